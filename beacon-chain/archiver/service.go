@@ -10,9 +10,12 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -29,6 +32,7 @@ type Service struct {
 	headFetcher          blockchain.HeadFetcher
 	participationFetcher blockchain.ParticipationFetcher
 	stateNotifier        statefeed.Notifier
+	stateGen             *stategen.State
 	lastArchivedEpoch    uint64
 }
 
@@ -38,6 +42,7 @@ type Config struct {
 	HeadFetcher          blockchain.HeadFetcher
 	ParticipationFetcher blockchain.ParticipationFetcher
 	StateNotifier        statefeed.Notifier
+	StateGen             *stategen.State
 }
 
 // NewArchiverService initializes the service from configuration options.
@@ -50,6 +55,7 @@ func NewArchiverService(ctx context.Context, cfg *Config) *Service {
 		headFetcher:          cfg.HeadFetcher,
 		participationFetcher: cfg.ParticipationFetcher,
 		stateNotifier:        cfg.StateNotifier,
+		stateGen:             cfg.StateGen,
 	}
 }
 
@@ -139,6 +145,57 @@ func (s *Service) archiveBalances(ctx context.Context, balances []uint64, epoch
 	return nil
 }
 
+// We archive the breakdown of the rewards and penalties validators received in the
+// epoch transition at the end of the epoch. The transition is replayed on the state
+// at the last slot of the epoch, which is the head state if the head is at the end
+// of the epoch.
+func (s *Service) archiveValidatorRewards(ctx context.Context, headState *state.BeaconState, epoch uint64) error {
+	preState := headState
+	lastSlot := helpers.StartSlot(epoch+1) - 1
+	if headState.Slot() != lastSlot {
+		if s.stateGen == nil {
+			return errors.New("no state generator to retrieve the end of epoch state")
+		}
+		var err error
+		preState, err = s.stateGen.StateBySlot(ctx, lastSlot)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve end of epoch state")
+		}
+	}
+	rewards, err := transition.EpochRewards(ctx, preState)
+	if err != nil {
+		return errors.Wrap(err, "could not compute validator rewards")
+	}
+	archived := &pb.ArchivedValidatorRewards{
+		SourceReward:         make([]uint64, len(rewards)),
+		SourcePenalty:        make([]uint64, len(rewards)),
+		TargetReward:         make([]uint64, len(rewards)),
+		TargetPenalty:        make([]uint64, len(rewards)),
+		HeadReward:           make([]uint64, len(rewards)),
+		HeadPenalty:          make([]uint64, len(rewards)),
+		InclusionDelayReward: make([]uint64, len(rewards)),
+		ProposerReward:       make([]uint64, len(rewards)),
+		InactivityPenalty:    make([]uint64, len(rewards)),
+		SlashingPenalty:      make([]uint64, len(rewards)),
+	}
+	for i, r := range rewards {
+		archived.SourceReward[i] = r.SourceReward
+		archived.SourcePenalty[i] = r.SourcePenalty
+		archived.TargetReward[i] = r.TargetReward
+		archived.TargetPenalty[i] = r.TargetPenalty
+		archived.HeadReward[i] = r.HeadReward
+		archived.HeadPenalty[i] = r.HeadPenalty
+		archived.InclusionDelayReward[i] = r.InclusionDelayReward
+		archived.ProposerReward[i] = r.ProposerReward
+		archived.InactivityPenalty[i] = r.InactivityPenalty
+		archived.SlashingPenalty[i] = r.SlashingPenalty
+	}
+	if err := s.beaconDB.SaveArchivedValidatorRewards(ctx, epoch, archived); err != nil {
+		return errors.Wrap(err, "could not archive validator rewards")
+	}
+	return nil
+}
+
 func (s *Service) run(ctx context.Context) {
 	stateChannel := make(chan *feed.Event, 1)
 	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
@@ -183,6 +240,12 @@ func (s *Service) run(ctx context.Context) {
 					log.WithError(err).Error("Could not archive validator balances and active indices")
 					continue
 				}
+				if flags.Get().EnableArchivedValidatorRewards {
+					if err := s.archiveValidatorRewards(ctx, headState, epochToArchive); err != nil {
+						log.WithError(err).Error("Could not archive validator rewards")
+						continue
+					}
+				}
 				log.WithField(
 					"epoch",
 					epochToArchive,
@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -211,6 +212,47 @@ func TestArchiverService_SavesIndicesAndBalances(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Successfully archived")
 }
 
+func TestArchiverService_SavesValidatorRewards(t *testing.T) {
+	hook := logTest.NewGlobal()
+	cfg := flags.Get()
+	flags.Init(&flags.GlobalFlags{EnableArchivedValidatorRewards: true})
+	defer flags.Init(cfg)
+
+	validatorCount := uint64(100)
+	headState, err := setupState(validatorCount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc, _ := setupService(t)
+	svc.headFetcher = &mock.ChainService{
+		State: headState,
+	}
+	event := &feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{
+			BlockRoot: [32]byte{1, 2, 3},
+			Verified:  true,
+		},
+	}
+	triggerStateEvent(t, svc, event)
+
+	retrieved, err := svc.beaconDB.ArchivedValidatorRewards(svc.ctx, helpers.CurrentEpoch(headState))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved == nil {
+		t.Fatal("Expected validator rewards to be archived")
+	}
+	if uint64(len(retrieved.SourcePenalty)) != validatorCount {
+		t.Fatalf("Wanted %d validator rewards, received %d", validatorCount, len(retrieved.SourcePenalty))
+	}
+	// No validator attested to the correct source in the previous epoch.
+	if retrieved.SourcePenalty[0] == 0 {
+		t.Error("Expected validators to receive a source penalty")
+	}
+	testutil.AssertLogsContain(t, hook, "Successfully archived")
+}
+
 func TestArchiverService_SavesCommitteeInfo(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validatorCount := uint64(100)
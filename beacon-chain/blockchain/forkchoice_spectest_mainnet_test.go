@@ -0,0 +1,9 @@
+package blockchain
+
+import (
+	"testing"
+)
+
+func TestForkChoiceMainnet(t *testing.T) {
+	runForkChoiceTests(t, "mainnet")
+}
@@ -0,0 +1,9 @@
+package blockchain
+
+import (
+	"testing"
+)
+
+func TestForkChoiceMinimal(t *testing.T) {
+	runForkChoiceTests(t, "minimal")
+}
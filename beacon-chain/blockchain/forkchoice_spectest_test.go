@@ -0,0 +1,298 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/snappy"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/params/spectest"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// forkChoiceStep is a single step of a fork choice spec test. Exactly one of its fields is set.
+type forkChoiceStep struct {
+	Tick             *uint64           `json:"tick"`
+	Block            *string           `json:"block"`
+	Attestation      *string           `json:"attestation"`
+	AttesterSlashing *string           `json:"attester_slashing"`
+	Valid            *bool             `json:"valid"`
+	Checks           *forkChoiceChecks `json:"checks"`
+}
+
+// forkChoiceChecks are the expected values of the fork choice store after a step. Older releases of
+// the spec tests only check the checkpoint roots, newer ones check the full checkpoints.
+type forkChoiceChecks struct {
+	Head                    *forkChoiceHead       `json:"head"`
+	JustifiedCheckpointRoot *string               `json:"justified_checkpoint_root"`
+	FinalizedCheckpointRoot *string               `json:"finalized_checkpoint_root"`
+	JustifiedCheckpoint     *forkChoiceCheckpoint `json:"justified_checkpoint"`
+	FinalizedCheckpoint     *forkChoiceCheckpoint `json:"finalized_checkpoint"`
+	BestJustifiedCheckpoint *forkChoiceCheckpoint `json:"best_justified_checkpoint"`
+}
+
+type forkChoiceHead struct {
+	Slot uint64 `json:"slot"`
+	Root string `json:"root"`
+}
+
+type forkChoiceCheckpoint struct {
+	Epoch uint64 `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+func runForkChoiceTests(t *testing.T, config string) {
+	if err := spectest.SetConfig(t, config); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, handler := range []string{"get_head", "on_block"} {
+		t.Run(handler, func(t *testing.T) {
+			folderPath := path.Join("fork_choice", handler, "pyspec_tests")
+			if ok, err := testutil.BazelDirectoryNonEmpty(path.Join("tests", config, "phase0", folderPath)); err != nil || !ok {
+				t.Skipf("No fork choice spec tests for %s in this spec tests release", handler)
+			}
+			testFolders, testsFolderPath := testutil.TestFolders(t, config, folderPath)
+			for _, folder := range testFolders {
+				t.Run(folder.Name(), func(t *testing.T) {
+					runForkChoiceTest(t, path.Join(testsFolderPath, folder.Name()))
+				})
+			}
+		})
+	}
+}
+
+// runForkChoiceTest replays the steps of a fork choice spec test read from its folder.
+func runForkChoiceTest(t *testing.T, folderPath string) {
+	stepsFile, err := testutil.BazelFileBytes(folderPath, "steps.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var steps []*forkChoiceStep
+	if err := yaml.Unmarshal(stepsFile, &steps); err != nil {
+		t.Fatalf("Failed to unmarshal steps: %v", err)
+	}
+	replayForkChoiceSteps(t, steps, func(t *testing.T, name string, obj sszUnmarshaler) {
+		loadForkChoiceTestSSZ(t, folderPath, name, obj)
+	})
+}
+
+// sszUnmarshaler is an SSZ object of a fork choice test.
+type sszUnmarshaler interface {
+	UnmarshalSSZ([]byte) error
+}
+
+// forkChoiceTestLoader reads the SSZ object of a fork choice test with the given name.
+type forkChoiceTestLoader func(t *testing.T, name string, obj sszUnmarshaler)
+
+// replayForkChoiceSteps replays the steps of a fork choice test against the chain service, from its
+// anchor state and block. Ticks set the wall clock the service uses to accept blocks and attestations.
+func replayForkChoiceSteps(t *testing.T, steps []*forkChoiceStep, load forkChoiceTestLoader) {
+	ctx := context.Background()
+	helpers.ClearCache()
+
+	anchorStateBase := &pb.BeaconState{}
+	load(t, "anchor_state", anchorStateBase)
+	anchorBlock := &ethpb.BeaconBlock{}
+	load(t, "anchor_block", anchorBlock)
+	if anchorBlock.Slot != 0 {
+		t.Skip("Only fork choice spec tests anchored at genesis are supported")
+	}
+	anchorState, err := beaconstate.InitializeFromProto(anchorStateBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	anchorRoot, err := stateutil.BlockRoot(anchorBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := setupForkChoiceTestService(t, testDB.SetupDB(t))
+	service.genesisTime = time.Unix(int64(anchorState.GenesisTime()), 0)
+	defer roughtime.SetNow(service.genesisTime)()
+	if err := service.saveGenesisData(ctx, anchorState); err != nil {
+		t.Fatal(err)
+	}
+	if service.genesisRoot != anchorRoot {
+		t.Fatalf("Genesis block root %#x does not match anchor block root %#x", service.genesisRoot, anchorRoot)
+	}
+
+	for i, step := range steps {
+		valid := step.Valid == nil || *step.Valid
+		switch {
+		case step.Tick != nil:
+			roughtime.SetNow(time.Unix(int64(*step.Tick), 0))
+		case step.Block != nil:
+			blk := &ethpb.SignedBeaconBlock{}
+			load(t, *step.Block, blk)
+			root, err := stateutil.BlockRoot(blk.Block)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkForkChoiceStepResult(t, i, *step.Block, valid, service.ReceiveBlockNoPubsub(ctx, blk, root))
+		case step.Attestation != nil:
+			att := &ethpb.Attestation{}
+			load(t, *step.Attestation, att)
+			checkForkChoiceStepResult(t, i, *step.Attestation, valid, service.ReceiveAttestationNoPubsub(ctx, att))
+		case step.AttesterSlashing != nil:
+			slashing := &ethpb.AttesterSlashing{}
+			load(t, *step.AttesterSlashing, slashing)
+			headState, err := service.HeadState(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = blocks.VerifyAttesterSlashing(ctx, headState, slashing)
+			if err == nil {
+				service.insertSlashingsToForkChoiceStore(ctx, []*ethpb.AttesterSlashing{slashing})
+			}
+			checkForkChoiceStepResult(t, i, *step.AttesterSlashing, valid, err)
+		case step.Checks != nil:
+			checkForkChoice(ctx, t, i, service, step.Checks)
+		default:
+			t.Fatalf("Step %d: unknown fork choice step", i)
+		}
+	}
+}
+
+func setupForkChoiceTestService(t *testing.T, beaconDB db.Database) *Service {
+	ctx := context.Background()
+	opsService, err := attestations.NewService(ctx, &attestations.Config{Pool: attestations.NewPool()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service, err := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+		AttPool:         attestations.NewPool(),
+		ExitPool:        voluntaryexits.NewPool(),
+		SlashingPool:    slashings.NewPool(),
+		StateNotifier:   &mock.MockStateNotifier{},
+		StateGen:        stategen.New(beaconDB, cache.NewStateSummaryCache()),
+		ForkChoiceStore: protoarray.New(0, 0, params.BeaconConfig().ZeroHash),
+		OpsService:      opsService,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return service
+}
+
+// loadForkChoiceTestSSZ reads an SSZ object of a fork choice spec test, which may be snappy compressed.
+func loadForkChoiceTestSSZ(t *testing.T, folderPath string, name string, obj sszUnmarshaler) {
+	enc, err := testutil.BazelFileBytes(folderPath, name+".ssz")
+	if err != nil {
+		compressed, snappyErr := testutil.BazelFileBytes(folderPath, name+".ssz_snappy")
+		if snappyErr != nil {
+			t.Fatalf("Could not read %s: %v", name, err)
+		}
+		enc, err = snappy.Decode(nil /* dst */, compressed)
+		if err != nil {
+			t.Fatalf("Could not decompress %s: %v", name, err)
+		}
+	}
+	if err := obj.UnmarshalSSZ(enc); err != nil {
+		t.Fatalf("Failed to unmarshal %s: %v", name, err)
+	}
+}
+
+func checkForkChoiceStepResult(t *testing.T, step int, name string, valid bool, err error) {
+	if valid && err != nil {
+		t.Fatalf("Step %d: could not process %s: %v", step, name, err)
+	}
+	if !valid && err == nil {
+		t.Fatalf("Step %d: processed %s which should be invalid", step, name)
+	}
+}
+
+func checkForkChoice(ctx context.Context, t *testing.T, step int, service *Service, checks *forkChoiceChecks) {
+	if checks.Head != nil {
+		balances, err := forkChoiceTestJustifiedBalances(ctx, service)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := service.updateHead(ctx, balances); err != nil {
+			t.Fatalf("Step %d: could not update head: %v", step, err)
+		}
+		headRoot, err := service.HeadRoot(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if service.HeadSlot() != checks.Head.Slot {
+			t.Errorf("Step %d: wanted head slot %d, got %d", step, checks.Head.Slot, service.HeadSlot())
+		}
+		if got := fmt.Sprintf("%#x", headRoot); got != checks.Head.Root {
+			t.Errorf("Step %d: wanted head root %s, got %s", step, checks.Head.Root, got)
+		}
+	}
+
+	checkpoints := []struct {
+		name     string
+		got      *ethpb.Checkpoint
+		wanted   *forkChoiceCheckpoint
+		wantRoot *string
+	}{
+		{"justified", service.CurrentJustifiedCheckpt(), checks.JustifiedCheckpoint, checks.JustifiedCheckpointRoot},
+		{"finalized", service.FinalizedCheckpt(), checks.FinalizedCheckpoint, checks.FinalizedCheckpointRoot},
+		{"best justified", service.bestJustifiedCheckpt, checks.BestJustifiedCheckpoint, nil},
+	}
+	for _, c := range checkpoints {
+		if c.wanted != nil {
+			if c.got.Epoch != c.wanted.Epoch {
+				t.Errorf("Step %d: wanted %s epoch %d, got %d", step, c.name, c.wanted.Epoch, c.got.Epoch)
+			}
+			c.wantRoot = &c.wanted.Root
+		}
+		if c.wantRoot == nil {
+			continue
+		}
+		// The genesis checkpoint root is the zero hash in the chain service, and the anchor root in the spec.
+		root := service.ensureRootNotZeros(bytesutil.ToBytes32(c.got.Root))
+		if got := fmt.Sprintf("%#x", root); !strings.EqualFold(got, *c.wantRoot) {
+			t.Errorf("Step %d: wanted %s root %s, got %s", step, c.name, *c.wantRoot, got)
+		}
+	}
+}
+
+// forkChoiceTestJustifiedBalances returns the balances of the justified checkpoint state, which
+// weigh the votes in head computation.
+func forkChoiceTestJustifiedBalances(ctx context.Context, service *Service) ([]uint64, error) {
+	root := service.ensureRootNotZeros(bytesutil.ToBytes32(service.CurrentJustifiedCheckpt().Root))
+	var justifiedState *beaconstate.BeaconState
+	var err error
+	if featureconfig.Get().NewStateMgmt {
+		justifiedState, err = service.stateGen.StateByRoot(ctx, root)
+	} else {
+		justifiedState, err = service.beaconDB.State(ctx, root)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if justifiedState == nil {
+		return nil, fmt.Errorf("no state for justified root %#x", root)
+	}
+	return justifiedState.Balances(), nil
+}
@@ -0,0 +1,98 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// TestForkChoiceSteps_GeneratedChain replays fork choice steps over a generated chain, covering the
+// runner with the pinned spec tests releases which do not ship fork choice vectors.
+func TestForkChoiceSteps_GeneratedChain(t *testing.T) {
+	ctx := context.Background()
+	genesis, privs := testutil.DeterministicGenesisState(t, 64)
+	genesisRoot, err := genesis.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	anchorBlock := blocks.NewGenesisBlock(genesisRoot[:]).Block
+	anchorRoot, err := stateutil.BlockRoot(anchorBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoded := make(map[string][]byte)
+	enc, err := genesis.InnerStateUnsafe().MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded["anchor_state"] = enc
+	if encoded["anchor_block"], err = anchorBlock.MarshalSSZ(); err != nil {
+		t.Fatal(err)
+	}
+	st := genesis.Copy()
+	roots := make(map[uint64][32]byte)
+	for slot := uint64(1); slot <= 3; slot++ {
+		blk, err := testutil.GenerateFullBlock(st, privs, testutil.DefaultBlockGenConfig(), slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if st, err = state.ExecuteStateTransition(ctx, st, blk); err != nil {
+			t.Fatal(err)
+		}
+		if roots[slot], err = stateutil.BlockRoot(blk.Block); err != nil {
+			t.Fatal(err)
+		}
+		if encoded[fmt.Sprintf("block_%d", slot)], err = blk.MarshalSSZ(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tick := func(slot uint64) *forkChoiceStep {
+		now := genesis.GenesisTime() + slot*params.BeaconConfig().SecondsPerSlot
+		return &forkChoiceStep{Tick: &now}
+	}
+	block := func(slot uint64, valid bool) *forkChoiceStep {
+		name := fmt.Sprintf("block_%d", slot)
+		return &forkChoiceStep{Block: &name, Valid: &valid}
+	}
+	head := func(slot uint64) *forkChoiceStep {
+		anchor := fmt.Sprintf("%#x", anchorRoot)
+		return &forkChoiceStep{Checks: &forkChoiceChecks{
+			Head:                    &forkChoiceHead{Slot: slot, Root: fmt.Sprintf("%#x", roots[slot])},
+			JustifiedCheckpoint:     &forkChoiceCheckpoint{Epoch: 0, Root: anchor},
+			FinalizedCheckpoint:     &forkChoiceCheckpoint{Epoch: 0, Root: anchor},
+			BestJustifiedCheckpoint: &forkChoiceCheckpoint{Epoch: 0, Root: anchor},
+		}}
+	}
+	steps := []*forkChoiceStep{
+		tick(1),
+		block(1, true),
+		head(1),
+		tick(2),
+		block(2, true),
+		// Blocks from the future are rejected until their slot starts.
+		block(3, false),
+		head(2),
+		tick(3),
+		block(3, true),
+		head(3),
+	}
+
+	replayForkChoiceSteps(t, steps, func(t *testing.T, name string, obj sszUnmarshaler) {
+		enc, ok := encoded[name]
+		if !ok {
+			t.Fatalf("Unknown object %s", name)
+		}
+		if err := obj.UnmarshalSSZ(enc); err != nil {
+			t.Fatalf("Failed to unmarshal %s: %v", name, err)
+		}
+	})
+}
@@ -130,6 +130,9 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 		})
 
 		reorgCount.Inc()
+		if err := s.recordReorg(ctx, s.headRoot(), s.headSlot(), headRoot, newHeadBlock.Block.Slot); err != nil {
+			log.WithError(err).Warn("Could not record reorg")
+		}
 	}
 
 	// Cache the new head info.
@@ -138,6 +138,17 @@ func TestSaveHead_Different_Reorg(t *testing.T) {
 		t.Error("Head did not change")
 	}
 	testutil.AssertLogsContain(t, hook, "Chain reorg occurred")
+
+	reorgs, err := service.beaconDB.Reorgs(context.Background(), 0, ^uint64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reorgs) != 1 {
+		t.Fatalf("Wanted 1 recorded reorg, got %d", len(reorgs))
+	}
+	if !bytes.Equal(reorgs[0].OldHeadRoot, oldRoot[:]) || !bytes.Equal(reorgs[0].NewHeadRoot, newRoot[:]) || reorgs[0].NewHeadSlot != 1 {
+		t.Errorf("Unexpected recorded reorg %v", reorgs[0])
+	}
 }
 
 func TestUpdateRecentCanonicalBlocks_CanUpdateWithoutParent(t *testing.T) {
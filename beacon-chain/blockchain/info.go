@@ -2,11 +2,15 @@ package blockchain
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
 
 	"github.com/emicklei/dot"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
 const template = `<html>
@@ -83,3 +87,141 @@ func (s *Service) TreeHandler(w http.ResponseWriter, _ *http.Request) {
 		log.WithError(err).Error("Failed to render p2p info page")
 	}
 }
+
+// forkChoiceTree is the JSON view of the fork choice store served by ForkChoiceHandler.
+type forkChoiceTree struct {
+	HeadRoot       string            `json:"head_root"`
+	JustifiedEpoch uint64            `json:"justified_epoch"`
+	JustifiedRoot  string            `json:"justified_root"`
+	FinalizedEpoch uint64            `json:"finalized_epoch"`
+	FinalizedRoot  string            `json:"finalized_root"`
+	Nodes          []*forkChoiceNode `json:"nodes"`
+}
+
+type forkChoiceNode struct {
+	Slot           uint64 `json:"slot"`
+	Root           string `json:"root"`
+	Parent         string `json:"parent_root"`
+	JustifiedEpoch uint64 `json:"justified_epoch"`
+	FinalizedEpoch uint64 `json:"finalized_epoch"`
+	Weight         uint64 `json:"weight"`
+	BestChild      string `json:"best_child_root"`
+	BestDescendant string `json:"best_descendant_root"`
+	Graffiti       string `json:"graffiti"`
+	Viable         bool   `json:"viable"`
+}
+
+// ForkChoiceHandler is a handler to serve the /forkchoice page in metrics. It exports the fork choice
+// store as a Graphviz DOT graph, or as JSON with ?format=json. The nodes can be filtered with the
+// start_slot and end_slot query parameters, both inclusive.
+func (s *Service) ForkChoiceHandler(w http.ResponseWriter, r *http.Request) {
+	startSlot, err := slotQueryParam(r, "start_slot", 0)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	endSlot, err := slotQueryParam(r, "end_slot", math.MaxUint64)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	justified := s.CurrentJustifiedCheckpt()
+	finalized := s.FinalizedCheckpt()
+	headRoot := s.headRoot()
+	justifiedRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(justified.Root))
+	nodes := s.forkChoiceStore.Tree(startSlot, endSlot)
+
+	switch format := r.URL.Query().Get("format"); format {
+	case "json":
+		tree := &forkChoiceTree{
+			HeadRoot:       fmt.Sprintf("%#x", headRoot),
+			JustifiedEpoch: justified.Epoch,
+			JustifiedRoot:  fmt.Sprintf("%#x", justifiedRoot),
+			FinalizedEpoch: finalized.Epoch,
+			FinalizedRoot:  fmt.Sprintf("%#x", s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root))),
+			Nodes:          make([]*forkChoiceNode, len(nodes)),
+		}
+		for i, n := range nodes {
+			tree.Nodes[i] = &forkChoiceNode{
+				Slot:           n.Slot,
+				Root:           fmt.Sprintf("%#x", n.Root),
+				Parent:         fmt.Sprintf("%#x", n.Parent),
+				JustifiedEpoch: n.JustifiedEpoch,
+				FinalizedEpoch: n.FinalizedEpoch,
+				Weight:         n.Weight,
+				BestChild:      fmt.Sprintf("%#x", n.BestChild),
+				BestDescendant: fmt.Sprintf("%#x", n.BestDescendant),
+				Graffiti:       fmt.Sprintf("%#x", n.Graffiti),
+				Viable:         n.Viable,
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(tree); err != nil {
+			log.WithError(err).Error("Failed to render fork choice page")
+		}
+	case "", "dot":
+		w.Header().Set("Content-Type", "text/vnd.graphviz")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(forkChoiceGraph(nodes, headRoot, justifiedRoot).String())); err != nil {
+			log.WithError(err).Error("Failed to render fork choice page")
+		}
+	default:
+		http.Error(w, fmt.Sprintf("unknown format %q, wanted dot or json", format), http.StatusBadRequest)
+	}
+}
+
+// forkChoiceGraph draws the fork choice nodes, with edges from children to their parent. Edges to
+// best children are bold, the head is green, the justified block is blue and nodes which are not
+// viable for head are dashed.
+func forkChoiceGraph(nodes []*protoarray.TreeNode, headRoot [32]byte, justifiedRoot [32]byte) *dot.Graph {
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labeljust", "l")
+
+	dotNodes := make(map[[32]byte]dot.Node, len(nodes))
+	bestChildren := make(map[[32]byte]bool, len(nodes))
+	for _, n := range nodes {
+		label := fmt.Sprintf("slot: %d\n root: %#x\n weight: %d\n justified epoch: %d\n finalized epoch: %d\n graffiti: %s",
+			n.Slot, n.Root[:4], n.Weight/1e9, n.JustifiedEpoch, n.FinalizedEpoch, hex.EncodeToString(n.Graffiti[:8]))
+		dotN := graph.Node(fmt.Sprintf("%#x", n.Root)).Box().Attr("label", label)
+		switch n.Root {
+		case headRoot:
+			dotN = dotN.Attr("color", "green")
+		case justifiedRoot:
+			dotN = dotN.Attr("color", "blue")
+		}
+		if !n.Viable {
+			dotN = dotN.Attr("style", "dashed")
+		}
+		dotNodes[n.Root] = dotN
+		bestChildren[n.BestChild] = true
+	}
+
+	for _, n := range nodes {
+		parent, ok := dotNodes[n.Parent]
+		if !ok {
+			continue
+		}
+		edge := graph.Edge(dotNodes[n.Root], parent)
+		if bestChildren[n.Root] {
+			edge.Attr("style", "bold")
+		}
+	}
+	return graph
+}
+
+// slotQueryParam parses a slot from the query parameters of the request, or returns the default
+// value when it is absent.
+func slotQueryParam(r *http.Request, name string, defaultValue uint64) (uint64, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	slot, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", name, value)
+	}
+	return slot, nil
+}
@@ -0,0 +1,96 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func setupForkChoiceHandlerService(t *testing.T) *Service {
+	ctx := context.Background()
+	store := protoarray.New(0, 0, [32]byte{'a'})
+	if err := store.ProcessBlock(ctx, 0, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.ProcessBlock(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, [32]byte{'g'}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.ProcessBlock(ctx, 2, [32]byte{'c'}, [32]byte{'b'}, [32]byte{}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	return &Service{
+		forkChoiceStore:  store,
+		head:             &head{slot: 2, root: [32]byte{'c'}},
+		genesisRoot:      [32]byte{'a'},
+		justifiedCheckpt: &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+		finalizedCheckpt: &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+	}
+}
+
+func TestForkChoiceHandler_JSON(t *testing.T) {
+	service := setupForkChoiceHandlerService(t)
+
+	rec := httptest.NewRecorder()
+	service.ForkChoiceHandler(rec, httptest.NewRequest(http.MethodGet, "/forkchoice?format=json&start_slot=1", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Wanted status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	tree := &forkChoiceTree{}
+	if err := json.Unmarshal(rec.Body.Bytes(), tree); err != nil {
+		t.Fatal(err)
+	}
+	if tree.HeadRoot != fmt.Sprintf("%#x", [32]byte{'c'}) {
+		t.Errorf("Wanted head root %#x, got %s", [32]byte{'c'}, tree.HeadRoot)
+	}
+	// The zero justified root stands for the genesis block.
+	if tree.JustifiedRoot != fmt.Sprintf("%#x", [32]byte{'a'}) {
+		t.Errorf("Wanted justified root %#x, got %s", [32]byte{'a'}, tree.JustifiedRoot)
+	}
+	if len(tree.Nodes) != 2 {
+		t.Fatalf("Wanted 2 nodes from slot 1, got %d", len(tree.Nodes))
+	}
+	n := tree.Nodes[0]
+	if n.Slot != 1 || n.Parent != fmt.Sprintf("%#x", [32]byte{'a'}) || n.Graffiti != fmt.Sprintf("%#x", [32]byte{'g'}) || !n.Viable {
+		t.Errorf("Unexpected node %+v", n)
+	}
+}
+
+func TestForkChoiceHandler_DOT(t *testing.T) {
+	service := setupForkChoiceHandlerService(t)
+
+	rec := httptest.NewRecorder()
+	service.ForkChoiceHandler(rec, httptest.NewRequest(http.MethodGet, "/forkchoice?end_slot=1", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Wanted status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	graph := rec.Body.String()
+	if !strings.HasPrefix(graph, "digraph") {
+		t.Errorf("Wanted a directed graph, got %s", graph)
+	}
+	if !strings.Contains(graph, fmt.Sprintf("%#x", [32]byte{'b'})) {
+		t.Error("Wanted node at slot 1 in graph")
+	}
+	if strings.Contains(graph, fmt.Sprintf("%#x", [32]byte{'c'})) {
+		t.Error("Did not want node at slot 2 in graph")
+	}
+}
+
+func TestForkChoiceHandler_InvalidParams(t *testing.T) {
+	service := setupForkChoiceHandlerService(t)
+
+	for _, target := range []string{"/forkchoice?format=svg", "/forkchoice?start_slot=foo", "/forkchoice?end_slot=-1"} {
+		rec := httptest.NewRecorder()
+		service.ForkChoiceHandler(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("Wanted status %d for %s, got %d", http.StatusBadRequest, target, rec.Code)
+		}
+	}
+}
@@ -88,6 +88,13 @@ var (
 		Name: "beacon_reorg_total",
 		Help: "Count the number of times beacon chain has a reorg",
 	})
+	reorgDepth = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "beacon_reorg_depth_blocks",
+			Help:    "The number of canonical blocks orphaned by chain reorgs",
+			Buckets: []float64{1, 2, 3, 4, 8, 16, 32, 64},
+		},
+	)
 	sentBlockPropagationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "block_sent_latency_milliseconds",
@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -136,6 +137,9 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 		if err := s.forkChoiceStore.Prune(ctx, fRoot); err != nil {
 			return nil, errors.Wrap(err, "could not prune proto array fork choice nodes")
 		}
+		if err := s.saveForkChoice(ctx); err != nil {
+			log.WithError(err).Warn("Could not save fork choice")
+		}
 
 		s.prevFinalizedCheckpt = s.finalizedCheckpt
 		s.finalizedCheckpt = postState.FinalizedCheckpoint()
@@ -144,6 +148,10 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 			return nil, errors.Wrap(err, "could not save new justified")
 		}
 
+		if err := s.insertFinalizedDeposits(ctx, fRoot); err != nil {
+			log.WithError(err).Warn("Could not finalize deposits")
+		}
+
 		if featureconfig.Get().NewStateMgmt {
 			fRoot := bytesutil.ToBytes32(postState.FinalizedCheckpoint().Root)
 			fBlock, err := s.beaconDB.Block(ctx, fRoot)
@@ -300,6 +308,14 @@ func (s *Service) onBlockInitialSyncStateTransition(ctx context.Context, signed
 			return errors.Wrap(err, "could not save new justified")
 		}
 
+		if err := s.insertFinalizedDeposits(ctx, bytesutil.ToBytes32(postState.FinalizedCheckpoint().Root)); err != nil {
+			log.WithError(err).Warn("Could not finalize deposits")
+		}
+
+		if err := s.saveForkChoice(ctx); err != nil {
+			log.WithError(err).Warn("Could not save fork choice")
+		}
+
 		if featureconfig.Get().NewStateMgmt {
 			fRoot := bytesutil.ToBytes32(postState.FinalizedCheckpoint().Root)
 			fBlock, err := s.beaconDB.Block(ctx, fRoot)
@@ -347,8 +363,8 @@ func (s *Service) onBlockInitialSyncStateTransition(ctx context.Context, signed
 	return nil
 }
 
-// This feeds in the block and block's attestations to fork choice store. It's allows fork choice store
-// to gain information on the most current chain.
+// This feeds in the block, block's attestations and attester slashings to fork choice store. It's allows
+// fork choice store to gain information on the most current chain.
 func (s *Service) insertBlockToForkChoiceStore(ctx context.Context, blk *ethpb.BeaconBlock, root [32]byte, state *stateTrie.BeaconState) error {
 	if err := s.fillInForkChoiceMissingBlocks(ctx, blk, state); err != nil {
 		return err
@@ -372,5 +388,20 @@ func (s *Service) insertBlockToForkChoiceStore(ctx context.Context, blk *ethpb.B
 		s.forkChoiceStore.ProcessAttestation(ctx, indices, bytesutil.ToBytes32(a.Data.BeaconBlockRoot), a.Data.Target.Epoch)
 	}
 
+	// Feed in block's attester slashings to fork choice store, to discount equivocating votes.
+	s.insertSlashingsToForkChoiceStore(ctx, blk.Body.AttesterSlashings)
+
 	return nil
 }
+
+// This marks the validators slashed by the input attester slashings as equivocating in fork choice
+// store, so their votes no longer weigh in head computation. The slashings must have been verified.
+func (s *Service) insertSlashingsToForkChoiceStore(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
+	for _, slashing := range slashings {
+		if slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+			continue
+		}
+		indices := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+		s.forkChoiceStore.ProcessAttesterSlashing(ctx, indices)
+	}
+}
@@ -448,6 +448,33 @@ func (s *Service) finalizedImpliesNewJustified(ctx context.Context, state *state
 	return nil
 }
 
+// insertFinalizedDeposits collapses the deposits included in the finalized state into the finalized
+// deposit trie of the deposit cache, so that the cache only holds the deposits which are still pending.
+func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "blockchain.insertFinalizedDeposits")
+	defer span.End()
+
+	if s.depositCache == nil {
+		return nil
+	}
+	var finalizedState *stateTrie.BeaconState
+	var err error
+	if featureconfig.Get().NewStateMgmt {
+		finalizedState, err = s.stateGen.StateByRoot(ctx, fRoot)
+	} else {
+		finalizedState, err = s.beaconDB.State(ctx, fRoot)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized state")
+	}
+	// The finalized state may not be saved yet during initial sync, the deposits are then
+	// finalized on a later checkpoint.
+	if finalizedState == nil {
+		return nil
+	}
+	return s.depositCache.FinalizeDeposits(ctx, finalizedState.Eth1DepositIndex())
+}
+
 // This retrieves missing blocks from DB (ie. the blocks that couldn't received over sync) and inserts them to fork choice store.
 // This is useful for block tree visualizer and additional vote accounting.
 func (s *Service) fillInForkChoiceMissingBlocks(ctx context.Context, blk *ethpb.BeaconBlock, state *stateTrie.BeaconState) error {
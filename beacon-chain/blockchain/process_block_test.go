@@ -10,6 +10,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -435,6 +436,51 @@ func TestSaveInitState_CanSaveDelete(t *testing.T) {
 	}
 }
 
+func TestInsertFinalizedDeposits(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	depositCache := depositcache.NewDepositCache()
+	cfg := &Config{BeaconDB: db, DepositCache: depositCache}
+	service, err := NewService(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, d := range deposits {
+		depositCache.InsertDeposit(ctx, d, uint64(i), int64(i), [32]byte{})
+	}
+	finalizedState := testutil.NewBeaconState()
+	if err := finalizedState.SetEth1DepositIndex(3); err != nil {
+		t.Fatal(err)
+	}
+	fRoot := [32]byte{'a'}
+	if err := service.beaconDB.SaveState(ctx, finalizedState, fRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.insertFinalizedDeposits(ctx, fRoot); err != nil {
+		t.Fatal(err)
+	}
+	fd := depositCache.FinalizedDeposits(ctx)
+	if fd == nil || fd.MerkleTrieIndex != 2 {
+		t.Fatalf("Unexpected finalized deposits %v", fd)
+	}
+	wantTrie, _, err := testutil.DeterministicDepositTrie(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fd.Deposits.Root() != wantTrie.Root() {
+		t.Errorf("Wanted finalized deposit root %#x, received %#x", wantTrie.Root(), fd.Deposits.Root())
+	}
+	if len(depositCache.AllDepositContainers(ctx)) != 1 {
+		t.Errorf("Wanted 1 deposit container, received %d", len(depositCache.AllDepositContainers(ctx)))
+	}
+}
+
 func TestUpdateJustified_CouldUpdateBest(t *testing.T) {
 	ctx := context.Background()
 	db := testDB.SetupDB(t)
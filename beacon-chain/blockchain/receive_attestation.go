@@ -93,7 +93,7 @@ func (s *Service) processAttestation(subscribedToStateEvents chan struct{}) {
 		select {
 		case <-s.ctx.Done():
 			return
-		case <-st.C():
+		case slot := <-st.C():
 			ctx := context.Background()
 			atts := s.attPool.ForkchoiceAttestations()
 			for _, a := range atts {
@@ -135,6 +135,13 @@ func (s *Service) processAttestation(subscribedToStateEvents chan struct{}) {
 					}).WithError(err).Warn("Could not receive attestation in chain service")
 				}
 			}
+
+			// Persist fork choice votes at every epoch, so at most an epoch of votes is lost on restart.
+			if helpers.IsEpochStart(slot) {
+				if err := s.saveForkChoice(ctx); err != nil {
+					log.WithError(err).Warn("Could not save fork choice")
+				}
+			}
 		}
 	}
 }
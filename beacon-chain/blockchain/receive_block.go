@@ -81,7 +81,7 @@ func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedB
 		return nil
 	}
 	for _, exit := range block.Block.Body.VoluntaryExits {
-		s.exitPool.MarkIncluded(exit)
+		s.exitPool.MarkIncluded(exit, block.Block.Slot)
 	}
 
 	s.epochParticipationLock.Lock()
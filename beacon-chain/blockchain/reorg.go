@@ -0,0 +1,82 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// recordReorg saves a reorg from the old head to the new head in the DB, along with the common
+// ancestor of both heads, the number of orphaned blocks and the fork choice weights of both branches.
+// Nothing is recorded when the new head descends from the old head, nor when the fork point can't be
+// resolved because the old head is no longer in the fork choice store.
+func (s *Service) recordReorg(ctx context.Context, oldHeadRoot [32]byte, oldHeadSlot uint64, newHeadRoot [32]byte, newHeadSlot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "blockchain.recordReorg")
+	defer span.End()
+
+	reorg := &dbpb.Reorg{
+		Slot:        s.CurrentSlot(),
+		OldHeadRoot: oldHeadRoot[:],
+		OldHeadSlot: oldHeadSlot,
+		NewHeadRoot: newHeadRoot[:],
+		NewHeadSlot: newHeadSlot,
+	}
+	ancestor, oldBranch, newBranch, depth := forkPoint(s.forkChoiceStore.Tree(0, ^uint64(0)), oldHeadRoot, newHeadRoot)
+	if ancestor == nil {
+		log.WithField("oldHeadRoot", fmt.Sprintf("%#x", bytesutil.Trunc(oldHeadRoot[:]))).Debug(
+			"Could not resolve reorg fork point, not recording reorg")
+		return nil
+	}
+	if depth == 0 {
+		// The new head descends from the old head, several blocks were applied at once.
+		return nil
+	}
+	reorg.CommonAncestorRoot = ancestor.Root[:]
+	reorg.CommonAncestorSlot = ancestor.Slot
+	reorg.Depth = depth
+	if oldBranch != nil {
+		reorg.OldBranchWeight = oldBranch.Weight
+	}
+	if newBranch != nil {
+		reorg.NewBranchWeight = newBranch.Weight
+	}
+	reorgDepth.Observe(float64(reorg.Depth))
+
+	return s.beaconDB.SaveReorg(ctx, reorg)
+}
+
+// forkPoint returns the latest common ancestor of the old and the new head in the fork choice tree,
+// the first blocks of the old and the new branches after it, and the number of blocks of the old
+// branch. The ancestor is nil if either head is unknown or they don't share an ancestor.
+func forkPoint(
+	nodes []*protoarray.TreeNode,
+	oldHeadRoot [32]byte,
+	newHeadRoot [32]byte,
+) (ancestor *protoarray.TreeNode, oldBranch *protoarray.TreeNode, newBranch *protoarray.TreeNode, depth uint64) {
+	nodesByRoot := make(map[[32]byte]*protoarray.TreeNode, len(nodes))
+	for _, n := range nodes {
+		nodesByRoot[n.Root] = n
+	}
+
+	// Map every block of the new chain to its child on the way to the new head.
+	newChain := make(map[[32]byte]*protoarray.TreeNode)
+	var child *protoarray.TreeNode
+	for n := nodesByRoot[newHeadRoot]; n != nil; n = nodesByRoot[n.Parent] {
+		newChain[n.Root] = child
+		child = n
+	}
+
+	child = nil
+	for n := nodesByRoot[oldHeadRoot]; n != nil; n = nodesByRoot[n.Parent] {
+		if newBranch, ok := newChain[n.Root]; ok {
+			return n, child, newBranch, depth
+		}
+		child = n
+		depth++
+	}
+	return nil, nil, nil, 0
+}
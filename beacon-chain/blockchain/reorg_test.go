@@ -0,0 +1,43 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+)
+
+func TestForkPoint(t *testing.T) {
+	// a <- b <- c <- d
+	//        \
+	//         e <- f
+	nodes := []*protoarray.TreeNode{
+		{Slot: 0, Root: [32]byte{'a'}, Weight: 60},
+		{Slot: 1, Root: [32]byte{'b'}, Parent: [32]byte{'a'}, Weight: 60},
+		{Slot: 2, Root: [32]byte{'c'}, Parent: [32]byte{'b'}, Weight: 20},
+		{Slot: 3, Root: [32]byte{'d'}, Parent: [32]byte{'c'}, Weight: 20},
+		{Slot: 3, Root: [32]byte{'e'}, Parent: [32]byte{'b'}, Weight: 40},
+		{Slot: 4, Root: [32]byte{'f'}, Parent: [32]byte{'e'}, Weight: 40},
+	}
+
+	ancestor, oldBranch, newBranch, depth := forkPoint(nodes, [32]byte{'d'}, [32]byte{'f'})
+	if ancestor == nil || ancestor.Root != [32]byte{'b'} {
+		t.Fatalf("Wanted common ancestor b, got %v", ancestor)
+	}
+	if oldBranch.Root != [32]byte{'c'} || newBranch.Root != [32]byte{'e'} {
+		t.Errorf("Wanted branches c and e, got %#x and %#x", oldBranch.Root, newBranch.Root)
+	}
+	if depth != 2 {
+		t.Errorf("Wanted depth 2, got %d", depth)
+	}
+
+	// The new head descends from the old head.
+	ancestor, _, _, depth = forkPoint(nodes, [32]byte{'b'}, [32]byte{'f'})
+	if ancestor == nil || ancestor.Root != [32]byte{'b'} || depth != 0 {
+		t.Errorf("Wanted ancestor b at depth 0, got %v at depth %d", ancestor, depth)
+	}
+
+	// The old head is not in the tree.
+	if ancestor, _, _, _ := forkPoint(nodes, [32]byte{'z'}, [32]byte{'f'}); ancestor != nil {
+		t.Errorf("Wanted no ancestor for unknown head, got %v", ancestor)
+	}
+}
@@ -187,7 +187,7 @@ func (s *Service) Start() {
 		s.bestJustifiedCheckpt = stateTrie.CopyCheckpoint(justifiedCheckpoint)
 		s.finalizedCheckpt = stateTrie.CopyCheckpoint(finalizedCheckpoint)
 		s.prevFinalizedCheckpt = stateTrie.CopyCheckpoint(finalizedCheckpoint)
-		s.resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint)
+		s.resumeForkChoice(ctx, justifiedCheckpoint, finalizedCheckpoint)
 
 		if !featureconfig.Get().NewStateMgmt {
 			if finalizedCheckpoint.Epoch > 1 {
@@ -307,7 +307,7 @@ func (s *Service) initializeBeaconChain(
 // Stop the blockchain service's main event loop and associated goroutines.
 func (s *Service) Stop() error {
 	defer s.cancel()
-	return nil
+	return s.saveForkChoice(s.ctx)
 }
 
 // Status always returns nil unless there is an error condition that causes
@@ -499,11 +499,66 @@ func (s *Service) pruneGarbageState(ctx context.Context, slot uint64) error {
 	return nil
 }
 
-// This is called when a client starts from non-genesis slot. This passes last justified and finalized
-// information to fork choice service to initializes fork choice store.
-func (s *Service) resumeForkChoice(justifiedCheckpoint *ethpb.Checkpoint, finalizedCheckpoint *ethpb.Checkpoint) {
-	store := protoarray.New(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
+// This is called when a client starts from non-genesis slot. This restores the fork choice store saved
+// before the restart, and updates head with it. If there is no saved store, or it is inconsistent with
+// the DB, this passes last justified and finalized information to fork choice service to initialize
+// an empty fork choice store instead.
+func (s *Service) resumeForkChoice(ctx context.Context, justifiedCheckpoint *ethpb.Checkpoint, finalizedCheckpoint *ethpb.Checkpoint) {
+	store, err := s.restoreForkChoice(ctx, finalizedCheckpoint)
+	if err != nil {
+		log.WithError(err).Warn("Could not restore saved fork choice, resuming from finalized checkpoint")
+	}
+	if store == nil {
+		s.forkChoiceStore = protoarray.New(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
+		return
+	}
 	s.forkChoiceStore = store
+	log.WithField("nodes", len(store.Nodes())).Info("Restored saved fork choice")
+
+	if err := s.updateHead(ctx, store.Balances()); err != nil {
+		log.WithError(err).Warn("Could not update head with restored fork choice")
+	}
+}
+
+// This restores the fork choice store saved in DB. It returns nil without error if no store was saved.
+// The saved store must contain the finalized block, and every block in it must be in DB.
+func (s *Service) restoreForkChoice(ctx context.Context, finalizedCheckpoint *ethpb.Checkpoint) (*protoarray.ForkChoice, error) {
+	saved, err := s.beaconDB.ForkChoice(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get saved fork choice")
+	}
+	if saved == nil {
+		return nil, nil
+	}
+	store, err := protoarray.CreateFromProto(saved)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not restore saved fork choice")
+	}
+
+	finalizedRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalizedCheckpoint.Root))
+	if !store.HasNode(finalizedRoot) {
+		return nil, fmt.Errorf("saved fork choice does not contain finalized root %#x", finalizedRoot)
+	}
+	for _, n := range store.Nodes() {
+		if !s.beaconDB.HasBlock(ctx, n.Root) {
+			return nil, fmt.Errorf("saved fork choice block %#x at slot %d is not in db", n.Root, n.Slot)
+		}
+	}
+
+	// The store may have been saved before the latest finalization was processed.
+	if err := store.Prune(ctx, finalizedRoot); err != nil {
+		return nil, errors.Wrap(err, "could not prune saved fork choice")
+	}
+	return store, nil
+}
+
+// This saves the fork choice store to DB, so it can be restored on restart. This is called on every
+// new finalized checkpoint, at every epoch and when the service stops.
+func (s *Service) saveForkChoice(ctx context.Context) error {
+	if s.forkChoiceStore == nil || len(s.forkChoiceStore.Nodes()) == 0 {
+		return nil
+	}
+	return s.beaconDB.SaveForkChoice(ctx, s.forkChoiceStore.ToProto())
 }
 
 // This returns true if block has been processed before. Two ways to verify the block has been processed:
@@ -525,3 +525,97 @@ func BenchmarkHasBlockForkChoiceStore(b *testing.B) {
 		}
 	}
 }
+
+func TestSaveAndRestoreForkChoice(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	s := &Service{
+		beaconDB:        db,
+		forkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+	}
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := stateutil.BlockRoot(genesis.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child := testutil.NewBeaconBlock()
+	child.Block.Slot = 1
+	child.Block.ParentRoot = genesisRoot[:]
+	childRoot, err := stateutil.BlockRoot(child.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{genesis, child}); err != nil {
+		t.Fatal(err)
+	}
+	s.genesisRoot = genesisRoot
+	if err := s.forkChoiceStore.ProcessBlock(ctx, 0, genesisRoot, [32]byte{}, [32]byte{}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.forkChoiceStore.ProcessBlock(ctx, 1, childRoot, genesisRoot, [32]byte{}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	s.forkChoiceStore.ProcessAttestation(ctx, []uint64{0}, childRoot, 0)
+	if _, err := s.forkChoiceStore.Head(ctx, 0, genesisRoot, []uint64{10}, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.saveForkChoice(ctx); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := s.restoreForkChoice(ctx, &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored == nil {
+		t.Fatal("Expected fork choice to be restored")
+	}
+	if !reflect.DeepEqual(restored.Nodes(), s.forkChoiceStore.Nodes()) {
+		t.Error("Restored fork choice nodes are not equal")
+	}
+	if !reflect.DeepEqual(restored.Balances(), []uint64{10}) {
+		t.Errorf("Wanted balances %v, got %v", []uint64{10}, restored.Balances())
+	}
+	head, err := restored.Head(ctx, 0, genesisRoot, restored.Balances(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != childRoot {
+		t.Errorf("Wanted head %#x, got %#x", childRoot, head)
+	}
+
+	// A saved fork choice without the finalized block can not be restored.
+	if _, err := s.restoreForkChoice(ctx, &ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}}); err == nil {
+		t.Error("Expected error restoring fork choice without finalized root")
+	}
+}
+
+func TestRestoreForkChoice_BlockNotInDB(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	s := &Service{
+		beaconDB:        db,
+		forkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+	}
+
+	restored, err := s.restoreForkChoice(ctx, &ethpb.Checkpoint{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored != nil {
+		t.Error("Expected no fork choice to be restored without a saved one")
+	}
+
+	root := [32]byte{'a'}
+	s.genesisRoot = root
+	if err := s.forkChoiceStore.ProcessBlock(ctx, 0, root, [32]byte{}, [32]byte{}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.saveForkChoice(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.restoreForkChoice(ctx, &ethpb.Checkpoint{}); err == nil {
+		t.Error("Expected error restoring fork choice with a block not in db")
+	}
+}
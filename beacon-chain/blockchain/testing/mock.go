@@ -116,7 +116,7 @@ func (ms *ChainService) ReceiveBlock(ctx context.Context, block *ethpb.SignedBea
 
 // ReceiveBlockNoVerify mocks ReceiveBlockNoVerify method in chain service.
 func (ms *ChainService) ReceiveBlockNoVerify(ctx context.Context, block *ethpb.SignedBeaconBlock, blockRoot [32]byte) error {
-	return nil
+	return ms.ReceiveBlockNoPubsubForkchoice(ctx, block, blockRoot)
 }
 
 // ReceiveBlockNoPubsub mocks ReceiveBlockNoPubsub method in chain service.
@@ -8,15 +8,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"sort"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	log "github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -33,6 +38,20 @@ type DepositFetcher interface {
 	AllDeposits(ctx context.Context, beforeBlk *big.Int) []*ethpb.Deposit
 	DepositByPubkey(ctx context.Context, pubKey []byte) (*ethpb.Deposit, *big.Int)
 	DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *big.Int) (uint64, [32]byte)
+	FinalizedDeposits(ctx context.Context) *FinalizedDeposits
+}
+
+// FinalizedDeposits stores the trie of the deposits which were included in the finalized state,
+// or seeded from a deposit snapshot. Only the roots of the complete subtrees covering these
+// deposits are kept in the trie, and the cache holds no deposit containers for them.
+type FinalizedDeposits struct {
+	// Deposits is the deposit trie up to the last finalized deposit. It must be copied
+	// before inserting more deposits into it.
+	Deposits *trieutil.SparseMerkleTrie
+	// MerkleTrieIndex is the index of the last finalized deposit.
+	MerkleTrieIndex int64
+	// Eth1BlockHeight is the height of the eth1 block which contains the last finalized deposit.
+	Eth1BlockHeight uint64
 }
 
 // DepositCache stores all in-memory deposit objects. This
@@ -44,6 +63,7 @@ type DepositCache struct {
 	depositsLock       sync.RWMutex
 	chainStartDeposits []*ethpb.Deposit
 	chainStartPubkeys  map[string]bool
+	finalizedDeposits  *FinalizedDeposits
 }
 
 // NewDepositCache instantiates a new deposit cache
@@ -144,16 +164,144 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	dc.depositsLock.RLock()
 	defer dc.depositsLock.RUnlock()
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Eth1BlockHeight > blockHeight.Uint64() })
-	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
-	// deposit.
+	// the cache only holds the deposits following the finalized deposits.
+	finalizedCount := uint64(0)
+	fd := dc.finalizedDeposits
+	if fd != nil {
+		finalizedCount = uint64(fd.MerkleTrieIndex + 1)
+	}
 	if heightIdx == 0 {
+		// send the root of the finalized deposits if they were all made prior to blockheight.
+		if fd != nil && fd.Eth1BlockHeight <= blockHeight.Uint64() {
+			return finalizedCount, fd.Deposits.Root()
+		}
+		// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
+		// deposit.
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	return finalizedCount + uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+}
+
+// InsertFinalizedDeposits sets the trie of the deposits seeded from a deposit snapshot. Only deposits
+// following the last finalized deposit are expected to be inserted into the cache afterwards.
+func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, fd *FinalizedDeposits) {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertFinalizedDeposits")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	dc.finalizedDeposits = fd
+	historicalDepositsCount.Add(float64(fd.MerkleTrieIndex + 1))
+}
+
+// RemoveDepositsAfterHeight removes the deposits, and the pending deposits, made after the given eth1
+// block height, which were made in blocks orphaned by an eth1 reorg. It returns the number of removed
+// deposits.
+func (dc *DepositCache) RemoveDepositsAfterHeight(ctx context.Context, blockHeight uint64) int {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.RemoveDepositsAfterHeight")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	// Deposits are sorted by merkle index, and thereby by block height.
+	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Eth1BlockHeight > blockHeight })
+	removed := len(dc.deposits) - heightIdx
+	dc.deposits = dc.deposits[:heightIdx]
+
+	var pendingDeposits []*dbpb.DepositContainer
+	for _, ctnr := range dc.pendingDeposits {
+		if ctnr.Eth1BlockHeight <= blockHeight {
+			pendingDeposits = append(pendingDeposits, ctnr)
+		}
+	}
+	dc.pendingDeposits = pendingDeposits
+	pendingDepositsCount.Set(float64(len(dc.pendingDeposits)))
+	span.AddAttributes(trace.Int64Attribute("count", int64(removed)))
+	return removed
+}
+
+// FinalizeDeposits collapses the deposits with a merkle index below the deposit index of the finalized
+// state into the trie of the finalized deposits, and removes their containers from the cache. Deposits
+// which were not yet processed from the deposit contract logs are finalized by a later call.
+func (dc *DepositCache) FinalizeDeposits(ctx context.Context, eth1DepositIndex uint64) error {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.FinalizeDeposits")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	lastIndex := int64(-1)
+	var depositTrie *trieutil.SparseMerkleTrie
+	if dc.finalizedDeposits != nil {
+		lastIndex = dc.finalizedDeposits.MerkleTrieIndex
+		depositTrie = dc.finalizedDeposits.Deposits.Copy()
+	} else {
+		var err error
+		depositTrie, err = trieutil.NewTrie(depth)
+		if err != nil {
+			return errors.Wrap(err, "could not create deposit trie")
+		}
+	}
+	if int64(eth1DepositIndex)-1 <= lastIndex {
+		return nil
+	}
+
+	finalized := 0
+	var eth1BlockHeight uint64
+	for _, ctnr := range dc.deposits {
+		if ctnr.Index >= int64(eth1DepositIndex) {
+			break
+		}
+		if ctnr.Index != lastIndex+1 {
+			return fmt.Errorf("wanted deposit with index %d to finalize, received %d", lastIndex+1, ctnr.Index)
+		}
+		depositHash, err := ssz.HashTreeRoot(ctnr.Deposit.Data)
+		if err != nil {
+			return errors.Wrap(err, "could not hash deposit data")
+		}
+		depositTrie.Insert(depositHash[:], int(ctnr.Index))
+		lastIndex = ctnr.Index
+		eth1BlockHeight = ctnr.Eth1BlockHeight
+		finalized++
+	}
+	if finalized == 0 {
+		return nil
+	}
+
+	// Only keep the roots of the complete subtrees covering the finalized deposits.
+	depositCount := uint64(lastIndex + 1)
+	branches, err := depositTrie.FinalizedBranches(depositCount)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized branches of deposit trie")
+	}
+	depositTrie, err = trieutil.CreateTrieFromFinalized(branches, depositCount, depth)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit trie from finalized branches")
+	}
+	dc.finalizedDeposits = &FinalizedDeposits{
+		Deposits:        depositTrie,
+		MerkleTrieIndex: lastIndex,
+		Eth1BlockHeight: eth1BlockHeight,
+	}
+	// Copy the remaining containers so that the finalized ones can be garbage collected.
+	dc.deposits = append([]*dbpb.DepositContainer{}, dc.deposits[finalized:]...)
+	span.AddAttributes(trace.Int64Attribute("count", int64(finalized)))
+	return nil
+}
+
+// FinalizedDeposits returns the trie of the finalized deposits, nil if no deposits were
+// finalized and the cache was not seeded from a deposit snapshot.
+func (dc *DepositCache) FinalizedDeposits(ctx context.Context) *FinalizedDeposits {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.FinalizedDeposits")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	return dc.finalizedDeposits
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
-// a certain public key within its deposit data.
+// a certain public key within its deposit data. Finalized deposits are not looked through.
 func (dc *DepositCache) DepositByPubkey(ctx context.Context, pubKey []byte) (*ethpb.Deposit, *big.Int) {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.DepositByPubkey")
 	defer span.End()
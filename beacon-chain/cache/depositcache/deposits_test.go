@@ -7,8 +7,10 @@ import (
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -273,3 +275,153 @@ func TestBeaconDB_DepositByPubkey_ReturnsFirstMatchingDeposit(t *testing.T) {
 		t.Errorf("Returned wrong block number %v", blkNum)
 	}
 }
+
+func TestBeaconDB_DepositsNumberAndRootAtHeight_FinalizedDeposits(t *testing.T) {
+	dc := NewDepositCache()
+	trie, err := trieutil.GenerateTrieFromItems([][]byte{[]byte("A"), []byte("BB"), []byte("CCC")}, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dc.InsertFinalizedDeposits(context.Background(), &FinalizedDeposits{
+		Deposits:        trie,
+		MerkleTrieIndex: 2,
+		Eth1BlockHeight: 10,
+	})
+	dc.deposits = []*dbpb.DepositContainer{
+		{
+			Index:           3,
+			Eth1BlockHeight: 11,
+			Deposit:         &ethpb.Deposit{},
+			DepositRoot:     []byte("root"),
+		},
+	}
+
+	tests := []struct {
+		height    int64
+		wantCount uint64
+		wantRoot  [32]byte
+	}{
+		{height: 9, wantCount: 0, wantRoot: [32]byte{}},
+		{height: 10, wantCount: 3, wantRoot: trie.Root()},
+		{height: 11, wantCount: 4, wantRoot: bytesutil.ToBytes32([]byte("root"))},
+	}
+	for _, tt := range tests {
+		n, root := dc.DepositsNumberAndRootAtHeight(context.Background(), big.NewInt(tt.height))
+		if n != tt.wantCount {
+			t.Errorf("Height %d: returned unexpected deposits number %d wanted %d", tt.height, n, tt.wantCount)
+		}
+		if root != tt.wantRoot {
+			t.Errorf("Height %d: returned unexpected root: %#x", tt.height, root)
+		}
+	}
+	if dc.FinalizedDeposits(context.Background()) == nil {
+		t.Error("Expected finalized deposits")
+	}
+}
+
+func TestFinalizeDeposits_CollapsesFinalizedDeposits(t *testing.T) {
+	ctx := context.Background()
+	dc := NewDepositCache()
+	var depositHashes [][]byte
+	for i := 0; i < 6; i++ {
+		deposit := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Amount:                32,
+				Signature:             make([]byte, 96),
+			},
+		}
+		depositHash, err := ssz.HashTreeRoot(deposit.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		depositHashes = append(depositHashes, depositHash[:])
+		dc.InsertDeposit(ctx, deposit, uint64(10+i), int64(i), [32]byte{byte(i)})
+	}
+
+	if err := dc.FinalizeDeposits(ctx, 4); err != nil {
+		t.Fatal(err)
+	}
+	fd := dc.FinalizedDeposits(ctx)
+	if fd == nil || fd.MerkleTrieIndex != 3 || fd.Eth1BlockHeight != 13 {
+		t.Fatalf("Unexpected finalized deposits %v", fd)
+	}
+	wantTrie, err := trieutil.GenerateTrieFromItems(depositHashes[:4], 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fd.Deposits.Root() != wantTrie.Root() {
+		t.Errorf("Wanted finalized deposit root %#x, received %#x", wantTrie.Root(), fd.Deposits.Root())
+	}
+	if len(dc.AllDepositContainers(ctx)) != 2 {
+		t.Errorf("Wanted 2 deposit containers, received %d", len(dc.AllDepositContainers(ctx)))
+	}
+	n, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(13))
+	if n != 4 || root != wantTrie.Root() {
+		t.Errorf("Unexpected deposits number %d and root %#x at height 13", n, root)
+	}
+	n, _ = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(15))
+	if n != 6 {
+		t.Errorf("Wanted 6 deposits at height 15, received %d", n)
+	}
+
+	// Deposits following the finalized deposits can be inserted into a copy of the finalized trie.
+	fullTrie, err := trieutil.GenerateTrieFromItems(depositHashes, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositTrie := fd.Deposits.Copy()
+	for i := 4; i < 6; i++ {
+		depositTrie.Insert(depositHashes[i], i)
+	}
+	if depositTrie.Root() != fullTrie.Root() {
+		t.Errorf("Wanted deposit root %#x, received %#x", fullTrie.Root(), depositTrie.Root())
+	}
+
+	// Finalizing fewer deposits does nothing.
+	if err := dc.FinalizeDeposits(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	if dc.FinalizedDeposits(ctx).MerkleTrieIndex != 3 {
+		t.Error("Expected finalized deposits to be unchanged")
+	}
+	// Deposits which were not processed yet are finalized later.
+	if err := dc.FinalizeDeposits(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+	fd = dc.FinalizedDeposits(ctx)
+	if fd.MerkleTrieIndex != 5 || fd.Deposits.Root() != fullTrie.Root() {
+		t.Errorf("Unexpected finalized deposits %v", fd)
+	}
+	if len(dc.AllDepositContainers(ctx)) != 0 {
+		t.Errorf("Wanted no deposit containers, received %d", len(dc.AllDepositContainers(ctx)))
+	}
+}
+
+func TestRemoveDepositsAfterHeight(t *testing.T) {
+	ctx := context.Background()
+	dc := NewDepositCache()
+	for i := 0; i < 5; i++ {
+		d := &ethpb.Deposit{Proof: [][]byte{{byte(i)}}}
+		dc.InsertDeposit(ctx, d, uint64(10+i), int64(i), [32]byte{})
+		dc.InsertPendingDeposit(ctx, d, uint64(10+i), int64(i), [32]byte{})
+	}
+
+	if removed := dc.RemoveDepositsAfterHeight(ctx, 12); removed != 2 {
+		t.Errorf("Wanted 2 removed deposits, received %d", removed)
+	}
+	if len(dc.AllDeposits(ctx, nil)) != 3 {
+		t.Errorf("Wanted 3 deposits, received %d", len(dc.AllDeposits(ctx, nil)))
+	}
+	if len(dc.PendingDeposits(ctx, nil)) != 3 {
+		t.Errorf("Wanted 3 pending deposits, received %d", len(dc.PendingDeposits(ctx, nil)))
+	}
+	n, _ := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(20))
+	if n != 3 {
+		t.Errorf("Wanted 3 deposits at height 20, received %d", n)
+	}
+	if removed := dc.RemoveDepositsAfterHeight(ctx, 12); removed != 0 {
+		t.Errorf("Wanted no removed deposits, received %d", removed)
+	}
+}
@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+var (
+	// maxDutiesCacheEpochs defines the max number of epochs of precomputed duties the cache keeps.
+	// Duties are precomputed for the current and the next epoch, the previous epoch is kept
+	// for validators lagging behind the epoch boundary.
+	maxDutiesCacheEpochs = uint64(3)
+
+	// Metrics.
+	dutiesCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "duties_cache_miss",
+		Help: "The number of duties requests that aren't present in the cache.",
+	})
+	dutiesCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "duties_cache_hit",
+		Help: "The number of duties requests that are present in the cache.",
+	})
+)
+
+// DutyAssignment is the precomputed attester assignment of a validator in an epoch.
+type DutyAssignment struct {
+	Committee      []uint64
+	CommitteeIndex uint64
+	AttesterSlot   uint64
+	// Subnet is the attestation subnet the committee publishes its attestations to.
+	Subnet uint64
+}
+
+// EpochDuties holds the shuffling derived duties of all validators in an epoch,
+// precomputed from the head state.
+type EpochDuties struct {
+	Epoch uint64
+	// HeadRoot is the root of the head block the duties were computed from.
+	HeadRoot [32]byte
+	// DependentRoot is the root of the last block of the epoch before the head's epoch, which
+	// the duties are derived from. Duties are recomputed when the head changes dependent root.
+	DependentRoot [32]byte
+	// ValidatorIndices maps validator public keys to their indices.
+	ValidatorIndices map[[48]byte]uint64
+	// Statuses holds the status of every validator in the epoch, by validator index.
+	Statuses []ethpb.ValidatorStatus
+	// Assignments maps validator indices to their attester assignment.
+	Assignments map[uint64]*DutyAssignment
+	// ProposerSlots maps validator indices to the slots they propose in.
+	ProposerSlots map[uint64][]uint64
+	// Subnets holds the attestation subnets of all the committees in the epoch.
+	Subnets []uint64
+}
+
+// DutiesCache keeps the duties precomputed for the latest epochs, by epoch.
+type DutiesCache struct {
+	duties map[uint64]*EpochDuties
+	lock   sync.RWMutex
+}
+
+// NewDutiesCache creates a new duties cache.
+func NewDutiesCache() *DutiesCache {
+	return &DutiesCache{
+		duties: make(map[uint64]*EpochDuties),
+	}
+}
+
+// Duties returns the precomputed duties of the epoch, nil if they are not in the cache.
+func (c *DutiesCache) Duties(epoch uint64) *EpochDuties {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	duties, ok := c.duties[epoch]
+	if !ok {
+		dutiesCacheMiss.Inc()
+		return nil
+	}
+	dutiesCacheHit.Inc()
+	return duties
+}
+
+// Put saves the precomputed duties of an epoch, replacing the ones computed before for the
+// same epoch. Duties of epochs too far behind the latest epoch are pruned.
+func (c *DutiesCache) Put(duties *EpochDuties) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.duties[duties.Epoch] = duties
+	var latest uint64
+	for epoch := range c.duties {
+		if epoch > latest {
+			latest = epoch
+		}
+	}
+	for epoch := range c.duties {
+		if epoch+maxDutiesCacheEpochs <= latest {
+			delete(c.duties, epoch)
+		}
+	}
+}
+
+// Clear removes all the precomputed duties, for instance after a reorg.
+func (c *DutiesCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.duties = make(map[uint64]*EpochDuties)
+}
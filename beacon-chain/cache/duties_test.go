@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"testing"
+)
+
+func TestDutiesCache_PutAndDuties(t *testing.T) {
+	c := NewDutiesCache()
+	if d := c.Duties(1); d != nil {
+		t.Errorf("Wanted no duties in an empty cache, got %v", d)
+	}
+
+	duties := &EpochDuties{
+		Epoch:         1,
+		HeadRoot:      [32]byte{'A'},
+		ProposerSlots: map[uint64][]uint64{3: {9}},
+	}
+	c.Put(duties)
+	if d := c.Duties(1); d != duties {
+		t.Errorf("Wanted %v, got %v", duties, d)
+	}
+
+	replaced := &EpochDuties{Epoch: 1, HeadRoot: [32]byte{'B'}}
+	c.Put(replaced)
+	if d := c.Duties(1); d != replaced {
+		t.Errorf("Wanted duties to be replaced by %v, got %v", replaced, d)
+	}
+}
+
+func TestDutiesCache_PrunesOldEpochs(t *testing.T) {
+	c := NewDutiesCache()
+	for epoch := uint64(0); epoch < 2*maxDutiesCacheEpochs; epoch++ {
+		c.Put(&EpochDuties{Epoch: epoch})
+	}
+	latest := 2*maxDutiesCacheEpochs - 1
+	for epoch := uint64(0); epoch <= latest; epoch++ {
+		d := c.Duties(epoch)
+		if epoch+maxDutiesCacheEpochs <= latest && d != nil {
+			t.Errorf("Wanted duties of epoch %d to be pruned", epoch)
+		}
+		if epoch+maxDutiesCacheEpochs > latest && d == nil {
+			t.Errorf("Wanted duties of epoch %d to be kept", epoch)
+		}
+	}
+
+	c.Clear()
+	if d := c.Duties(latest); d != nil {
+		t.Errorf("Wanted no duties after clearing the cache, got %v", d)
+	}
+}
@@ -75,3 +75,9 @@ func (c *HotStateCache) Has(root [32]byte) bool {
 func (c *HotStateCache) Delete(root [32]byte) bool {
 	return c.cache.Remove(root)
 }
+
+// Resize changes the number of hot states this can cache, evicting the oldest states if
+// the cache holds more than the new size. It returns the number of evicted states.
+func (c *HotStateCache) Resize(size int) int {
+	return c.cache.Resize(size)
+}
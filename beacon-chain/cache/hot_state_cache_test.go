@@ -44,3 +44,24 @@ func TestHotStateCache_RoundTrip(t *testing.T) {
 		t.Error("Cache not suppose to have the object")
 	}
 }
+
+func TestHotStateCache_Resize(t *testing.T) {
+	c := cache.NewHotStateCache()
+	for i := byte(0); i < 4; i++ {
+		state, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: uint64(i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Put([32]byte{i}, state)
+	}
+
+	if evicted := c.Resize(2); evicted != 2 {
+		t.Errorf("Wanted 2 evicted states, got %d", evicted)
+	}
+	if c.Has([32]byte{0}) || c.Has([32]byte{1}) {
+		t.Error("Oldest states should have been evicted")
+	}
+	if !c.Has([32]byte{2}) || !c.Has([32]byte{3}) {
+		t.Error("Newest states should have been kept")
+	}
+}
@@ -26,32 +26,37 @@ func New(ctx context.Context, state *stateTrie.BeaconState) ([]*Validator, *Bala
 	prevEpoch := helpers.PrevEpoch(state)
 
 	if err := state.ReadFromEveryValidator(func(idx int, val *stateTrie.ReadOnlyValidator) error {
-		// Was validator withdrawable or slashed
-		withdrawable := prevEpoch+1 >= val.WithdrawableEpoch()
-		pVal := &Validator{
-			IsSlashed:                    val.Slashed(),
-			IsWithdrawableCurrentEpoch:   withdrawable,
-			CurrentEpochEffectiveBalance: val.EffectiveBalance(),
-		}
-		// Was validator active current epoch
-		if helpers.IsActiveValidatorUsingTrie(val, currentEpoch) {
-			pVal.IsActiveCurrentEpoch = true
-			pBal.ActiveCurrentEpoch += val.EffectiveBalance()
-		}
-		// Was validator active previous epoch
-		if helpers.IsActiveValidatorUsingTrie(val, prevEpoch) {
-			pVal.IsActivePrevEpoch = true
-			pBal.ActivePrevEpoch += val.EffectiveBalance()
-		}
-		// Set inclusion slot and inclusion distance to be max, they will be compared and replaced
-		// with the lower values
-		pVal.InclusionSlot = params.BeaconConfig().FarFutureEpoch
-		pVal.InclusionDistance = params.BeaconConfig().FarFutureEpoch
-
-		pValidators[idx] = pVal
+		pValidators[idx] = newValidator(val, pBal, prevEpoch, currentEpoch)
 		return nil
 	}); err != nil {
 		return nil, nil, errors.Wrap(err, "failed to initialize precompute")
 	}
 	return pValidators, pBal, nil
 }
+
+// newValidator builds the pre computed record of a single validator and accumulates
+// its effective balance into the active balances of pBal.
+func newValidator(val *stateTrie.ReadOnlyValidator, pBal *Balance, prevEpoch uint64, currentEpoch uint64) *Validator {
+	// Was validator withdrawable or slashed
+	withdrawable := prevEpoch+1 >= val.WithdrawableEpoch()
+	pVal := &Validator{
+		IsSlashed:                    val.Slashed(),
+		IsWithdrawableCurrentEpoch:   withdrawable,
+		CurrentEpochEffectiveBalance: val.EffectiveBalance(),
+	}
+	// Was validator active current epoch
+	if helpers.IsActiveValidatorUsingTrie(val, currentEpoch) {
+		pVal.IsActiveCurrentEpoch = true
+		pBal.ActiveCurrentEpoch += val.EffectiveBalance()
+	}
+	// Was validator active previous epoch
+	if helpers.IsActiveValidatorUsingTrie(val, prevEpoch) {
+		pVal.IsActivePrevEpoch = true
+		pBal.ActivePrevEpoch += val.EffectiveBalance()
+	}
+	// Set inclusion slot and inclusion distance to be max, they will be compared and replaced
+	// with the lower values
+	pVal.InclusionSlot = params.BeaconConfig().FarFutureEpoch
+	pVal.InclusionDistance = params.BeaconConfig().FarFutureEpoch
+	return pVal
+}
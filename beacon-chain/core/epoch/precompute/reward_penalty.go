@@ -69,35 +69,47 @@ func AttestationsDelta(state *stateTrie.BeaconState, pBal *Balance, vp []*Valida
 }
 
 func attestationDelta(pBal *Balance, v *Validator, prevEpoch uint64, finalizedEpoch uint64) (uint64, uint64) {
+	return attestationRewards(pBal, v, prevEpoch, finalizedEpoch).attestationTotals()
+}
+
+// attestationTotals sums up the attestation rewards and penalties of the breakdown.
+func (d Rewards) attestationTotals() (uint64, uint64) {
+	r := d.SourceReward + d.TargetReward + d.HeadReward + d.InclusionDelayReward
+	p := d.SourcePenalty + d.TargetPenalty + d.HeadPenalty + d.InactivityPenalty
+	return r, p
+}
+
+// attestationRewards computes the breakdown of the attestation rewards and penalties of an
+// individual validator based on its voting record.
+func attestationRewards(pBal *Balance, v *Validator, prevEpoch uint64, finalizedEpoch uint64) Rewards {
+	d := Rewards{}
 	eligible := v.IsActivePrevEpoch || (v.IsSlashed && !v.IsWithdrawableCurrentEpoch)
 	if !eligible || pBal.ActiveCurrentEpoch == 0 {
-		return 0, 0
+		return d
 	}
 
 	baseRewardsPerEpoch := params.BeaconConfig().BaseRewardsPerEpoch
 	effectiveBalanceIncrement := params.BeaconConfig().EffectiveBalanceIncrement
 	vb := v.CurrentEpochEffectiveBalance
 	br := vb * params.BeaconConfig().BaseRewardFactor / mathutil.IntegerSquareRoot(pBal.ActiveCurrentEpoch) / baseRewardsPerEpoch
-	r, p := uint64(0), uint64(0)
 	currentEpochBalance := pBal.ActiveCurrentEpoch / effectiveBalanceIncrement
 
 	// Process source reward / penalty
 	if v.IsPrevEpochAttester && !v.IsSlashed {
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
 		maxAttesterReward := br - proposerReward
-		r += maxAttesterReward / v.InclusionDistance
+		d.InclusionDelayReward = maxAttesterReward / v.InclusionDistance
 
 		if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.SourceReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
-
+			d.SourceReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		d.SourcePenalty = br
 	}
 
 	// Process target reward / penalty
@@ -105,13 +117,13 @@ func attestationDelta(pBal *Balance, v *Validator, prevEpoch uint64, finalizedEp
 		if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.TargetReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochTargetAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.TargetReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		d.TargetPenalty = br
 	}
 
 	// Process head reward / penalty
@@ -119,13 +131,13 @@ func attestationDelta(pBal *Balance, v *Validator, prevEpoch uint64, finalizedEp
 		if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.HeadReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochHeadAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.HeadReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		d.HeadPenalty = br
 	}
 
 	// Process finality delay penalty
@@ -134,15 +146,15 @@ func attestationDelta(pBal *Balance, v *Validator, prevEpoch uint64, finalizedEp
 	if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 		// If validator is performing optimally, this cancels all rewards for a neutral balance.
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
-		p += baseRewardsPerEpoch*br - proposerReward
+		d.InactivityPenalty = baseRewardsPerEpoch*br - proposerReward
 		// Apply an additional penalty to validators that did not vote on the correct target or has been slashed.
 		// Equivalent to the following condition from the spec:
 		// `index not in get_unslashed_attesting_indices(state, matching_target_attestations)`
 		if !v.IsPrevEpochTargetAttester || v.IsSlashed {
-			p += vb * finalityDelay / params.BeaconConfig().InactivityPenaltyQuotient
+			d.InactivityPenalty += vb * finalityDelay / params.BeaconConfig().InactivityPenaltyQuotient
 		}
 	}
-	return r, p
+	return d
 }
 
 // ProposersDelta computes and returns the rewards and penalties differences for individual validators based on the
@@ -0,0 +1,295 @@
+package precompute
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// registryRecord is the flat copy of the validator registry fields which registry
+// updates and slashings read and write during epoch processing.
+type registryRecord struct {
+	activationEligibilityEpoch uint64
+	activationEpoch            uint64
+	exitEpoch                  uint64
+	withdrawableEpoch          uint64
+	updated                    bool
+}
+
+// exitQueue tracks the exit queue epoch and its churn so validators can be ejected
+// without rescanning the registry for every exit.
+type exitQueue struct {
+	epoch uint64
+	churn uint64
+	limit uint64
+}
+
+// ProcessEpochSinglePass processes justification and finalization, rewards and penalties,
+// registry updates and slashings of the epoch transition. Unlike calling New,
+// ProcessRewardsAndPenaltiesPrecompute, ProcessRegistryUpdates and ProcessSlashings in
+// sequence, the validator registry is read once into a flat structure and all the
+// per validator deltas and registry changes are then computed in a single sweep over it.
+// The pre computed validator records and balances are returned for reporting.
+func ProcessEpochSinglePass(
+	ctx context.Context,
+	state *stateTrie.BeaconState,
+) (*stateTrie.BeaconState, []*Validator, *Balance, error) {
+	ctx, span := trace.StartSpan(ctx, "precomputeEpoch.ProcessEpochSinglePass")
+	defer span.End()
+
+	state, vp, pBal, _, err := processEpochSinglePass(ctx, state, false)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, nil, err
+	}
+	return state, vp, pBal, nil
+}
+
+// ProcessEpochSinglePassWithRewards is ProcessEpochSinglePass which also returns the breakdown
+// of the rewards and penalties applied to every validator.
+func ProcessEpochSinglePassWithRewards(
+	ctx context.Context,
+	state *stateTrie.BeaconState,
+) (*stateTrie.BeaconState, []*Validator, []Rewards, error) {
+	ctx, span := trace.StartSpan(ctx, "precomputeEpoch.ProcessEpochSinglePassWithRewards")
+	defer span.End()
+
+	state, vp, _, rewards, err := processEpochSinglePass(ctx, state, true)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, nil, nil, err
+	}
+	return state, vp, rewards, nil
+}
+
+func processEpochSinglePass(
+	ctx context.Context,
+	state *stateTrie.BeaconState,
+	recordRewards bool,
+) (*stateTrie.BeaconState, []*Validator, *Balance, []Rewards, error) {
+	currentEpoch := helpers.CurrentEpoch(state)
+	prevEpoch := helpers.PrevEpoch(state)
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+
+	numOfVals := state.NumValidators()
+	if numOfVals != state.BalancesLength() {
+		return nil, nil, nil, nil, errors.New("validator registry not the same length as balances")
+	}
+	vp := make([]*Validator, numOfVals)
+	records := make([]registryRecord, numOfVals)
+	pBal := &Balance{}
+	queue := &exitQueue{}
+	activeCount := uint64(0)
+	if err := state.ReadFromEveryValidator(func(idx int, val *stateTrie.ReadOnlyValidator) error {
+		vp[idx] = newValidator(val, pBal, prevEpoch, currentEpoch)
+		if vp[idx].IsActiveCurrentEpoch {
+			activeCount++
+		}
+		exitEpoch := val.ExitEpoch()
+		records[idx] = registryRecord{
+			activationEligibilityEpoch: val.ActivationEligibilityEpoch(),
+			activationEpoch:            val.ActivationEpoch(),
+			exitEpoch:                  exitEpoch,
+			withdrawableEpoch:          val.WithdrawableEpoch(),
+		}
+		if exitEpoch != farFutureEpoch {
+			if exitEpoch > queue.epoch {
+				queue.epoch = exitEpoch
+				queue.churn = 0
+			}
+			if exitEpoch == queue.epoch {
+				queue.churn++
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "failed to initialize precompute")
+	}
+	if activationExitEpoch := helpers.ActivationExitEpoch(currentEpoch); activationExitEpoch > queue.epoch {
+		queue.epoch = activationExitEpoch
+		queue.churn = 0
+	}
+	churnLimit, err := helpers.ValidatorChurnLimit(activeCount)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "could not get churn limit")
+	}
+	queue.limit = churnLimit
+
+	vp, pBal, err = ProcessAttestations(ctx, state, vp, pBal)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	state, err = ProcessJustificationAndFinalizationPreCompute(state, pBal)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "could not process justification")
+	}
+
+	var breakdown []Rewards
+	if recordRewards {
+		breakdown = make([]Rewards, numOfVals)
+	}
+	rewards, penalties, slashings, activationQ, err := processValidators(state, pBal, vp, records, queue, breakdown)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	// Only activate just enough validators according to the activation churn limit.
+	sort.Slice(activationQ, func(i, j int) bool {
+		a, b := activationQ[i], activationQ[j]
+		if records[a].activationEligibilityEpoch == records[b].activationEligibilityEpoch {
+			return a < b
+		}
+		return records[a].activationEligibilityEpoch < records[b].activationEligibilityEpoch
+	})
+	if uint64(len(activationQ)) > churnLimit {
+		activationQ = activationQ[:churnLimit]
+	}
+	activationEpoch := helpers.ActivationExitEpoch(currentEpoch)
+	for _, idx := range activationQ {
+		records[idx].activationEpoch = activationEpoch
+		records[idx].updated = true
+	}
+
+	if err := applyBalances(state, vp, rewards, penalties, slashings); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	for idx := range records {
+		if !records[idx].updated {
+			continue
+		}
+		val, err := state.ValidatorAtIndex(uint64(idx))
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		val.ActivationEligibilityEpoch = records[idx].activationEligibilityEpoch
+		val.ActivationEpoch = records[idx].activationEpoch
+		val.ExitEpoch = records[idx].exitEpoch
+		val.WithdrawableEpoch = records[idx].withdrawableEpoch
+		if err := state.UpdateValidatorAtIndex(uint64(idx), val); err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+
+	return state, vp, pBal, breakdown, nil
+}
+
+// processValidators is the single sweep over the flat validator records. It returns the
+// combined attestation and proposer rewards, the attestation penalties, the slashing penalties
+// and the indices eligible for activation, while recording activation eligibility and ejections
+// in records. The breakdown of the rewards and penalties is recorded in breakdown when it is not nil.
+func processValidators(
+	state *stateTrie.BeaconState,
+	pBal *Balance,
+	vp []*Validator,
+	records []registryRecord,
+	queue *exitQueue,
+	breakdown []Rewards,
+) ([]uint64, []uint64, []uint64, []uint64, error) {
+	currentEpoch := helpers.CurrentEpoch(state)
+	prevEpoch := helpers.PrevEpoch(state)
+	finalizedEpoch := state.FinalizedCheckpointEpoch()
+	cfg := params.BeaconConfig()
+
+	// Rewards and penalties are not processed in the genesis epoch.
+	processRewards := currentEpoch != 0
+	balanceSqrt := mathutil.IntegerSquareRoot(pBal.ActiveCurrentEpoch)
+	// Balance square root cannot be 0, this prevents division by 0.
+	if balanceSqrt == 0 {
+		balanceSqrt = 1
+	}
+
+	totalSlashing := uint64(0)
+	for _, slashing := range state.Slashings() {
+		totalSlashing += slashing
+	}
+	minSlashing := mathutil.Min(totalSlashing*3, pBal.ActiveCurrentEpoch)
+	epochToWithdraw := currentEpoch + cfg.EpochsPerSlashingsVector/2
+	activationEligibilityEpoch := currentEpoch + 1
+
+	rewards := make([]uint64, len(vp))
+	penalties := make([]uint64, len(vp))
+	slashings := make([]uint64, len(vp))
+	var activationQ []uint64
+	for i, v := range vp {
+		rec := &records[i]
+
+		// Rewards and penalties.
+		if processRewards {
+			d := attestationRewards(pBal, v, prevEpoch, finalizedEpoch)
+			r, p := d.attestationTotals()
+			rewards[i] += r
+			penalties[i] += p
+			if breakdown != nil {
+				// Keep the proposer rewards credited by previously swept validators.
+				d.ProposerReward = breakdown[i].ProposerReward
+				breakdown[i] = d
+			}
+			// Only apply inclusion rewards to proposer only if the attested hasn't been slashed.
+			if v.IsPrevEpochAttester && !v.IsSlashed {
+				if v.ProposerIndex >= uint64(len(vp)) {
+					return nil, nil, nil, nil, errors.Errorf("proposer index %d out of range", v.ProposerIndex)
+				}
+				baseReward := v.CurrentEpochEffectiveBalance * cfg.BaseRewardFactor / balanceSqrt / cfg.BaseRewardsPerEpoch
+				proposerReward := baseReward / cfg.ProposerRewardQuotient
+				rewards[v.ProposerIndex] += proposerReward
+				if breakdown != nil {
+					breakdown[v.ProposerIndex].ProposerReward += proposerReward
+				}
+			}
+		}
+
+		// Registry updates: activation eligibility and ejections.
+		if rec.activationEligibilityEpoch == cfg.FarFutureEpoch && v.CurrentEpochEffectiveBalance == cfg.MaxEffectiveBalance {
+			rec.activationEligibilityEpoch = activationEligibilityEpoch
+			rec.updated = true
+		}
+		if v.IsActiveCurrentEpoch && v.CurrentEpochEffectiveBalance <= cfg.EjectionBalance && rec.exitEpoch == cfg.FarFutureEpoch {
+			if queue.churn >= queue.limit {
+				queue.epoch++
+				queue.churn = 0
+			}
+			queue.churn++
+			rec.exitEpoch = queue.epoch
+			rec.withdrawableEpoch = queue.epoch + cfg.MinValidatorWithdrawabilityDelay
+			rec.updated = true
+		}
+		if rec.activationEligibilityEpoch <= finalizedEpoch && rec.activationEpoch == cfg.FarFutureEpoch {
+			activationQ = append(activationQ, uint64(i))
+		}
+
+		// Slashings.
+		if v.IsSlashed && rec.withdrawableEpoch == epochToWithdraw {
+			increment := cfg.EffectiveBalanceIncrement
+			penaltyNumerator := v.CurrentEpochEffectiveBalance / increment * minSlashing
+			slashings[i] = penaltyNumerator / pBal.ActiveCurrentEpoch * increment
+			if breakdown != nil {
+				breakdown[i].SlashingPenalty = slashings[i]
+			}
+		}
+	}
+	return rewards, penalties, slashings, activationQ, nil
+}
+
+// applyBalances writes the computed deltas back to the state balances. As with the multi pass
+// processing, the recorded balance after the epoch transition excludes slashing penalties.
+func applyBalances(state *stateTrie.BeaconState, vp []*Validator, rewards, penalties, slashings []uint64) error {
+	validatorBals := state.Balances()
+	for i := range validatorBals {
+		vp[i].BeforeEpochTransitionBalance = validatorBals[i]
+		validatorBals[i] = helpers.IncreaseBalanceWithVal(validatorBals[i], rewards[i])
+		validatorBals[i] = helpers.DecreaseBalanceWithVal(validatorBals[i], penalties[i])
+		vp[i].AfterEpochTransitionBalance = validatorBals[i]
+		validatorBals[i] = helpers.DecreaseBalanceWithVal(validatorBals[i], slashings[i])
+	}
+	if err := state.SetBalances(validatorBals); err != nil {
+		return errors.Wrap(err, "could not set validator balances")
+	}
+	return nil
+}
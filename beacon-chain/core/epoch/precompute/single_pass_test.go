@@ -0,0 +1,211 @@
+package precompute
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestProcessEpochSinglePass_MatchesMultiPass(t *testing.T) {
+	s := buildSinglePassState(t, 2048)
+
+	want, err := processEpochMultiPass(context.Background(), s.Copy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, vp, _, err := ProcessEpochSinglePass(context.Background(), s.Copy())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got.Balances(), want.Balances()) {
+		t.Error("Balances do not match multi pass epoch processing")
+	}
+	gotVals, wantVals := got.Validators(), want.Validators()
+	for i := range wantVals {
+		if !proto.Equal(gotVals[i], wantVals[i]) {
+			t.Errorf("Validator %d: wanted %v, got %v", i, wantVals[i], gotVals[i])
+		}
+	}
+	if !proto.Equal(got.FinalizedCheckpoint(), want.FinalizedCheckpoint()) {
+		t.Error("Finalized checkpoint does not match multi pass epoch processing")
+	}
+	if vp[0].BeforeEpochTransitionBalance != s.Balances()[0] {
+		t.Errorf("Wanted before transition balance %d, got %d", s.Balances()[0], vp[0].BeforeEpochTransitionBalance)
+	}
+}
+
+func TestProcessEpochSinglePass_EjectionsRespectChurnLimit(t *testing.T) {
+	base := buildState(params.BeaconConfig().SlotsPerEpoch*10, 64)
+	base.JustificationBits = bitfield.Bitvector4{0x00}
+	for i := 0; i < 10; i++ {
+		base.Validators[i].EffectiveBalance = params.BeaconConfig().EjectionBalance
+	}
+	s, err := state.InitializeFromProto(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _, _, err = ProcessEpochSinglePass(context.Background(), s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The churn limit spreads the ejections over several exit epochs.
+	exitEpochs := make(map[uint64]int)
+	for i := 0; i < 10; i++ {
+		v, err := s.ValidatorAtIndex(uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		exitEpochs[v.ExitEpoch]++
+	}
+	churnLimit := int(params.BeaconConfig().MinPerEpochChurnLimit)
+	for e, count := range exitEpochs {
+		if count > churnLimit {
+			t.Errorf("Exit epoch %d has %d exits, wanted at most %d", e, count, churnLimit)
+		}
+	}
+}
+
+func TestProcessEpochSinglePassWithRewards_BreakdownMatchesBalances(t *testing.T) {
+	s := buildSinglePassState(t, 2048)
+
+	got, vp, rewards, err := ProcessEpochSinglePassWithRewards(context.Background(), s.Copy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _, _, err := ProcessEpochSinglePass(context.Background(), s.Copy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Balances(), want.Balances()) {
+		t.Error("Recording rewards changed the processed balances")
+	}
+	if len(rewards) != len(vp) {
+		t.Fatalf("Wanted %d rewards, got %d", len(vp), len(rewards))
+	}
+
+	var proposerRewards, slashingPenalties uint64
+	for i, r := range rewards {
+		gained := r.SourceReward + r.TargetReward + r.HeadReward + r.InclusionDelayReward + r.ProposerReward
+		lost := r.SourcePenalty + r.TargetPenalty + r.HeadPenalty + r.InactivityPenalty
+		wantBal := vp[i].BeforeEpochTransitionBalance + gained
+		if wantBal > lost {
+			wantBal -= lost
+		} else {
+			wantBal = 0
+		}
+		if vp[i].AfterEpochTransitionBalance != wantBal {
+			t.Errorf("Validator %d: wanted balance %d from breakdown, got %d", i, wantBal, vp[i].AfterEpochTransitionBalance)
+		}
+		proposerRewards += r.ProposerReward
+		slashingPenalties += r.SlashingPenalty
+	}
+	if proposerRewards == 0 {
+		t.Error("Wanted proposer rewards in the breakdown")
+	}
+	if slashingPenalties == 0 {
+		t.Error("Wanted slashing penalties in the breakdown")
+	}
+}
+
+func BenchmarkProcessEpoch_MultiPass(b *testing.B) {
+	s := buildSinglePassState(b, 16384)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := processEpochMultiPass(context.Background(), s.Copy()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkProcessEpoch_SinglePass(b *testing.B) {
+	s := buildSinglePassState(b, 16384)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, _, err := ProcessEpochSinglePass(context.Background(), s.Copy()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// processEpochMultiPass runs the epoch processing steps which ProcessEpochSinglePass replaces
+// one after another, each sweeping over the validator registry.
+func processEpochMultiPass(ctx context.Context, s *state.BeaconState) (*state.BeaconState, error) {
+	vp, bp, err := New(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	vp, bp, err = ProcessAttestations(ctx, s, vp, bp)
+	if err != nil {
+		return nil, err
+	}
+	s, err = ProcessJustificationAndFinalizationPreCompute(s, bp)
+	if err != nil {
+		return nil, err
+	}
+	s, err = ProcessRewardsAndPenaltiesPrecompute(s, bp, vp)
+	if err != nil {
+		return nil, err
+	}
+	s, err = epoch.ProcessRegistryUpdates(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := ProcessSlashingsPrecompute(s, bp); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// buildSinglePassState builds a state with attestations, slashed validators due for their
+// slashing penalty, validators to eject and validators waiting for activation.
+func buildSinglePassState(t testing.TB, validatorCount uint64) *state.BeaconState {
+	cfg := params.BeaconConfig()
+	base := buildState(cfg.SlotsPerEpoch+3, validatorCount)
+	atts := make([]*pb.PendingAttestation, 3)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Target: &ethpb.Checkpoint{},
+				Source: &ethpb.Checkpoint{},
+			},
+			AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+			InclusionDelay:  uint64(i + 1),
+		}
+	}
+	base.PreviousEpochAttestations = atts
+	base.Slashings[0] = 10 * cfg.MaxEffectiveBalance
+
+	currentEpoch := base.Slot / cfg.SlotsPerEpoch
+	for i := uint64(0); i < validatorCount; i++ {
+		v := base.Validators[i]
+		switch i % 16 {
+		case 1:
+			v.Slashed = true
+			v.ExitEpoch = currentEpoch
+			v.WithdrawableEpoch = currentEpoch + cfg.EpochsPerSlashingsVector/2
+		case 3:
+			v.EffectiveBalance = cfg.EjectionBalance
+		case 5:
+			v.ActivationEligibilityEpoch = cfg.FarFutureEpoch
+			v.ActivationEpoch = cfg.FarFutureEpoch
+		case 7:
+			v.ActivationEpoch = cfg.FarFutureEpoch
+		}
+	}
+
+	s, err := state.InitializeFromProto(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
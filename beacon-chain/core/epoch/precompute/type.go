@@ -58,3 +58,29 @@ type Balance struct {
 	// correctly for head block during prev epoch.
 	PrevEpochHeadAttested uint64
 }
+
+// Rewards stores the breakdown of the rewards and penalties an individual validator received
+// during an epoch transition. The source, target, head and inclusion delay components account
+// for the validator's attestation duties of the previous epoch.
+type Rewards struct {
+	// SourceReward is the reward for attesting to the correct source.
+	SourceReward uint64
+	// SourcePenalty is the penalty for not attesting to the correct source.
+	SourcePenalty uint64
+	// TargetReward is the reward for attesting to the correct target.
+	TargetReward uint64
+	// TargetPenalty is the penalty for not attesting to the correct target.
+	TargetPenalty uint64
+	// HeadReward is the reward for attesting to the correct head.
+	HeadReward uint64
+	// HeadPenalty is the penalty for not attesting to the correct head.
+	HeadPenalty uint64
+	// InclusionDelayReward is the reward for how fast the validator's attestation was included.
+	InclusionDelayReward uint64
+	// ProposerReward is the reward for including other validators' attestations in proposed blocks.
+	ProposerReward uint64
+	// InactivityPenalty is the penalty applied while the chain is in an inactivity leak.
+	InactivityPenalty uint64
+	// SlashingPenalty is the penalty applied to a slashed validator halfway to its withdrawable epoch.
+	SlashingPenalty uint64
+}
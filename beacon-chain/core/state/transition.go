@@ -88,7 +88,7 @@ func ExecuteStateTransition(
 // as possible. This function should only be used when we can trust the data we're receiving entirely, such as
 // initial sync or for processing past accepted blocks.
 //
-// WARNING: This method does not validate any signatures in a block. This method also modifies the passed in state.
+// WARNING: This method does not validate any attestation signatures in a block. This method also modifies the passed in state.
 //
 // Spec pseudocode definition:
 //  def state_transition(state: BeaconState, block: BeaconBlock, validate_state_root: bool=False) -> BeaconState:
@@ -383,7 +383,7 @@ func ProcessBlock(
 
 // ProcessBlockNoVerifyAttSigs creates a new, modified beacon state by applying block operation
 // transformations as defined in the Ethereum Serenity specification. It does not validate
-// block attestation signatures.
+// block attestation signatures, all the other block operations are fully verified.
 //
 // Spec pseudocode definition:
 //
@@ -418,7 +418,7 @@ func ProcessBlockNoVerifyAttSigs(
 		return nil, errors.Wrap(err, "could not process eth1 data")
 	}
 
-	state, err = ProcessOperationsNoVerify(ctx, state, signed.Block.Body)
+	state, err = ProcessOperationsNoVerifyAttSigs(ctx, state, signed.Block.Body)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, errors.Wrap(err, "could not process block operation")
@@ -544,6 +544,46 @@ func ProcessOperationsNoVerify(
 	return state, nil
 }
 
+// ProcessOperationsNoVerifyAttSigs processes the operations in the beacon block and updates beacon state
+// with the operations in block. It does not verify attestation signatures, which is used when the
+// signatures of the block attestations have already been verified in batch.
+//
+// WARNING: This method does not verify attestation signatures.
+func ProcessOperationsNoVerifyAttSigs(
+	ctx context.Context,
+	state *stateTrie.BeaconState,
+	body *ethpb.BeaconBlockBody) (*stateTrie.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessOperationsNoVerifyAttSigs")
+	defer span.End()
+
+	if err := verifyOperationLengths(state, body); err != nil {
+		return nil, errors.Wrap(err, "could not verify operation lengths")
+	}
+
+	state, err := b.ProcessProposerSlashings(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block proposer slashings")
+	}
+	state, err = b.ProcessAttesterSlashings(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attester slashings")
+	}
+	state, err = b.ProcessAttestationsNoVerify(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attestations")
+	}
+	state, err = b.ProcessDeposits(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block validator deposits")
+	}
+	state, err = b.ProcessVoluntaryExits(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process validator exits")
+	}
+
+	return state, nil
+}
+
 func verifyOperationLengths(state *stateTrie.BeaconState, body *ethpb.BeaconBlockBody) error {
 	if uint64(len(body.ProposerSlashings)) > params.BeaconConfig().MaxProposerSlashings {
 		return fmt.Errorf(
@@ -612,42 +652,44 @@ func ProcessEpochPrecompute(ctx context.Context, state *stateTrie.BeaconState) (
 	if state == nil {
 		return nil, errors.New("nil state")
 	}
-	vp, bp, err := precompute.New(ctx, state)
-	if err != nil {
-		return nil, err
-	}
-	vp, bp, err = precompute.ProcessAttestations(ctx, state, vp, bp)
+	state, vp, _, err := precompute.ProcessEpochSinglePass(ctx, state)
 	if err != nil {
 		return nil, err
 	}
 
 	ValidatorSummary = vp
 
-	state, err = precompute.ProcessJustificationAndFinalizationPreCompute(state, bp)
+	state, err = e.ProcessFinalUpdates(state)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not process justification")
+		return nil, errors.Wrap(err, "could not process final updates")
 	}
+	return state, nil
+}
 
-	state, err = precompute.ProcessRewardsAndPenaltiesPrecompute(state, bp, vp)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not process rewards and penalties")
-	}
+// EpochRewards computes the breakdown of the rewards and penalties every validator receives in the
+// epoch transition at the end of the state's epoch. The state must be at the last slot of its epoch
+// and is not modified.
+func EpochRewards(ctx context.Context, state *stateTrie.BeaconState) ([]precompute.Rewards, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.EpochRewards")
+	defer span.End()
 
-	state, err = e.ProcessRegistryUpdates(state)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not process registry updates")
+	if state == nil {
+		return nil, errors.New("nil state")
 	}
-
-	err = precompute.ProcessSlashingsPrecompute(state, bp)
+	if !CanProcessEpoch(state) {
+		return nil, fmt.Errorf("expected state at the last slot of an epoch, received slot %d", state.Slot())
+	}
+	state, err := ProcessSlot(ctx, state.Copy())
 	if err != nil {
-		return nil, err
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process slot")
 	}
-
-	state, err = e.ProcessFinalUpdates(state)
+	_, _, rewards, err := precompute.ProcessEpochSinglePassWithRewards(ctx, state)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not process final updates")
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process epoch")
 	}
-	return state, nil
+	return rewards, nil
 }
 
 // ProcessBlockForStateRoot processes the state for state root computation. It skips proposer signature
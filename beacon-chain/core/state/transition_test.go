@@ -648,6 +648,44 @@ func TestProcessEpochPrecompute_CanProcess(t *testing.T) {
 		t.Errorf("Wanted slashed balance: %d, got: %d", wanted, newState.Slashings()[2])
 	}
 }
+
+func TestEpochRewards_DoesNotModifyState(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	lastSlot := 2*params.BeaconConfig().SlotsPerEpoch - 1
+	beaconState, err := state.ProcessSlots(context.Background(), beaconState, lastSlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	balances := beaconState.Balances()
+
+	rewards, err := state.EpochRewards(context.Background(), beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rewards) != 64 {
+		t.Errorf("Wanted %d rewards, got %d", 64, len(rewards))
+	}
+	// Nobody attested in the previous epoch so every active validator is penalized.
+	if rewards[0].SourcePenalty == 0 || rewards[0].TargetPenalty == 0 || rewards[0].HeadPenalty == 0 {
+		t.Errorf("Wanted attestation penalties, got %+v", rewards[0])
+	}
+	if beaconState.Slot() != lastSlot {
+		t.Errorf("Wanted slot %d, got %d", lastSlot, beaconState.Slot())
+	}
+	for i, b := range beaconState.Balances() {
+		if b != balances[i] {
+			t.Fatalf("Balance of validator %d changed from %d to %d", i, balances[i], b)
+		}
+	}
+
+	if err := beaconState.SetSlot(lastSlot + 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := state.EpochRewards(context.Background(), beaconState); err == nil {
+		t.Error("Expected error for a state not at the end of an epoch")
+	}
+}
+
 func BenchmarkProcessBlk_65536Validators_FullBlock(b *testing.B) {
 	logrus.SetLevel(logrus.PanicLevel)
 
@@ -1092,6 +1130,30 @@ func TestProcessOperations_IncorrectDeposits(t *testing.T) {
 	}
 }
 
+func TestProcessOperationsNoVerifyAttSigs_VerifiesExits(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	body := &ethpb.BeaconBlockBody{
+		VoluntaryExits: []*ethpb.SignedVoluntaryExit{
+			{
+				Exit:      &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 0},
+				Signature: make([]byte, 96),
+			},
+		},
+	}
+
+	if _, err := state.ProcessOperationsNoVerify(context.Background(), beaconState.Copy(), body); err != nil {
+		t.Fatalf("Unexpected error skipping exit verification: %v", err)
+	}
+	want := "could not process validator exits"
+	if _, err := state.ProcessOperationsNoVerifyAttSigs(
+		context.Background(),
+		beaconState.Copy(),
+		body,
+	); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
 func TestProcessSlots_SameSlotAsParentState(t *testing.T) {
 	slot := uint64(2)
 	parentState, err := beaconstate.InitializeFromProto(&pb.BeaconState{Slot: slot})
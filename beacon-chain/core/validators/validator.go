@@ -164,6 +164,27 @@ func SlashValidator(state *stateTrie.BeaconState, slashedIdx uint64) (*stateTrie
 	return state, nil
 }
 
+// AssignmentStatus determines the status of a validator in the given epoch.
+func AssignmentStatus(validator *stateTrie.ReadOnlyValidator, epoch uint64) ethpb.ValidatorStatus {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	if epoch < validator.ActivationEligibilityEpoch() {
+		return ethpb.ValidatorStatus_DEPOSITED
+	}
+	if epoch < validator.ActivationEpoch() {
+		return ethpb.ValidatorStatus_PENDING
+	}
+	if validator.ExitEpoch() == farFutureEpoch {
+		return ethpb.ValidatorStatus_ACTIVE
+	}
+	if epoch < validator.ExitEpoch() {
+		if validator.Slashed() {
+			return ethpb.ValidatorStatus_SLASHING
+		}
+		return ethpb.ValidatorStatus_EXITING
+	}
+	return ethpb.ValidatorStatus_EXITED
+}
+
 // ActivatedValidatorIndices determines the indices activated during the given epoch.
 func ActivatedValidatorIndices(epoch uint64, validators []*ethpb.Validator) []uint64 {
 	activations := make([]uint64, 0)
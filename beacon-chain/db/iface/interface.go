@@ -37,6 +37,7 @@ type ReadOnlyDatabase interface {
 	HasState(ctx context.Context, blockRoot [32]byte) bool
 	StateSummary(ctx context.Context, blockRoot [32]byte) (*ethereum_beacon_p2p_v1.StateSummary, error)
 	HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
+	StateDiff(ctx context.Context, blockRoot [32]byte) (*db.StateDiff, error)
 	HighestSlotStates(ctx context.Context) ([]*state.BeaconState, error)
 	HighestSlotStatesBelow(ctx context.Context, slot uint64) ([]*state.BeaconState, error)
 	// Slashing operations.
@@ -47,14 +48,24 @@ type ReadOnlyDatabase interface {
 	// Block operations.
 	VoluntaryExit(ctx context.Context, exitRoot [32]byte) (*eth.VoluntaryExit, error)
 	HasVoluntaryExit(ctx context.Context, exitRoot [32]byte) bool
+	// Operation pool related methods.
+	PoolVoluntaryExits(ctx context.Context) ([]*eth.SignedVoluntaryExit, error)
+	PoolProposerSlashings(ctx context.Context) ([]*eth.ProposerSlashing, error)
+	PoolAttesterSlashings(ctx context.Context) ([]*eth.AttesterSlashing, error)
 	// Checkpoint operations.
 	JustifiedCheckpoint(ctx context.Context) (*eth.Checkpoint, error)
 	FinalizedCheckpoint(ctx context.Context) (*eth.Checkpoint, error)
+	InitialSyncTarget(ctx context.Context) (*eth.Checkpoint, error)
+	// Fork choice related methods.
+	ForkChoice(ctx context.Context) (*db.ForkChoiceNodes, error)
+	// Reorg related methods.
+	Reorgs(ctx context.Context, startSlot uint64, endSlot uint64) ([]*db.Reorg, error)
 	// Archival data handlers for storing/retrieving historical beacon node information.
 	ArchivedActiveValidatorChanges(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedActiveSetChanges, error)
 	ArchivedCommitteeInfo(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedCommitteeInfo, error)
 	ArchivedBalances(ctx context.Context, epoch uint64) ([]uint64, error)
 	ArchivedValidatorParticipation(ctx context.Context, epoch uint64) (*eth.ValidatorParticipation, error)
+	ArchivedValidatorRewards(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedValidatorRewards, error)
 	ArchivedPointRoot(ctx context.Context, index uint64) [32]byte
 	HasArchivedPoint(ctx context.Context, index uint64) bool
 	LastArchivedIndexRoot(ctx context.Context) [32]byte
@@ -63,6 +74,7 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	Eth1Headers(ctx context.Context, startNumber uint64, endNumber uint64) ([]*db.ETH1Header, error)
 }
 
 // NoHeadAccessDatabase -- See github.com/prysmaticlabs/prysm/beacon-chain/db.NoHeadAccessDatabase
@@ -87,6 +99,7 @@ type NoHeadAccessDatabase interface {
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
 	SaveStateSummary(ctx context.Context, summary *ethereum_beacon_p2p_v1.StateSummary) error
 	SaveStateSummaries(ctx context.Context, summaries []*ethereum_beacon_p2p_v1.StateSummary) error
+	SaveStateDiff(ctx context.Context, diff *db.StateDiff, blockRoot [32]byte) error
 	// Slashing operations.
 	SaveProposerSlashing(ctx context.Context, slashing *eth.ProposerSlashing) error
 	SaveAttesterSlashing(ctx context.Context, slashing *eth.AttesterSlashing) error
@@ -95,20 +108,32 @@ type NoHeadAccessDatabase interface {
 	// Block operations.
 	SaveVoluntaryExit(ctx context.Context, exit *eth.VoluntaryExit) error
 	DeleteVoluntaryExit(ctx context.Context, exitRoot [32]byte) error
+	// Operation pool related methods.
+	SavePoolVoluntaryExits(ctx context.Context, exits []*eth.SignedVoluntaryExit) error
+	SavePoolProposerSlashings(ctx context.Context, slashings []*eth.ProposerSlashing) error
+	SavePoolAttesterSlashings(ctx context.Context, slashings []*eth.AttesterSlashing) error
 	// Checkpoint operations.
 	SaveJustifiedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error
 	SaveFinalizedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error
+	SaveInitialSyncTarget(ctx context.Context, checkpoint *eth.Checkpoint) error
+	// Fork choice related methods.
+	SaveForkChoice(ctx context.Context, forkChoice *db.ForkChoiceNodes) error
+	// Reorg related methods.
+	SaveReorg(ctx context.Context, reorg *db.Reorg) error
 	// Archival data handlers for storing/retrieving historical beacon node information.
 	SaveArchivedActiveValidatorChanges(ctx context.Context, epoch uint64, changes *ethereum_beacon_p2p_v1.ArchivedActiveSetChanges) error
 	SaveArchivedCommitteeInfo(ctx context.Context, epoch uint64, info *ethereum_beacon_p2p_v1.ArchivedCommitteeInfo) error
 	SaveArchivedBalances(ctx context.Context, epoch uint64, balances []uint64) error
 	SaveArchivedValidatorParticipation(ctx context.Context, epoch uint64, part *eth.ValidatorParticipation) error
+	SaveArchivedValidatorRewards(ctx context.Context, epoch uint64, rewards *ethereum_beacon_p2p_v1.ArchivedValidatorRewards) error
 	SaveArchivedPointRoot(ctx context.Context, blockRoot [32]byte, index uint64) error
 	SaveLastArchivedIndex(ctx context.Context, index uint64) error
 	// Deposit contract related handlers.
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	SaveEth1Headers(ctx context.Context, headers []*db.ETH1Header) error
+	DeleteEth1Headers(ctx context.Context, startNumber uint64, endNumber uint64) error
 }
 
 // HeadAccessDatabase -- See github.com/prysmaticlabs/prysm/beacon-chain/db.HeadAccessDatabase
@@ -153,6 +153,21 @@ func (e Exporter) DeleteVoluntaryExit(ctx context.Context, exitRoot [32]byte) er
 	return e.db.DeleteVoluntaryExit(ctx, exitRoot)
 }
 
+// PoolVoluntaryExits -- passthrough.
+func (e Exporter) PoolVoluntaryExits(ctx context.Context) ([]*eth.SignedVoluntaryExit, error) {
+	return e.db.PoolVoluntaryExits(ctx)
+}
+
+// PoolProposerSlashings -- passthrough.
+func (e Exporter) PoolProposerSlashings(ctx context.Context) ([]*eth.ProposerSlashing, error) {
+	return e.db.PoolProposerSlashings(ctx)
+}
+
+// PoolAttesterSlashings -- passthrough.
+func (e Exporter) PoolAttesterSlashings(ctx context.Context) ([]*eth.AttesterSlashing, error) {
+	return e.db.PoolAttesterSlashings(ctx)
+}
+
 // JustifiedCheckpoint -- passthrough.
 func (e Exporter) JustifiedCheckpoint(ctx context.Context) (*eth.Checkpoint, error) {
 	return e.db.JustifiedCheckpoint(ctx)
@@ -163,6 +178,21 @@ func (e Exporter) FinalizedCheckpoint(ctx context.Context) (*eth.Checkpoint, err
 	return e.db.FinalizedCheckpoint(ctx)
 }
 
+// InitialSyncTarget -- passthrough.
+func (e Exporter) InitialSyncTarget(ctx context.Context) (*eth.Checkpoint, error) {
+	return e.db.InitialSyncTarget(ctx)
+}
+
+// ForkChoice -- passthrough.
+func (e Exporter) ForkChoice(ctx context.Context) (*db.ForkChoiceNodes, error) {
+	return e.db.ForkChoice(ctx)
+}
+
+// Reorgs -- passthrough.
+func (e Exporter) Reorgs(ctx context.Context, startSlot uint64, endSlot uint64) ([]*db.Reorg, error) {
+	return e.db.Reorgs(ctx, startSlot, endSlot)
+}
+
 // ArchivedActiveValidatorChanges -- passthrough.
 func (e Exporter) ArchivedActiveValidatorChanges(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedActiveSetChanges, error) {
 	return e.db.ArchivedActiveValidatorChanges(ctx, epoch)
@@ -183,6 +213,11 @@ func (e Exporter) ArchivedValidatorParticipation(ctx context.Context, epoch uint
 	return e.db.ArchivedValidatorParticipation(ctx, epoch)
 }
 
+// ArchivedValidatorRewards -- passthrough.
+func (e Exporter) ArchivedValidatorRewards(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedValidatorRewards, error) {
+	return e.db.ArchivedValidatorRewards(ctx, epoch)
+}
+
 // DepositContractAddress -- passthrough.
 func (e Exporter) DepositContractAddress(ctx context.Context) ([]byte, error) {
 	return e.db.DepositContractAddress(ctx)
@@ -218,6 +253,11 @@ func (e Exporter) SaveStateSummaries(ctx context.Context, summaries []*pb.StateS
 	return e.db.SaveStateSummaries(ctx, summaries)
 }
 
+// SaveStateDiff -- passthrough.
+func (e Exporter) SaveStateDiff(ctx context.Context, diff *db.StateDiff, blockRoot [32]byte) error {
+	return e.db.SaveStateDiff(ctx, diff, blockRoot)
+}
+
 // SaveStates -- passthrough.
 func (e Exporter) SaveStates(ctx context.Context, states []*state.BeaconState, blockRoots [][32]byte) error {
 	return e.db.SaveStates(ctx, states, blockRoots)
@@ -238,6 +278,21 @@ func (e Exporter) SaveVoluntaryExit(ctx context.Context, exit *eth.VoluntaryExit
 	return e.db.SaveVoluntaryExit(ctx, exit)
 }
 
+// SavePoolVoluntaryExits -- passthrough.
+func (e Exporter) SavePoolVoluntaryExits(ctx context.Context, exits []*eth.SignedVoluntaryExit) error {
+	return e.db.SavePoolVoluntaryExits(ctx, exits)
+}
+
+// SavePoolProposerSlashings -- passthrough.
+func (e Exporter) SavePoolProposerSlashings(ctx context.Context, slashings []*eth.ProposerSlashing) error {
+	return e.db.SavePoolProposerSlashings(ctx, slashings)
+}
+
+// SavePoolAttesterSlashings -- passthrough.
+func (e Exporter) SavePoolAttesterSlashings(ctx context.Context, slashings []*eth.AttesterSlashing) error {
+	return e.db.SavePoolAttesterSlashings(ctx, slashings)
+}
+
 // SaveJustifiedCheckpoint -- passthrough.
 func (e Exporter) SaveJustifiedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error {
 	return e.db.SaveJustifiedCheckpoint(ctx, checkpoint)
@@ -248,6 +303,21 @@ func (e Exporter) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *eth.C
 	return e.db.SaveFinalizedCheckpoint(ctx, checkpoint)
 }
 
+// SaveInitialSyncTarget -- passthrough.
+func (e Exporter) SaveInitialSyncTarget(ctx context.Context, checkpoint *eth.Checkpoint) error {
+	return e.db.SaveInitialSyncTarget(ctx, checkpoint)
+}
+
+// SaveForkChoice -- passthrough.
+func (e Exporter) SaveForkChoice(ctx context.Context, forkChoice *db.ForkChoiceNodes) error {
+	return e.db.SaveForkChoice(ctx, forkChoice)
+}
+
+// SaveReorg -- passthrough.
+func (e Exporter) SaveReorg(ctx context.Context, reorg *db.Reorg) error {
+	return e.db.SaveReorg(ctx, reorg)
+}
+
 // SaveArchivedActiveValidatorChanges -- passthrough.
 func (e Exporter) SaveArchivedActiveValidatorChanges(ctx context.Context, epoch uint64, changes *ethereum_beacon_p2p_v1.ArchivedActiveSetChanges) error {
 	return e.db.SaveArchivedActiveValidatorChanges(ctx, epoch, changes)
@@ -268,6 +338,11 @@ func (e Exporter) SaveArchivedValidatorParticipation(ctx context.Context, epoch
 	return e.db.SaveArchivedValidatorParticipation(ctx, epoch, part)
 }
 
+// SaveArchivedValidatorRewards -- passthrough.
+func (e Exporter) SaveArchivedValidatorRewards(ctx context.Context, epoch uint64, rewards *ethereum_beacon_p2p_v1.ArchivedValidatorRewards) error {
+	return e.db.SaveArchivedValidatorRewards(ctx, epoch, rewards)
+}
+
 // SaveDepositContractAddress -- passthrough.
 func (e Exporter) SaveDepositContractAddress(ctx context.Context, addr common.Address) error {
 	return e.db.SaveDepositContractAddress(ctx, addr)
@@ -293,6 +368,11 @@ func (e Exporter) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
 	return e.db.HasStateSummary(ctx, blockRoot)
 }
 
+// StateDiff -- passthrough.
+func (e Exporter) StateDiff(ctx context.Context, blockRoot [32]byte) (*db.StateDiff, error) {
+	return e.db.StateDiff(ctx, blockRoot)
+}
+
 // IsFinalizedBlock -- passthrough.
 func (e Exporter) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
 	return e.db.IsFinalizedBlock(ctx, blockRoot)
@@ -308,6 +388,21 @@ func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData)
 	return e.db.SavePowchainData(ctx, data)
 }
 
+// Eth1Headers -- passthrough
+func (e Exporter) Eth1Headers(ctx context.Context, startNumber uint64, endNumber uint64) ([]*db.ETH1Header, error) {
+	return e.db.Eth1Headers(ctx, startNumber, endNumber)
+}
+
+// SaveEth1Headers -- passthrough
+func (e Exporter) SaveEth1Headers(ctx context.Context, headers []*db.ETH1Header) error {
+	return e.db.SaveEth1Headers(ctx, headers)
+}
+
+// DeleteEth1Headers -- passthrough
+func (e Exporter) DeleteEth1Headers(ctx context.Context, startNumber uint64, endNumber uint64) error {
+	return e.db.DeleteEth1Headers(ctx, startNumber, endNumber)
+}
+
 // SaveArchivedPointRoot -- passthrough
 func (e Exporter) SaveArchivedPointRoot(ctx context.Context, blockRoot [32]byte, index uint64) error {
 	return e.db.SaveArchivedPointRoot(ctx, blockRoot, index)
@@ -144,6 +144,40 @@ func (k *Store) SaveArchivedValidatorParticipation(ctx context.Context, epoch ui
 	})
 }
 
+// ArchivedValidatorRewards retrieval by epoch.
+func (k *Store) ArchivedValidatorRewards(ctx context.Context, epoch uint64) (*pb.ArchivedValidatorRewards, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedValidatorRewards")
+	defer span.End()
+
+	buf := bytesutil.Uint64ToBytes(epoch)
+	var target *pb.ArchivedValidatorRewards
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(archivedValidatorRewardsBucket)
+		enc := bkt.Get(buf)
+		if enc == nil {
+			return nil
+		}
+		target = &pb.ArchivedValidatorRewards{}
+		return decode(enc, target)
+	})
+	return target, err
+}
+
+// SaveArchivedValidatorRewards by epoch.
+func (k *Store) SaveArchivedValidatorRewards(ctx context.Context, epoch uint64, rewards *pb.ArchivedValidatorRewards) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedValidatorRewards")
+	defer span.End()
+	buf := bytesutil.Uint64ToBytes(epoch)
+	enc, err := encode(rewards)
+	if err != nil {
+		return err
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedValidatorRewardsBucket)
+		return bucket.Put(buf, enc)
+	})
+}
+
 func marshalBalances(bals []uint64) []byte {
 	res := make([]byte, len(bals)*8)
 	offset := 0
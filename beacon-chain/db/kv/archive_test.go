@@ -161,3 +161,33 @@ func TestStore_ArchivedValidatorParticipation(t *testing.T) {
 		t.Errorf("Wanted %v, received %v", part, retrieved)
 	}
 }
+
+func TestStore_ArchivedValidatorRewards(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	epoch := uint64(10)
+	rewards := &pbp2p.ArchivedValidatorRewards{
+		SourceReward:      []uint64{1, 2, 3},
+		SourcePenalty:     []uint64{0, 0, 4},
+		ProposerReward:    []uint64{5, 0, 0},
+		InactivityPenalty: []uint64{6, 7, 8},
+		SlashingPenalty:   []uint64{0, 9, 0},
+	}
+	if err := db.SaveArchivedValidatorRewards(ctx, epoch, rewards); err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err := db.ArchivedValidatorRewards(ctx, epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(rewards, retrieved) {
+		t.Errorf("Wanted %v, received %v", rewards, retrieved)
+	}
+	retrieved, err = db.ArchivedValidatorRewards(ctx, epoch+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved != nil {
+		t.Errorf("Wanted nil rewards for unknown epoch, received %v", retrieved)
+	}
+}
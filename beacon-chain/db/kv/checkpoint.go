@@ -120,3 +120,37 @@ func (k *Store) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.C
 		return k.updateFinalizedBlockRoots(ctx, tx, checkpoint)
 	})
 }
+
+// InitialSyncTarget returns the finalized checkpoint initial sync was last syncing towards,
+// or nil if none was saved.
+func (k *Store) InitialSyncTarget(ctx context.Context) (*ethpb.Checkpoint, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.InitialSyncTarget")
+	defer span.End()
+	var checkpoint *ethpb.Checkpoint
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(checkpointBucket)
+		enc := bkt.Get(initialSyncTargetKey)
+		if enc == nil {
+			return nil
+		}
+		checkpoint = &ethpb.Checkpoint{}
+		return decode(enc, checkpoint)
+	})
+	return checkpoint, err
+}
+
+// SaveInitialSyncTarget saves the finalized checkpoint initial sync is syncing towards, so that
+// an interrupted sync may resume against the same chain.
+func (k *Store) SaveInitialSyncTarget(ctx context.Context, checkpoint *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveInitialSyncTarget")
+	defer span.End()
+
+	enc, err := encode(checkpoint)
+	if err != nil {
+		return err
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(checkpointBucket)
+		return bucket.Put(initialSyncTargetKey, enc)
+	})
+}
@@ -144,3 +144,32 @@ func TestStore_FinalizedCheckpoint_StateMustExist(t *testing.T) {
 		t.Fatalf("wanted err %v, got %v", errMissingStateForCheckpoint, err)
 	}
 }
+
+func TestStore_InitialSyncTarget_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	retrieved, err := db.InitialSyncTarget(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved != nil {
+		t.Errorf("Expected no initial sync target, received %v", retrieved)
+	}
+
+	root := bytesutil.ToBytes32([]byte{'A'})
+	cp := &ethpb.Checkpoint{
+		Epoch: 10,
+		Root:  root[:],
+	}
+	if err := db.SaveInitialSyncTarget(ctx, cp); err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err = db.InitialSyncTarget(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(cp, retrieved) {
+		t.Errorf("Wanted %v, received %v", cp, retrieved)
+	}
+}
@@ -0,0 +1,90 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Eth1Headers retrieves the eth1 headers with a block number between start number and end number,
+// inclusive, in block number order.
+func (k *Store) Eth1Headers(ctx context.Context, startNumber uint64, endNumber uint64) ([]*dbpb.ETH1Header, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Eth1Headers")
+	defer span.End()
+
+	headers := make([]*dbpb.ETH1Header, 0)
+	err := k.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(eth1HeadersBucket).Cursor()
+		for key, enc := c.Seek(eth1HeaderKey(startNumber)); key != nil; key, enc = c.Next() {
+			if binary.BigEndian.Uint64(key) > endNumber {
+				break
+			}
+			header := &dbpb.ETH1Header{}
+			if err := decode(enc, header); err != nil {
+				return err
+			}
+			headers = append(headers, header)
+		}
+		return nil
+	})
+	return headers, err
+}
+
+// SaveEth1Headers saves the eth1 headers by block number, replacing any saved header with the same number.
+func (k *Store) SaveEth1Headers(ctx context.Context, headers []*dbpb.ETH1Header) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveEth1Headers")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(eth1HeadersBucket)
+		for _, header := range headers {
+			if header == nil {
+				return errors.New("cannot save nil eth1 header")
+			}
+			enc, err := encode(header)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(eth1HeaderKey(header.Number), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteEth1Headers deletes the eth1 headers with a block number between start number and end number, inclusive.
+func (k *Store) DeleteEth1Headers(ctx context.Context, startNumber uint64, endNumber uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteEth1Headers")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(eth1HeadersBucket)
+		// Collect the keys first, as deleting through the cursor would skip keys.
+		var keys [][]byte
+		c := bkt.Cursor()
+		for key, _ := c.Seek(eth1HeaderKey(startNumber)); key != nil; key, _ = c.Next() {
+			if binary.BigEndian.Uint64(key) > endNumber {
+				break
+			}
+			keys = append(keys, append([]byte{}, key...))
+		}
+		for _, key := range keys {
+			if err := bkt.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// eth1HeaderKey encodes the block number big endian, so that eth1 headers are iterated in block number order.
+func eth1HeaderKey(number uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, number)
+	return key
+}
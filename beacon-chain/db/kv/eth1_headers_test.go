@@ -0,0 +1,61 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+func TestStore_Eth1Headers_CanSaveRetrieveDelete(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	headers := []*dbpb.ETH1Header{
+		{Number: 256, Hash: []byte{'c'}, ParentHash: []byte{'b'}, Timestamp: 3000},
+		{Number: 5, Hash: []byte{'a'}, Timestamp: 100},
+		{Number: 6, Hash: []byte{'b'}, ParentHash: []byte{'a'}, Timestamp: 114},
+	}
+	if err := db.SaveEth1Headers(ctx, headers); err != nil {
+		t.Fatal(err)
+	}
+
+	received, err := db.Eth1Headers(ctx, 0, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := []*dbpb.ETH1Header{headers[1], headers[2], headers[0]}
+	if len(received) != len(wanted) {
+		t.Fatalf("Wanted %d headers, received %d", len(wanted), len(received))
+	}
+	for i := range wanted {
+		if !proto.Equal(received[i], wanted[i]) {
+			t.Errorf("Wanted header %v at index %d, received %v", wanted[i], i, received[i])
+		}
+	}
+
+	// Saving a header with the same number replaces the saved header.
+	replaced := &dbpb.ETH1Header{Number: 6, Hash: []byte{'d'}, ParentHash: []byte{'a'}, Timestamp: 115}
+	if err := db.SaveEth1Headers(ctx, []*dbpb.ETH1Header{replaced}); err != nil {
+		t.Fatal(err)
+	}
+	received, err = db.Eth1Headers(ctx, 6, 255)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || !proto.Equal(received[0], replaced) {
+		t.Errorf("Wanted the replaced header, received %v", received)
+	}
+
+	if err := db.DeleteEth1Headers(ctx, 6, 1000); err != nil {
+		t.Fatal(err)
+	}
+	received, err = db.Eth1Headers(ctx, 0, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || received[0].Number != 5 {
+		t.Errorf("Wanted only the header of block 5, received %v", received)
+	}
+}
@@ -0,0 +1,46 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ForkChoice retrieves the last saved fork choice store, or nil if none was saved.
+func (k *Store) ForkChoice(ctx context.Context) (*dbpb.ForkChoiceNodes, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ForkChoice")
+	defer span.End()
+
+	var forkChoice *dbpb.ForkChoiceNodes
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(forkChoiceBucket)
+		enc := bkt.Get(forkChoiceStoreKey)
+		if enc == nil {
+			return nil
+		}
+		forkChoice = &dbpb.ForkChoiceNodes{}
+		return decode(enc, forkChoice)
+	})
+	return forkChoice, err
+}
+
+// SaveForkChoice saves the fork choice store, replacing the previously saved one.
+func (k *Store) SaveForkChoice(ctx context.Context, forkChoice *dbpb.ForkChoiceNodes) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoice")
+	defer span.End()
+
+	if forkChoice == nil {
+		return errors.New("cannot save nil fork choice")
+	}
+	enc, err := encode(forkChoice)
+	if err != nil {
+		return err
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(forkChoiceBucket)
+		return bkt.Put(forkChoiceStoreKey, enc)
+	})
+}
@@ -0,0 +1,56 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+func TestStore_ForkChoice_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	forkChoice, err := db.ForkChoice(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forkChoice != nil {
+		t.Errorf("Wanted nil fork choice before saving, got %v", forkChoice)
+	}
+	if err := db.SaveForkChoice(ctx, nil); err == nil {
+		t.Error("Expected error saving nil fork choice")
+	}
+
+	want := &dbpb.ForkChoiceNodes{
+		JustifiedEpoch: 2,
+		FinalizedEpoch: 1,
+		FinalizedRoot:  make([]byte, 32),
+		Nodes:          []*dbpb.ForkChoiceNode{{Slot: 32, Root: []byte{'a'}, Parent: ^uint64(0)}},
+		Votes:          []*dbpb.ForkChoiceVote{{CurrentRoot: []byte{'a'}, NextRoot: []byte{'a'}, NextEpoch: 2}},
+		Balances:       []uint64{32, 31},
+	}
+	if err := db.SaveForkChoice(ctx, want); err != nil {
+		t.Fatal(err)
+	}
+	forkChoice, err = db.ForkChoice(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(forkChoice, want) {
+		t.Errorf("Wanted %v, received %v", want, forkChoice)
+	}
+
+	want = &dbpb.ForkChoiceNodes{JustifiedEpoch: 3, EquivocatingIndices: []uint64{5}}
+	if err := db.SaveForkChoice(ctx, want); err != nil {
+		t.Fatal(err)
+	}
+	forkChoice, err = db.ForkChoice(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(forkChoice, want) {
+		t.Errorf("Wanted %v, received %v", want, forkChoice)
+	}
+}
@@ -101,10 +101,18 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 			archivedCommitteeInfoBucket,
 			archivedBalancesBucket,
 			archivedValidatorParticipationBucket,
+			archivedValidatorRewardsBucket,
 			powchainBucket,
 			stateSummaryBucket,
 			archivedIndexRootBucket,
 			slotsHasObjectBucket,
+			forkChoiceBucket,
+			reorgsBucket,
+			stateDiffsBucket,
+			eth1HeadersBucket,
+			poolVoluntaryExitsBucket,
+			poolProposerSlashingsBucket,
+			poolAttesterSlashingsBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
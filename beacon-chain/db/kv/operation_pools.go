@@ -0,0 +1,132 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PoolVoluntaryExits retrieves the voluntary exits saved from the operation pool.
+func (k *Store) PoolVoluntaryExits(ctx context.Context) ([]*ethpb.SignedVoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PoolVoluntaryExits")
+	defer span.End()
+
+	exits := make([]*ethpb.SignedVoluntaryExit, 0)
+	err := k.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(poolVoluntaryExitsBucket).ForEach(func(_, enc []byte) error {
+			exit := &ethpb.SignedVoluntaryExit{}
+			if err := decode(enc, exit); err != nil {
+				return err
+			}
+			exits = append(exits, exit)
+			return nil
+		})
+	})
+	return exits, err
+}
+
+// SavePoolVoluntaryExits replaces the voluntary exits saved from the operation pool.
+func (k *Store) SavePoolVoluntaryExits(ctx context.Context, exits []*ethpb.SignedVoluntaryExit) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePoolVoluntaryExits")
+	defer span.End()
+
+	msgs := make([]proto.Message, len(exits))
+	for i, exit := range exits {
+		msgs[i] = exit
+	}
+	return k.replacePoolOperations(poolVoluntaryExitsBucket, msgs)
+}
+
+// PoolProposerSlashings retrieves the proposer slashings saved from the operation pool.
+func (k *Store) PoolProposerSlashings(ctx context.Context) ([]*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PoolProposerSlashings")
+	defer span.End()
+
+	slashings := make([]*ethpb.ProposerSlashing, 0)
+	err := k.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(poolProposerSlashingsBucket).ForEach(func(_, enc []byte) error {
+			slashing := &ethpb.ProposerSlashing{}
+			if err := decode(enc, slashing); err != nil {
+				return err
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
+
+// SavePoolProposerSlashings replaces the proposer slashings saved from the operation pool.
+func (k *Store) SavePoolProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePoolProposerSlashings")
+	defer span.End()
+
+	msgs := make([]proto.Message, len(slashings))
+	for i, slashing := range slashings {
+		msgs[i] = slashing
+	}
+	return k.replacePoolOperations(poolProposerSlashingsBucket, msgs)
+}
+
+// PoolAttesterSlashings retrieves the attester slashings saved from the operation pool.
+func (k *Store) PoolAttesterSlashings(ctx context.Context) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PoolAttesterSlashings")
+	defer span.End()
+
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	err := k.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(poolAttesterSlashingsBucket).ForEach(func(_, enc []byte) error {
+			slashing := &ethpb.AttesterSlashing{}
+			if err := decode(enc, slashing); err != nil {
+				return err
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
+
+// SavePoolAttesterSlashings replaces the attester slashings saved from the operation pool.
+func (k *Store) SavePoolAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePoolAttesterSlashings")
+	defer span.End()
+
+	msgs := make([]proto.Message, len(slashings))
+	for i, slashing := range slashings {
+		msgs[i] = slashing
+	}
+	return k.replacePoolOperations(poolAttesterSlashingsBucket, msgs)
+}
+
+// replacePoolOperations replaces the content of a pool bucket with the operations, keyed by
+// their hash tree root.
+func (k *Store) replacePoolOperations(bucketName []byte, ops []proto.Message) error {
+	return k.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(bucketName); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(bucketName)
+		if err != nil {
+			return err
+		}
+		for _, op := range ops {
+			root, err := ssz.HashTreeRoot(op)
+			if err != nil {
+				return err
+			}
+			enc, err := encode(op)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(root[:], enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
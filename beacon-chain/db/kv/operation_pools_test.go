@@ -0,0 +1,99 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+func TestStore_PoolVoluntaryExits_SaveReplacesSaved(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	exits := []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 1}, Signature: make([]byte, 96)},
+		{Exit: &ethpb.VoluntaryExit{Epoch: 6, ValidatorIndex: 2}, Signature: make([]byte, 96)},
+	}
+	if err := db.SavePoolVoluntaryExits(ctx, exits); err != nil {
+		t.Fatal(err)
+	}
+	received, err := db.PoolVoluntaryExits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != len(exits) {
+		t.Fatalf("Wanted %d exits, received %d", len(exits), len(received))
+	}
+	for _, exit := range exits {
+		found := false
+		for _, r := range received {
+			found = found || proto.Equal(exit, r)
+		}
+		if !found {
+			t.Errorf("Saved exit %v not retrieved", exit)
+		}
+	}
+
+	if err := db.SavePoolVoluntaryExits(ctx, exits[1:]); err != nil {
+		t.Fatal(err)
+	}
+	received, err = db.PoolVoluntaryExits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || !proto.Equal(received[0], exits[1]) {
+		t.Errorf("Wanted only exit %v, received %v", exits[1], received)
+	}
+}
+
+func TestStore_PoolSlashings_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	header := func(slot uint64) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: 5,
+				BodyRoot:      make([]byte, 32),
+				ParentRoot:    make([]byte, 32),
+				StateRoot:     make([]byte, 32),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	proposerSlashing := &ethpb.ProposerSlashing{Header_1: header(1), Header_2: header(1)}
+	att := func(targetEpoch uint64) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{3, 4},
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: targetEpoch, Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	attesterSlashing := &ethpb.AttesterSlashing{Attestation_1: att(1), Attestation_2: att(1)}
+
+	if err := db.SavePoolProposerSlashings(ctx, []*ethpb.ProposerSlashing{proposerSlashing}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SavePoolAttesterSlashings(ctx, []*ethpb.AttesterSlashing{attesterSlashing}); err != nil {
+		t.Fatal(err)
+	}
+	proposerSlashings, err := db.PoolProposerSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proposerSlashings) != 1 || !proto.Equal(proposerSlashings[0], proposerSlashing) {
+		t.Errorf("Wanted proposer slashing %v, received %v", proposerSlashing, proposerSlashings)
+	}
+	attesterSlashings, err := db.PoolAttesterSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attesterSlashings) != 1 || !proto.Equal(attesterSlashings[0], attesterSlashing) {
+		t.Errorf("Wanted attester slashing %v, received %v", attesterSlashing, attesterSlashings)
+	}
+}
@@ -0,0 +1,87 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Reorgs retrieves the recorded chain reorgs which occurred between start slot and end slot,
+// inclusive, in slot order.
+func (k *Store) Reorgs(ctx context.Context, startSlot uint64, endSlot uint64) ([]*dbpb.Reorg, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Reorgs")
+	defer span.End()
+
+	reorgs := make([]*dbpb.Reorg, 0)
+	err := k.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(reorgsBucket).Cursor()
+		for key, enc := c.Seek(reorgSlotPrefix(startSlot)); key != nil; key, enc = c.Next() {
+			if binary.BigEndian.Uint64(key[:8]) > endSlot {
+				break
+			}
+			reorg := &dbpb.Reorg{}
+			if err := decode(enc, reorg); err != nil {
+				return err
+			}
+			reorgs = append(reorgs, reorg)
+		}
+		return nil
+	})
+	return reorgs, err
+}
+
+// maxSavedReorgs is the number of most recent reorgs kept in the DB, older reorgs are pruned as new
+// ones are saved.
+const maxSavedReorgs = 4096
+
+// SaveReorg records a chain reorg, keyed by the slot it occurred at and the new head root. Only the
+// latest maxSavedReorgs reorgs are kept.
+func (k *Store) SaveReorg(ctx context.Context, reorg *dbpb.Reorg) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveReorg")
+	defer span.End()
+
+	if reorg == nil {
+		return errors.New("cannot save nil reorg")
+	}
+	enc, err := encode(reorg)
+	if err != nil {
+		return err
+	}
+	key := append(reorgSlotPrefix(reorg.Slot), reorg.NewHeadRoot...)
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(reorgsBucket)
+		if err := bkt.Put(key, enc); err != nil {
+			return err
+		}
+		return pruneReorgs(bkt, maxSavedReorgs)
+	})
+}
+
+// pruneReorgs deletes the oldest reorgs of the bucket so that at most limit reorgs remain.
+func pruneReorgs(bkt *bolt.Bucket, limit int) error {
+	keys := make([][]byte, 0)
+	c := bkt.Cursor()
+	for key, _ := c.First(); key != nil; key, _ = c.Next() {
+		keys = append(keys, key)
+	}
+	if len(keys) <= limit {
+		return nil
+	}
+	for _, key := range keys[:len(keys)-limit] {
+		if err := bkt.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reorgSlotPrefix encodes the slot big endian, so that reorg keys are iterated in slot order.
+func reorgSlotPrefix(slot uint64) []byte {
+	prefix := make([]byte, 8)
+	binary.BigEndian.PutUint64(prefix, slot)
+	return prefix
+}
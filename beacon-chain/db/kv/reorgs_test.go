@@ -0,0 +1,81 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_Reorgs_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	reorgs := []*dbpb.Reorg{
+		{Slot: 300, NewHeadRoot: []byte{'c'}, Depth: 3},
+		{Slot: 5, NewHeadRoot: []byte{'a'}, Depth: 1},
+		{Slot: 256, NewHeadRoot: []byte{'b'}, Depth: 2},
+		{Slot: 256, NewHeadRoot: []byte{'d'}, Depth: 1},
+	}
+	for _, r := range reorgs {
+		if err := db.SaveReorg(ctx, r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	received, err := db.Reorgs(ctx, 0, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := []*dbpb.Reorg{reorgs[1], reorgs[2], reorgs[3], reorgs[0]}
+	if len(received) != len(wanted) {
+		t.Fatalf("Wanted %d reorgs, received %d", len(wanted), len(received))
+	}
+	for i := range wanted {
+		if !proto.Equal(received[i], wanted[i]) {
+			t.Errorf("Wanted reorg %v at index %d, received %v", wanted[i], i, received[i])
+		}
+	}
+
+	received, err = db.Reorgs(ctx, 6, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 2 || received[0].Slot != 256 || received[1].Slot != 256 {
+		t.Errorf("Wanted the 2 reorgs at slot 256, received %v", received)
+	}
+
+	received, err = db.Reorgs(ctx, 301, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 0 {
+		t.Errorf("Wanted no reorgs, received %d", len(received))
+	}
+}
+
+func TestStore_PruneReorgs_KeepsLatest(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	for slot := uint64(1); slot <= 5; slot++ {
+		if err := db.SaveReorg(ctx, &dbpb.Reorg{Slot: slot, NewHeadRoot: []byte{'a'}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.db.Update(func(tx *bolt.Tx) error {
+		return pruneReorgs(tx.Bucket(reorgsBucket), 2)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	received, err := db.Reorgs(ctx, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 2 || received[0].Slot != 4 || received[1].Slot != 5 {
+		t.Errorf("Wanted the reorgs at slots 4 and 5, received %v", received)
+	}
+}
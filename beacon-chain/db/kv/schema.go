@@ -20,9 +20,17 @@ var (
 	archivedCommitteeInfoBucket          = []byte("archived-committee-info")
 	archivedBalancesBucket               = []byte("archived-balances")
 	archivedValidatorParticipationBucket = []byte("archived-validator-participation")
+	archivedValidatorRewardsBucket       = []byte("archived-validator-rewards")
 	powchainBucket                       = []byte("powchain")
 	archivedIndexRootBucket              = []byte("archived-index-root")
 	slotsHasObjectBucket                 = []byte("slots-has-objects")
+	forkChoiceBucket                     = []byte("fork-choice")
+	reorgsBucket                         = []byte("reorgs")
+	stateDiffsBucket                     = []byte("state-diffs")
+	eth1HeadersBucket                    = []byte("eth1-headers")
+	poolVoluntaryExitsBucket             = []byte("pool-voluntary-exits")
+	poolProposerSlashingsBucket          = []byte("pool-proposer-slashings")
+	poolAttesterSlashingsBucket          = []byte("pool-attester-slashings")
 
 	// Key indices buckets.
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
@@ -40,6 +48,8 @@ var (
 	depositContractAddressKey = []byte("deposit-contract")
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
+	initialSyncTargetKey      = []byte("initial-sync-target")
+	forkChoiceStoreKey        = []byte("fork-choice-store")
 	powchainDataKey           = []byte("powchain-data")
 	lastArchivedIndexKey      = []byte("last-archived")
 	savedBlockSlotsKey        = []byte("saved-block-slots")
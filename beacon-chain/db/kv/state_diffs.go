@@ -0,0 +1,44 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// StateDiff retrieves the diff of the state of the given block root against a full state, if any.
+func (k *Store) StateDiff(ctx context.Context, blockRoot [32]byte) (*dbpb.StateDiff, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+
+	var diff *dbpb.StateDiff
+	err := k.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(stateDiffsBucket).Get(blockRoot[:])
+		if enc == nil {
+			return nil
+		}
+		diff = &dbpb.StateDiff{}
+		return decode(enc, diff)
+	})
+	return diff, err
+}
+
+// SaveStateDiff stores the diff of the state of the given block root against a full state.
+func (k *Store) SaveStateDiff(ctx context.Context, diff *dbpb.StateDiff, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	if diff == nil {
+		return errors.New("cannot save nil state diff")
+	}
+	enc, err := encode(diff)
+	if err != nil {
+		return err
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateDiffsBucket).Put(blockRoot[:], enc)
+	})
+}
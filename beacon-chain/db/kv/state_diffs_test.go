@@ -0,0 +1,44 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+func TestStore_StateDiff_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	r := [32]byte{'A'}
+
+	diff, err := db.StateDiff(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil {
+		t.Errorf("Wanted no state diff, got %v", diff)
+	}
+
+	wanted := &dbpb.StateDiff{
+		BaseRoot:      []byte{'B'},
+		BaseSlot:      64,
+		BalanceDeltas: []int64{-1, 0, 2},
+		BlockRoots:    []*dbpb.RootDiff{{Index: 3, Root: []byte{'C'}}},
+	}
+	if err := db.SaveStateDiff(ctx, wanted, r); err != nil {
+		t.Fatal(err)
+	}
+	diff, err = db.StateDiff(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(diff, wanted) {
+		t.Errorf("Wanted %v, got %v", wanted, diff)
+	}
+
+	if err := db.SaveStateDiff(ctx, nil, r); err == nil {
+		t.Error("Wanted error saving nil state diff")
+	}
+}
@@ -0,0 +1,261 @@
+// Package duties defines a service which precomputes the validator duties of the
+// current and next epochs from the head state, so duties can be served at epoch
+// boundaries without advancing the head state.
+package duties
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var log = logrus.WithField("prefix", "duties")
+
+// Service precomputing the shuffling, committees, proposers and attestation subnets
+// of the current and next epochs on every epoch transition of the head, and whenever
+// the head moves to a chain with another dependent root.
+type Service struct {
+	ctx               context.Context
+	cancel            context.CancelFunc
+	headFetcher       blockchain.HeadFetcher
+	stateNotifier     statefeed.Notifier
+	dutiesCache       *cache.DutiesCache
+	lastHeadEpoch     uint64
+	lastDependentRoot [32]byte
+	precomputedEpoch  bool
+}
+
+// Config options for the duties service.
+type Config struct {
+	HeadFetcher   blockchain.HeadFetcher
+	StateNotifier statefeed.Notifier
+	DutiesCache   *cache.DutiesCache
+}
+
+// NewService initializes the service from configuration options.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:           ctx,
+		cancel:        cancel,
+		headFetcher:   cfg.HeadFetcher,
+		stateNotifier: cfg.StateNotifier,
+		dutiesCache:   cfg.DutiesCache,
+	}
+}
+
+// Start the duties service event loop.
+func (s *Service) Start() {
+	go s.run(s.ctx)
+}
+
+// Stop the duties service event loop.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	return nil
+}
+
+// Status reports the healthy status of the duties service. Returning nil means service
+// is correctly running without error.
+func (s *Service) Status() error {
+	return nil
+}
+
+// ComputeEpochDuties computes the duties of all validators in the given epoch from the
+// state, which is advanced with empty slots to the start of the epoch when it is behind.
+// The input state is not modified.
+func ComputeEpochDuties(ctx context.Context, st *state.BeaconState, epoch uint64) (*cache.EpochDuties, error) {
+	ctx, span := trace.StartSpan(ctx, "duties.ComputeEpochDuties")
+	defer span.End()
+
+	st = st.Copy()
+	if epochStartSlot := helpers.StartSlot(epoch); st.Slot() < epochStartSlot {
+		var err error
+		st, err = transition.ProcessSlots(ctx, st, epochStartSlot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", epochStartSlot)
+		}
+	}
+
+	indices, statuses, err := validatorStatuses(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	activeCount, err := helpers.ActiveValidatorCount(st, epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get active validator count")
+	}
+	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(st, epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute committee assignments")
+	}
+	duties := &cache.EpochDuties{
+		Epoch:            epoch,
+		ValidatorIndices: indices,
+		Statuses:         statuses,
+		Assignments:      make(map[uint64]*cache.DutyAssignment, len(committeeAssignments)),
+		ProposerSlots:    proposerIndexToSlots,
+	}
+
+	// Validators of the same committee share their assignment.
+	assignments := make(map[*helpers.CommitteeAssignmentContainer]*cache.DutyAssignment)
+	subnets := make(map[uint64]bool)
+	for idx, ca := range committeeAssignments {
+		assignment, ok := assignments[ca]
+		if !ok {
+			assignment = &cache.DutyAssignment{
+				Committee:      ca.Committee,
+				CommitteeIndex: ca.CommitteeIndex,
+				AttesterSlot:   ca.AttesterSlot,
+				Subnet:         helpers.ComputeSubnetFromCommitteeAndSlot(activeCount, ca.CommitteeIndex, ca.AttesterSlot),
+			}
+			assignments[ca] = assignment
+			subnets[assignment.Subnet] = true
+		}
+		duties.Assignments[idx] = assignment
+	}
+	duties.Subnets = make([]uint64, 0, len(subnets))
+	for subnet := range subnets {
+		duties.Subnets = append(duties.Subnets, subnet)
+	}
+	sort.Slice(duties.Subnets, func(i, j int) bool {
+		return duties.Subnets[i] < duties.Subnets[j]
+	})
+	return duties, nil
+}
+
+// validatorStatuses returns the indices of all validators by public key, and their statuses
+// in the given epoch.
+func validatorStatuses(st *state.BeaconState, epoch uint64) (map[[48]byte]uint64, []ethpb.ValidatorStatus, error) {
+	indices := make(map[[48]byte]uint64, st.NumValidators())
+	statuses := make([]ethpb.ValidatorStatus, st.NumValidators())
+	if err := st.ReadFromEveryValidator(func(idx int, val *state.ReadOnlyValidator) error {
+		indices[val.PublicKey()] = uint64(idx)
+		statuses[idx] = validators.AssignmentStatus(val, epoch)
+		return nil
+	}); err != nil {
+		return nil, nil, errors.Wrap(err, "could not read validators")
+	}
+	return indices, statuses, nil
+}
+
+// dependentRoot returns the root of the last block of the epoch before the state's epoch. The
+// proposers of the state's epoch and the committees of its next epoch are derived from the chain
+// up to this block, as are the committees of its epoch from an earlier block of the same chain.
+func dependentRoot(st *state.BeaconState) ([32]byte, error) {
+	epoch := helpers.CurrentEpoch(st)
+	if epoch == 0 {
+		return params.BeaconConfig().ZeroHash, nil
+	}
+	root, err := helpers.BlockRootAtSlot(st, helpers.StartSlot(epoch)-1)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get dependent root")
+	}
+	return bytesutil.ToBytes32(root), nil
+}
+
+// update precomputes the duties of the head's current and next epochs when the head moved to
+// another epoch or to a chain with another dependent root. Otherwise, only the validator statuses
+// of the current epoch duties are refreshed, as slashings and exits change them with every block.
+func (s *Service) update(ctx context.Context) error {
+	headState, err := s.headFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	epoch := helpers.CurrentEpoch(headState)
+	root, err := dependentRoot(headState)
+	if err != nil {
+		return err
+	}
+	if s.precomputedEpoch && epoch == s.lastHeadEpoch && root == s.lastDependentRoot {
+		return s.refreshStatuses(headState, epoch)
+	}
+	return s.precompute(ctx, headState, root)
+}
+
+// precompute computes the duties of the head state's current and next epochs.
+func (s *Service) precompute(ctx context.Context, headState *state.BeaconState, dependentRoot [32]byte) error {
+	headRoot, err := s.headFetcher.HeadRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head root")
+	}
+	epoch := helpers.CurrentEpoch(headState)
+	for _, e := range []uint64{epoch, epoch + 1} {
+		duties, err := ComputeEpochDuties(ctx, headState, e)
+		if err != nil {
+			return errors.Wrapf(err, "could not compute duties of epoch %d", e)
+		}
+		duties.HeadRoot = bytesutil.ToBytes32(headRoot)
+		duties.DependentRoot = dependentRoot
+		s.dutiesCache.Put(duties)
+	}
+	s.lastHeadEpoch = epoch
+	s.lastDependentRoot = dependentRoot
+	s.precomputedEpoch = true
+	log.WithFields(logrus.Fields{
+		"epoch":         epoch,
+		"dependentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(dependentRoot[:])),
+	}).Debug("Precomputed validator duties")
+	return nil
+}
+
+// refreshStatuses replaces the validator statuses of the precomputed duties of the head state's
+// epoch with the ones of the head state. The next epoch statuses depend on the epoch transition,
+// and are refreshed when the head reaches the next epoch.
+func (s *Service) refreshStatuses(headState *state.BeaconState, epoch uint64) error {
+	current := s.dutiesCache.Duties(epoch)
+	if current == nil {
+		return nil
+	}
+	indices, statuses, err := validatorStatuses(headState, epoch)
+	if err != nil {
+		return err
+	}
+	// The cached duties are shared with readers, so the refreshed duties are a copy.
+	refreshed := *current
+	refreshed.ValidatorIndices = indices
+	refreshed.Statuses = statuses
+	s.dutiesCache.Put(&refreshed)
+	return nil
+}
+
+func (s *Service) run(ctx context.Context) {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed && event.Type != statefeed.Reorg {
+				continue
+			}
+			if err := s.update(ctx); err != nil {
+				// Duties computed from a previous head may no longer be valid.
+				s.dutiesCache.Clear()
+				s.precomputedEpoch = false
+				log.WithError(err).Error("Could not precompute validator duties")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state feed notifier failed")
+			return
+		}
+	}
+}
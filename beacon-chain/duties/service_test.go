@@ -0,0 +1,190 @@
+package duties
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestComputeEpochDuties_MatchesCommitteeAssignments(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 128)
+	epoch := uint64(1)
+
+	duties, err := ComputeEpochDuties(context.Background(), beaconState, epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconState.Slot() != 0 {
+		t.Errorf("Wanted input state to be left at slot 0, got %d", beaconState.Slot())
+	}
+
+	st, err := transition.ProcessSlots(context.Background(), beaconState.Copy(), helpers.StartSlot(epoch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(st, epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(duties.ProposerSlots, proposerIndexToSlots) {
+		t.Errorf("Wanted proposer slots %v, got %v", proposerIndexToSlots, duties.ProposerSlots)
+	}
+	if len(duties.Assignments) != len(committeeAssignments) {
+		t.Fatalf("Wanted %d assignments, got %d", len(committeeAssignments), len(duties.Assignments))
+	}
+	for idx, ca := range committeeAssignments {
+		a := duties.Assignments[idx]
+		if a.AttesterSlot != ca.AttesterSlot || a.CommitteeIndex != ca.CommitteeIndex || !reflect.DeepEqual(a.Committee, ca.Committee) {
+			t.Errorf("Validator %d: wanted assignment %v, got %v", idx, ca, a)
+		}
+		wantSubnet := helpers.ComputeSubnetFromCommitteeAndSlot(128, ca.CommitteeIndex, ca.AttesterSlot)
+		if a.Subnet != wantSubnet {
+			t.Errorf("Validator %d: wanted subnet %d, got %d", idx, wantSubnet, a.Subnet)
+		}
+	}
+	if len(duties.Subnets) == 0 {
+		t.Error("Wanted the attestation subnets of the epoch")
+	}
+	for i := 1; i < len(duties.Subnets); i++ {
+		if duties.Subnets[i-1] >= duties.Subnets[i] {
+			t.Fatalf("Wanted sorted unique subnets, got %v", duties.Subnets)
+		}
+	}
+
+	pubKey := beaconState.PubkeyAtIndex(5)
+	if idx, ok := duties.ValidatorIndices[pubKey]; !ok || idx != 5 {
+		t.Errorf("Wanted validator index 5, got %d", idx)
+	}
+	if duties.Statuses[5] != ethpb.ValidatorStatus_ACTIVE {
+		t.Errorf("Wanted active status, got %v", duties.Statuses[5])
+	}
+}
+
+func TestService_PrecomputesCurrentAndNextEpoch(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	if err := beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch + 1); err != nil {
+		t.Fatal(err)
+	}
+	dutiesCache := cache.NewDutiesCache()
+	s := NewService(context.Background(), &Config{
+		HeadFetcher: &mock.ChainService{State: beaconState, Root: []byte{'a'}},
+		DutiesCache: dutiesCache,
+	})
+	if err := s.update(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, epoch := range []uint64{1, 2} {
+		duties := dutiesCache.Duties(epoch)
+		if duties == nil {
+			t.Fatalf("Wanted precomputed duties of epoch %d", epoch)
+		}
+		if duties.HeadRoot != [32]byte{'a'} {
+			t.Errorf("Wanted head root %#x, got %#x", []byte{'a'}, duties.HeadRoot)
+		}
+		if duties.DependentRoot != params.BeaconConfig().ZeroHash {
+			t.Errorf("Wanted dependent root %#x, got %#x", params.BeaconConfig().ZeroHash, duties.DependentRoot)
+		}
+	}
+	if s.lastHeadEpoch != 1 {
+		t.Errorf("Wanted last head epoch 1, got %d", s.lastHeadEpoch)
+	}
+}
+
+func TestService_RefreshesStatusesOnSameDependentRoot(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	if err := beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch + 1); err != nil {
+		t.Fatal(err)
+	}
+	dutiesCache := cache.NewDutiesCache()
+	s := NewService(context.Background(), &Config{
+		HeadFetcher: &mock.ChainService{State: beaconState, Root: []byte{'a'}},
+		DutiesCache: dutiesCache,
+	})
+	if err := s.update(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	precomputed := dutiesCache.Duties(1)
+
+	// A new head on the same chain slashes a validator.
+	headState := beaconState.Copy()
+	if err := headState.SetSlot(params.BeaconConfig().SlotsPerEpoch + 2); err != nil {
+		t.Fatal(err)
+	}
+	val, err := headState.ValidatorAtIndex(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val.Slashed = true
+	val.ExitEpoch = 10
+	if err := headState.UpdateValidatorAtIndex(3, val); err != nil {
+		t.Fatal(err)
+	}
+	s.headFetcher = &mock.ChainService{State: headState, Root: []byte{'b'}}
+	if err := s.update(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	duties := dutiesCache.Duties(1)
+	if duties.Statuses[3] != ethpb.ValidatorStatus_SLASHING {
+		t.Errorf("Wanted status %v, got %v", ethpb.ValidatorStatus_SLASHING, duties.Statuses[3])
+	}
+	if precomputed.Statuses[3] != ethpb.ValidatorStatus_ACTIVE {
+		t.Errorf("Wanted previously cached duties to be left unchanged, got status %v", precomputed.Statuses[3])
+	}
+	// The shuffling derived duties are kept, as the dependent root did not change.
+	if duties.HeadRoot != [32]byte{'a'} {
+		t.Errorf("Wanted head root %#x, got %#x", []byte{'a'}, duties.HeadRoot)
+	}
+	if !reflect.DeepEqual(duties.Assignments, precomputed.Assignments) {
+		t.Error("Wanted assignments to be kept")
+	}
+}
+
+func TestService_RecomputesOnDependentRootChange(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	if err := beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch + 1); err != nil {
+		t.Fatal(err)
+	}
+	dutiesCache := cache.NewDutiesCache()
+	s := NewService(context.Background(), &Config{
+		HeadFetcher: &mock.ChainService{State: beaconState, Root: []byte{'a'}},
+		DutiesCache: dutiesCache,
+	})
+	if err := s.update(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// A reorg moves the head, within the same epoch, to a chain with another last block in
+	// the previous epoch.
+	headState := beaconState.Copy()
+	dependent := [32]byte{'d'}
+	if err := headState.UpdateBlockRootAtIndex(params.BeaconConfig().SlotsPerEpoch-1, dependent); err != nil {
+		t.Fatal(err)
+	}
+	s.headFetcher = &mock.ChainService{State: headState, Root: []byte{'b'}}
+	if err := s.update(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, epoch := range []uint64{1, 2} {
+		duties := dutiesCache.Duties(epoch)
+		if duties == nil {
+			t.Fatalf("Wanted precomputed duties of epoch %d", epoch)
+		}
+		if duties.HeadRoot != [32]byte{'b'} {
+			t.Errorf("Epoch %d: wanted head root %#x, got %#x", epoch, []byte{'b'}, duties.HeadRoot)
+		}
+		if duties.DependentRoot != dependent {
+			t.Errorf("Epoch %d: wanted dependent root %#x, got %#x", epoch, dependent, duties.DependentRoot)
+		}
+	}
+}
@@ -23,6 +23,12 @@ var (
 		Name:  "archive-blocks",
 		Usage: "Whether or not beacon chain should archive historical blocks",
 	}
+	// ArchiveValidatorRewardsFlag defines whether or not the beacon chain should archive
+	// the breakdown of the rewards and penalties of every validator in persistent storage.
+	ArchiveValidatorRewardsFlag = &cli.BoolFlag{
+		Name:  "archive-validator-rewards",
+		Usage: "Whether or not beacon chain should archive the per validator breakdown of rewards and penalties at every epoch",
+	}
 	// ArchiveAttestationsFlag defines whether or not the beacon chain should archive
 	// historical attestation data in persistent storage.
 	ArchiveAttestationsFlag = &cli.BoolFlag{
@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -13,6 +15,16 @@ var (
 		Usage: "A mainchain web3 provider string http endpoint",
 		Value: "https://goerli.prylabs.net",
 	}
+	// FallbackWeb3ProviderFlag provides fallback HTTP access endpoints to ETH 1.0 RPCs.
+	FallbackWeb3ProviderFlag = &cli.StringSliceFlag{
+		Name:  "fallback-web3provider",
+		Usage: "A fallback mainchain web3 provider string http endpoint, used in order when the primary endpoint is unhealthy. This flag may be used multiple times.",
+	}
+	// DepositSnapshotFlag specifies the path to a deposit tree snapshot to seed the deposit trie from.
+	DepositSnapshotFlag = &cli.StringFlag{
+		Name:  "deposit-snapshot",
+		Usage: "Path to a protobuf encoded deposit tree snapshot exported from a synced node with tools/deposit-snapshot, used to seed the deposit trie of a node with no eth1 data so only the deposit contract logs following the snapshot are processed. The genesis state of the snapshot is saved into a database with no genesis state.",
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = &cli.StringFlag{
 		Name:  "deposit-contract",
@@ -119,6 +131,33 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// ArchivedPointsPerFullState specifies every how many archived points a full state is saved in the cold
+	// section of DB. The archived points in between are saved as diffs against the last full state.
+	ArchivedPointsPerFullState = &cli.IntFlag{
+		Name:  "archived-points-per-full-state",
+		Usage: "Every how many archived points a full state gets saved in the DB, with diffs saved for the archived points in between. 1 saves every archived state in full.",
+		Value: 1,
+	}
+	// HotStateReplayBudget specifies the longest a hot state is expected to take to regenerate by replaying
+	// blocks, before the node saves a state snapshot in the hot section of DB.
+	HotStateReplayBudget = &cli.DurationFlag{
+		Name:  "hot-state-replay-budget",
+		Usage: "The maximum estimated time spent replaying blocks to regenerate a hot state before a snapshot of it is saved.",
+		Value: 3 * time.Second,
+	}
+	// HotStateCacheMemory specifies the amount of memory in megabytes the hot state cache may use.
+	HotStateCacheMemory = &cli.IntFlag{
+		Name:  "hot-state-cache-memory",
+		Usage: "The amount of memory in megabytes the cached hot states may use.",
+		Value: 2048,
+	}
+	// HotStateNonFinalityEpochs specifies the number of epochs since finality after which epoch boundary
+	// hot state snapshots are thinned out.
+	HotStateNonFinalityEpochs = &cli.IntFlag{
+		Name:  "hot-state-non-finality-epochs",
+		Usage: "The number of epochs without finality after which fewer epoch boundary states are saved in the hot section of DB.",
+		Value: 16,
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -136,6 +175,18 @@ var (
 		Usage: "The factor by which block batch limit may increase on burst.",
 		Value: 10,
 	}
+	// RPCRequestLimit specifies the amount of non-block req/resp requests a peer may send per second.
+	RPCRequestLimit = &cli.IntFlag{
+		Name:  "rpc-request-limit",
+		Usage: "The amount of status, goodbye, ping and metadata requests the local peer allows from a remote peer per second.",
+		Value: 1,
+	}
+	// RPCRequestLimitBurstFactor specifies the factor by which the rpc request limit may increase.
+	RPCRequestLimitBurstFactor = &cli.IntFlag{
+		Name:  "rpc-request-limit-burst-factor",
+		Usage: "The factor by which the rpc request limit may increase on burst.",
+		Value: 5,
+	}
 	// EnableDebugRPCEndpoints as /v1/beacon/state.
 	EnableDebugRPCEndpoints = &cli.BoolFlag{
 		Name:  "enable-debug-rpc-endpoints",
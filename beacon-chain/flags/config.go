@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -13,6 +15,7 @@ type GlobalFlags struct {
 	EnableArchivedValidatorSetChanges bool
 	EnableArchivedBlocks              bool
 	EnableArchivedAttestations        bool
+	EnableArchivedValidatorRewards    bool
 	UnsafeSync                        bool
 	DisableDiscv5                     bool
 	MinimumSyncPeers                  int
@@ -20,6 +23,12 @@ type GlobalFlags struct {
 	DeploymentBlock                   int
 	BlockBatchLimit                   int
 	BlockBatchLimitBurstFactor        int
+	RPCRequestLimit                   int
+	RPCRequestLimitBurstFactor        int
+	HotStateReplayBudget              time.Duration
+	HotStateCacheMemory               uint64
+	HotStateNonFinalityEpochs         uint64
+	ArchivedPointsPerFullState        uint64
 }
 
 var globalConfig *GlobalFlags
@@ -53,6 +62,9 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	if ctx.Bool(ArchiveAttestationsFlag.Name) {
 		cfg.EnableArchivedAttestations = true
 	}
+	if ctx.Bool(ArchiveValidatorRewardsFlag.Name) {
+		cfg.EnableArchivedValidatorRewards = true
+	}
 	if ctx.Bool(UnsafeSync.Name) {
 		cfg.UnsafeSync = true
 	}
@@ -61,8 +73,14 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	}
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
+	cfg.RPCRequestLimit = ctx.Int(RPCRequestLimit.Name)
+	cfg.RPCRequestLimitBurstFactor = ctx.Int(RPCRequestLimitBurstFactor.Name)
 	cfg.MaxPageSize = ctx.Int(RPCMaxPageSize.Name)
 	cfg.DeploymentBlock = ctx.Int(ContractDeploymentBlock.Name)
+	cfg.HotStateReplayBudget = ctx.Duration(HotStateReplayBudget.Name)
+	cfg.HotStateCacheMemory = uint64(ctx.Int(HotStateCacheMemory.Name)) << 20
+	cfg.HotStateNonFinalityEpochs = uint64(ctx.Int(HotStateNonFinalityEpochs.Name))
+	cfg.ArchivedPointsPerFullState = uint64(ctx.Int(ArchivedPointsPerFullState.Name))
 	configureMinimumPeers(ctx, cfg)
 
 	Init(cfg)
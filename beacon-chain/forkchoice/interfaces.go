@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 )
 
 // ForkChoicer represents the full fork choice interface composed of all of the sub-interfaces.
@@ -11,8 +12,10 @@ type ForkChoicer interface {
 	HeadRetriever        // to compute head.
 	BlockProcessor       // to track new block for fork choice.
 	AttestationProcessor // to track new attestation for fork choice.
+	SlashingProcessor    // to discount votes of equivocating validators.
 	Pruner               // to clean old data for fork choice.
 	Getter               // to retrieve fork choice information.
+	Persister            // to persist fork choice across restarts.
 }
 
 // HeadRetriever retrieves head root of the current chain.
@@ -30,6 +33,12 @@ type AttestationProcessor interface {
 	ProcessAttestation(context.Context, []uint64, [32]byte, uint64)
 }
 
+// SlashingProcessor processes the validator indices slashed by attester slashings, whose votes are
+// no longer accounted for in fork choice.
+type SlashingProcessor interface {
+	ProcessAttesterSlashing(context.Context, []uint64)
+}
+
 // Pruner prunes the fork choice upon new finalization. This is used to keep fork choice sane.
 type Pruner interface {
 	Prune(context.Context, [32]byte) error
@@ -41,4 +50,10 @@ type Getter interface {
 	Node([32]byte) *protoarray.Node
 	HasNode([32]byte) bool
 	Store() *protoarray.Store
+	Tree(uint64, uint64) []*protoarray.TreeNode
+}
+
+// Persister converts the fork choice to a proto so it can be saved to disk and restored on restart.
+type Persister interface {
+	ToProto() *dbpb.ForkChoiceNodes
 }
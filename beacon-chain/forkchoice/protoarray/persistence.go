@@ -0,0 +1,156 @@
+package protoarray
+
+import (
+	"fmt"
+	"sort"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+// ToProto converts the fork choice store nodes, the validators' latest votes, the last justified
+// balances and the equivocating validators to a proto, so that the fork choice can be saved to DB
+// and restored with CreateFromProto after a restart.
+func (f *ForkChoice) ToProto() *dbpb.ForkChoiceNodes {
+	// The exclusive lock guarantees the snapshot is not interleaved with a head computation.
+	f.store.nodeIndicesLock.Lock()
+	defer f.store.nodeIndicesLock.Unlock()
+
+	finalizedRoot := f.store.finalizedRoot
+	nodes := make([]*dbpb.ForkChoiceNode, len(f.store.Nodes))
+	for i, n := range f.store.Nodes {
+		root, graffiti := n.Root, n.Graffiti
+		nodes[i] = &dbpb.ForkChoiceNode{
+			Slot:           n.Slot,
+			Root:           root[:],
+			Parent:         n.Parent,
+			JustifiedEpoch: n.JustifiedEpoch,
+			FinalizedEpoch: n.FinalizedEpoch,
+			Weight:         n.Weight,
+			BestChild:      n.BestChild,
+			BestDescendant: n.BestDescendent,
+			Graffiti:       graffiti[:],
+		}
+	}
+	votes := make([]*dbpb.ForkChoiceVote, len(f.votes))
+	for i, v := range f.votes {
+		currentRoot, nextRoot := v.currentRoot, v.nextRoot
+		votes[i] = &dbpb.ForkChoiceVote{
+			CurrentRoot: currentRoot[:],
+			NextRoot:    nextRoot[:],
+			NextEpoch:   v.nextEpoch,
+		}
+	}
+	balances := make([]uint64, len(f.balances))
+	copy(balances, f.balances)
+	indices := make([]uint64, 0, len(f.equivocatingIndices))
+	for index := range f.equivocatingIndices {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	return &dbpb.ForkChoiceNodes{
+		JustifiedEpoch:      f.store.JustifiedEpoch,
+		FinalizedEpoch:      f.store.FinalizedEpoch,
+		FinalizedRoot:       finalizedRoot[:],
+		PruneThreshold:      f.store.PruneThreshold,
+		Nodes:               nodes,
+		Votes:               votes,
+		Balances:            balances,
+		EquivocatingIndices: indices,
+	}
+}
+
+// CreateFromProto restores a fork choice from a proto produced by ToProto. The restored store is
+// checked for internal consistency: every node index must be in range, parents must precede their
+// children and block roots must be unique.
+func CreateFromProto(pb *dbpb.ForkChoiceNodes) (*ForkChoice, error) {
+	finalizedRoot, err := toRoot(pb.FinalizedRoot)
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{
+		PruneThreshold: pb.PruneThreshold,
+		JustifiedEpoch: pb.JustifiedEpoch,
+		FinalizedEpoch: pb.FinalizedEpoch,
+		finalizedRoot:  finalizedRoot,
+		Nodes:          make([]*Node, len(pb.Nodes)),
+		NodeIndices:    make(map[[32]byte]uint64, len(pb.Nodes)),
+	}
+	nodeCount := uint64(len(pb.Nodes))
+	for i, n := range pb.Nodes {
+		index := uint64(i)
+		root, err := toRoot(n.Root)
+		if err != nil {
+			return nil, err
+		}
+		graffiti, err := toRoot(n.Graffiti)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := s.NodeIndices[root]; ok {
+			return nil, fmt.Errorf("duplicated node with root %#x", root)
+		}
+		if n.Parent != NonExistentNode && n.Parent >= index {
+			return nil, errInvalidNodeIndex
+		}
+		if n.BestChild != NonExistentNode && (n.BestChild <= index || n.BestChild >= nodeCount) {
+			return nil, errInvalidBestChildIndex
+		}
+		if n.BestDescendant != NonExistentNode && (n.BestDescendant < index || n.BestDescendant >= nodeCount) {
+			return nil, errInvalidBestDescendantIndex
+		}
+		s.Nodes[i] = &Node{
+			Slot:           n.Slot,
+			Root:           root,
+			Parent:         n.Parent,
+			JustifiedEpoch: n.JustifiedEpoch,
+			FinalizedEpoch: n.FinalizedEpoch,
+			Weight:         n.Weight,
+			BestChild:      n.BestChild,
+			BestDescendent: n.BestDescendant,
+			Graffiti:       graffiti,
+		}
+		s.NodeIndices[root] = index
+	}
+
+	votes := make([]Vote, len(pb.Votes))
+	for i, v := range pb.Votes {
+		currentRoot, err := toRoot(v.CurrentRoot)
+		if err != nil {
+			return nil, err
+		}
+		nextRoot, err := toRoot(v.NextRoot)
+		if err != nil {
+			return nil, err
+		}
+		votes[i] = Vote{currentRoot: currentRoot, nextRoot: nextRoot, nextEpoch: v.NextEpoch}
+	}
+	balances := make([]uint64, len(pb.Balances))
+	copy(balances, pb.Balances)
+	equivocatingIndices := make(map[uint64]bool, len(pb.EquivocatingIndices))
+	for _, index := range pb.EquivocatingIndices {
+		equivocatingIndices[index] = true
+	}
+
+	return &ForkChoice{store: s, votes: votes, balances: balances, equivocatingIndices: equivocatingIndices}, nil
+}
+
+// Balances returns a copy of the last justified balances the fork choice has applied.
+func (f *ForkChoice) Balances() []uint64 {
+	f.store.nodeIndicesLock.RLock()
+	defer f.store.nodeIndicesLock.RUnlock()
+
+	cpy := make([]uint64, len(f.balances))
+	copy(cpy, f.balances)
+	return cpy
+}
+
+// toRoot converts a saved root to a 32 bytes root, rejecting roots of any other length.
+func toRoot(b []byte) ([32]byte, error) {
+	var root [32]byte
+	if len(b) != len(root) {
+		return root, errInvalidRootLength
+	}
+	copy(root[:], b)
+	return root, nil
+}
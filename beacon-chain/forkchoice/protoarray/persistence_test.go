@@ -0,0 +1,149 @@
+package protoarray
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestForkChoice_ToProtoCreateFromProto(t *testing.T) {
+	f := &ForkChoice{
+		store: &Store{
+			PruneThreshold: 128,
+			JustifiedEpoch: 2,
+			FinalizedEpoch: 1,
+			finalizedRoot:  [32]byte{'a'},
+			Nodes: []*Node{
+				{Slot: 32, Root: [32]byte{'a'}, Parent: NonExistentNode, JustifiedEpoch: 1, FinalizedEpoch: 1, Weight: 30, BestChild: 1, BestDescendent: 2},
+				{Slot: 33, Root: [32]byte{'b'}, Parent: 0, JustifiedEpoch: 1, FinalizedEpoch: 1, Weight: 20, BestChild: 2, BestDescendent: 2, Graffiti: [32]byte{'g'}},
+				{Slot: 34, Root: [32]byte{'c'}, Parent: 1, JustifiedEpoch: 2, FinalizedEpoch: 1, Weight: 10, BestChild: NonExistentNode, BestDescendent: NonExistentNode},
+				{Slot: 34, Root: [32]byte{'d'}, Parent: 0, JustifiedEpoch: 1, FinalizedEpoch: 1, Weight: 10, BestChild: NonExistentNode, BestDescendent: NonExistentNode},
+			},
+			NodeIndices: map[[32]byte]uint64{{'a'}: 0, {'b'}: 1, {'c'}: 2, {'d'}: 3},
+		},
+		votes: []Vote{
+			{currentRoot: [32]byte{'c'}, nextRoot: [32]byte{'c'}, nextEpoch: 2},
+			{currentRoot: [32]byte{'b'}, nextRoot: [32]byte{'d'}, nextEpoch: 3},
+		},
+		balances:            []uint64{10, 20},
+		equivocatingIndices: map[uint64]bool{1: true, 7: true},
+	}
+
+	restored, err := CreateFromProto(f.ToProto())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored.store.Nodes, f.store.Nodes) {
+		t.Error("Restored nodes are not equal")
+	}
+	if !reflect.DeepEqual(restored.store.NodeIndices, f.store.NodeIndices) {
+		t.Error("Restored node indices are not equal")
+	}
+	if !reflect.DeepEqual(restored.votes, f.votes) {
+		t.Error("Restored votes are not equal")
+	}
+	if !reflect.DeepEqual(restored.balances, f.balances) {
+		t.Error("Restored balances are not equal")
+	}
+	if !reflect.DeepEqual(restored.equivocatingIndices, f.equivocatingIndices) {
+		t.Error("Restored equivocating indices are not equal")
+	}
+	if restored.store.JustifiedEpoch != 2 || restored.store.FinalizedEpoch != 1 || restored.store.PruneThreshold != 128 {
+		t.Error("Restored store checkpoints are not equal")
+	}
+	if restored.store.finalizedRoot != f.store.finalizedRoot {
+		t.Error("Restored finalized root is not equal")
+	}
+}
+
+func TestForkChoice_ToProtoConcurrentWithHead(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	if err := f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ProcessBlock(ctx, 2, indexToHash(2), indexToHash(1), [32]byte{}, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := uint64(0); i < 100; i++ {
+			f.ProcessAttestation(ctx, []uint64{i % 8}, indexToHash(1+i%2), i)
+			balances := make([]uint64, 8+i)
+			for j := range balances {
+				balances[j] = i
+			}
+			if _, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			if _, err := CreateFromProto(f.ToProto()); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
+
+func TestCreateFromProto_Invalid(t *testing.T) {
+	f := &ForkChoice{
+		store: &Store{
+			Nodes: []*Node{
+				{Root: [32]byte{'a'}, Parent: NonExistentNode, BestChild: 1, BestDescendent: 1},
+				{Root: [32]byte{'b'}, Parent: 0, BestChild: NonExistentNode, BestDescendent: NonExistentNode},
+			},
+			NodeIndices: map[[32]byte]uint64{{'a'}: 0, {'b'}: 1},
+		},
+		balances: []uint64{1},
+	}
+	if _, err := CreateFromProto(f.ToProto()); err != nil {
+		t.Fatal(err)
+	}
+
+	pb := f.ToProto()
+	pb.FinalizedRoot = nil
+	if _, err := CreateFromProto(pb); err != errInvalidRootLength {
+		t.Errorf("Wanted %v for missing finalized root, got %v", errInvalidRootLength, err)
+	}
+	pb = f.ToProto()
+	pb.Nodes[1].Root = pb.Nodes[1].Root[:31]
+	if _, err := CreateFromProto(pb); err != errInvalidRootLength {
+		t.Errorf("Wanted %v for short node root, got %v", errInvalidRootLength, err)
+	}
+
+	pb = f.ToProto()
+	pb.Nodes[0].Parent = 1
+	if _, err := CreateFromProto(pb); err != errInvalidNodeIndex {
+		t.Errorf("Wanted %v for parent after child, got %v", errInvalidNodeIndex, err)
+	}
+
+	pb = f.ToProto()
+	pb.Nodes[0].BestChild = 2
+	if _, err := CreateFromProto(pb); err != errInvalidBestChildIndex {
+		t.Errorf("Wanted %v for out of range best child, got %v", errInvalidBestChildIndex, err)
+	}
+
+	pb = f.ToProto()
+	pb.Nodes[0].BestDescendant = 2
+	if _, err := CreateFromProto(pb); err != errInvalidBestDescendantIndex {
+		t.Errorf("Wanted %v for out of range best descendant, got %v", errInvalidBestDescendantIndex, err)
+	}
+
+	pb = f.ToProto()
+	pb.Nodes[1].Root = pb.Nodes[0].Root
+	if _, err := CreateFromProto(pb); err == nil {
+		t.Error("Wanted error for duplicated node roots")
+	}
+}
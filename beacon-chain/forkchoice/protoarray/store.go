@@ -29,7 +29,7 @@ func New(justifiedEpoch uint64, finalizedEpoch uint64, finalizedRoot [32]byte) *
 	b := make([]uint64, 0)
 	v := make([]Vote, 0)
 
-	return &ForkChoice{store: s, balances: b, votes: v}
+	return &ForkChoice{store: s, balances: b, votes: v, equivocatingIndices: make(map[uint64]bool)}
 }
 
 // Head returns the head root from fork choice store.
@@ -41,10 +41,10 @@ func (f *ForkChoice) Head(ctx context.Context, justifiedEpoch uint64, justifiedR
 
 	newBalances := justifiedStateBalances
 
-	// Using the read lock is ok here, rest of the operations below is read only.
-	// The only time it writes to node indices is inserting and pruning blocks from the store.
-	f.store.nodeIndicesLock.RLock()
-	defer f.store.nodeIndicesLock.RUnlock()
+	// The votes, balances and node weights are updated below, so the lock must be exclusive
+	// for readers such as ToProto to observe a consistent store.
+	f.store.nodeIndicesLock.Lock()
+	defer f.store.nodeIndicesLock.Unlock()
 	deltas, newVotes, err := computeDeltas(ctx, f.store.NodeIndices, f.votes, f.balances, newBalances)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not compute deltas")
@@ -65,7 +65,15 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.ProcessAttestation")
 	defer span.End()
 
+	f.store.nodeIndicesLock.Lock()
+	defer f.store.nodeIndicesLock.Unlock()
+
 	for _, index := range validatorIndices {
+		// Votes of equivocating validators are no longer accounted for.
+		if f.equivocatingIndices[index] {
+			continue
+		}
+
 		// Validator indices will grow the vote cache.
 		for index >= uint64(len(f.votes)) {
 			f.votes = append(f.votes, Vote{currentRoot: params.BeaconConfig().ZeroHash, nextRoot: params.BeaconConfig().ZeroHash})
@@ -85,6 +93,30 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	processedAttestationCount.Inc()
 }
 
+// ProcessAttesterSlashing marks the validators slashed by an attester slashing as equivocating.
+// The latest votes of equivocating validators are removed from the fork choice weights on the next
+// head computation, and their subsequent attestations are ignored.
+func (f *ForkChoice) ProcessAttesterSlashing(ctx context.Context, slashedIndices []uint64) {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.ProcessAttesterSlashing")
+	defer span.End()
+
+	f.store.nodeIndicesLock.Lock()
+	defer f.store.nodeIndicesLock.Unlock()
+
+	for _, index := range slashedIndices {
+		if f.equivocatingIndices[index] {
+			continue
+		}
+		f.equivocatingIndices[index] = true
+
+		// Moving the vote to the zero hash removes its balance from the current root when
+		// deltas are next computed, without adding it to any other node.
+		if index < uint64(len(f.votes)) {
+			f.votes[index].nextRoot = params.BeaconConfig().ZeroHash
+		}
+	}
+}
+
 // ProcessBlock processes a new block by inserting it to the fork choice store.
 func (f *ForkChoice) ProcessBlock(ctx context.Context, slot uint64, blockRoot [32]byte, parentRoot [32]byte, graffiti [32]byte, justifiedEpoch uint64, finalizedEpoch uint64) error {
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.ProcessBlock")
@@ -0,0 +1,51 @@
+package protoarray
+
+// TreeNode is a view of a fork choice node for debugging, where the parent, best child and best
+// descendant indices are resolved to block roots. Roots of nodes that don't exist are zero.
+type TreeNode struct {
+	Slot           uint64
+	Root           [32]byte
+	Parent         [32]byte
+	JustifiedEpoch uint64
+	FinalizedEpoch uint64
+	Weight         uint64
+	BestChild      [32]byte
+	BestDescendant [32]byte
+	Graffiti       [32]byte
+	Viable         bool // whether the node's checkpoints agree with the store, so it can be head.
+}
+
+// Tree returns the nodes of the fork choice store with a slot between start slot and end slot,
+// inclusive, in insertion order.
+func (f *ForkChoice) Tree(startSlot uint64, endSlot uint64) []*TreeNode {
+	f.store.nodeIndicesLock.RLock()
+	defer f.store.nodeIndicesLock.RUnlock()
+
+	nodes := f.store.Nodes
+	rootAt := func(index uint64) [32]byte {
+		if index == NonExistentNode || index >= uint64(len(nodes)) {
+			return [32]byte{}
+		}
+		return nodes[index].Root
+	}
+
+	tree := make([]*TreeNode, 0, len(nodes))
+	for _, n := range nodes {
+		if n.Slot < startSlot || n.Slot > endSlot {
+			continue
+		}
+		tree = append(tree, &TreeNode{
+			Slot:           n.Slot,
+			Root:           n.Root,
+			Parent:         rootAt(n.Parent),
+			JustifiedEpoch: n.JustifiedEpoch,
+			FinalizedEpoch: n.FinalizedEpoch,
+			Weight:         n.Weight,
+			BestChild:      rootAt(n.BestChild),
+			BestDescendant: rootAt(n.BestDescendent),
+			Graffiti:       n.Graffiti,
+			Viable:         f.store.viableForHead(n),
+		})
+	}
+	return tree
+}
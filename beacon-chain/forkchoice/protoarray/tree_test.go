@@ -0,0 +1,39 @@
+package protoarray
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestForkChoice_Tree(t *testing.T) {
+	f := &ForkChoice{
+		store: &Store{
+			JustifiedEpoch: 1,
+			FinalizedEpoch: 1,
+			Nodes: []*Node{
+				{Slot: 32, Root: [32]byte{'a'}, Parent: NonExistentNode, JustifiedEpoch: 1, FinalizedEpoch: 1, Weight: 30, BestChild: 1, BestDescendent: 1},
+				{Slot: 33, Root: [32]byte{'b'}, Parent: 0, JustifiedEpoch: 1, FinalizedEpoch: 1, Weight: 20, BestChild: NonExistentNode, BestDescendent: NonExistentNode, Graffiti: [32]byte{'g'}},
+				{Slot: 34, Root: [32]byte{'c'}, Parent: 0, JustifiedEpoch: 0, FinalizedEpoch: 0, Weight: 10, BestChild: NonExistentNode, BestDescendent: NonExistentNode},
+			},
+			NodeIndices: map[[32]byte]uint64{{'a'}: 0, {'b'}: 1, {'c'}: 2},
+		},
+	}
+
+	tree := f.Tree(0, 100)
+	wanted := []*TreeNode{
+		{Slot: 32, Root: [32]byte{'a'}, JustifiedEpoch: 1, FinalizedEpoch: 1, Weight: 30, BestChild: [32]byte{'b'}, BestDescendant: [32]byte{'b'}, Viable: true},
+		{Slot: 33, Root: [32]byte{'b'}, Parent: [32]byte{'a'}, JustifiedEpoch: 1, FinalizedEpoch: 1, Weight: 20, Graffiti: [32]byte{'g'}, Viable: true},
+		{Slot: 34, Root: [32]byte{'c'}, Parent: [32]byte{'a'}, Weight: 10, Viable: false},
+	}
+	if !reflect.DeepEqual(tree, wanted) {
+		t.Errorf("Wanted tree %v, got %v", wanted, tree)
+	}
+
+	tree = f.Tree(33, 33)
+	if len(tree) != 1 || tree[0].Root != [32]byte{'b'} {
+		t.Errorf("Wanted only node at slot 33, got %v", tree)
+	}
+	if tree := f.Tree(35, 40); len(tree) != 0 {
+		t.Errorf("Wanted no nodes, got %d", len(tree))
+	}
+}
@@ -4,9 +4,10 @@ import "sync"
 
 // ForkChoice defines the overall fork choice store which includes all block nodes, validator's latest votes and balances.
 type ForkChoice struct {
-	store    *Store
-	votes    []Vote   // tracks individual validator's last vote.
-	balances []uint64 // tracks individual validator's last justified balances.
+	store               *Store
+	votes               []Vote          // tracks individual validator's last vote.
+	balances            []uint64        // tracks individual validator's last justified balances.
+	equivocatingIndices map[uint64]bool // tracks validators slashed for equivocating attestations.
 }
 
 // Store defines the fork choice store which includes block nodes and the last view of checkpoint information.
@@ -394,3 +394,63 @@ func TestVotes_CanFindHead(t *testing.T) {
 		t.Error("Incorrect head for with justified epoch at 2")
 	}
 }
+
+func TestVotes_EquivocatingValidatorsAreDiscounted(t *testing.T) {
+	ctx := context.Background()
+	balances := []uint64{1, 1, 1}
+	f := setup(1, 1)
+
+	// Insert blocks 2 and 1 into the tree:
+	//            0
+	//           / \
+	//          2   1
+	if err := f.ProcessBlock(ctx, 0, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ProcessBlock(ctx, 0, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// Two votes for block 1 and one for block 2, head is at 1.
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(1), 2)
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(2), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != indexToHash(1) {
+		t.Error("Incorrect head with two votes for block 1")
+	}
+
+	// Slashing both validators voting for block 1 removes their votes, head switches to 2.
+	f.ProcessAttesterSlashing(ctx, []uint64{0, 1})
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != indexToHash(2) {
+		t.Error("Incorrect head after slashing validators voting for block 1")
+	}
+	if w := f.Node(indexToHash(1)).Weight; w != 0 {
+		t.Errorf("Wanted weight 0 for block 1, got %d", w)
+	}
+
+	// Later votes of equivocating validators are ignored.
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(1), 3)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != indexToHash(2) {
+		t.Error("Incorrect head after equivocating validators voted again")
+	}
+
+	// Slashing a validator that has not voted yet, and slashing a validator twice, is harmless.
+	f.ProcessAttesterSlashing(ctx, []uint64{1, 5})
+	if _, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1); err != nil {
+		t.Fatal(err)
+	}
+	if w := f.Node(indexToHash(2)).Weight; w != 1 {
+		t.Errorf("Wanted weight 1 for block 2, got %d", w)
+	}
+}
@@ -27,6 +27,8 @@ import (
 var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.HTTPWeb3ProviderFlag,
+	flags.FallbackWeb3ProviderFlag,
+	flags.DepositSnapshotFlag,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
@@ -44,6 +46,8 @@ var appFlags = []cli.Flag{
 	flags.DisableDiscv5,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
+	flags.RPCRequestLimit,
+	flags.RPCRequestLimitBurstFactor,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
@@ -52,7 +56,12 @@ var appFlags = []cli.Flag{
 	flags.ArchiveValidatorSetChangesFlag,
 	flags.ArchiveBlocksFlag,
 	flags.ArchiveAttestationsFlag,
+	flags.ArchiveValidatorRewardsFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.ArchivedPointsPerFullState,
+	flags.HotStateReplayBudget,
+	flags.HotStateCacheMemory,
+	flags.HotStateNonFinalityEpochs,
 	flags.EnableDebugRPCEndpoints,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
@@ -23,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/duties"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
@@ -37,6 +38,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
@@ -68,6 +70,7 @@ type BeaconNode struct {
 	stop              chan struct{} // Channel to wait for termination notifications.
 	db                db.Database
 	stateSummaryCache *cache.StateSummaryCache
+	dutiesCache       *cache.DutiesCache
 	attestationPool   attestations.Pool
 	exitPool          *voluntaryexits.Pool
 	slashingsPool     *slashings.Pool
@@ -121,6 +124,7 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		exitPool:          voluntaryexits.NewPool(),
 		slashingsPool:     slashings.NewPool(),
 		stateSummaryCache: cache.NewStateSummaryCache(),
+		dutiesCache:       cache.NewDutiesCache(),
 	}
 
 	if err := beacon.startDB(cliCtx); err != nil {
@@ -151,6 +155,10 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerDutiesService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerInitialSyncService(); err != nil {
 		return nil, err
 	}
@@ -410,12 +418,26 @@ func (b *BeaconNode) registerPOWChainService() error {
 		log.Warn("Using default ETH1 connection provided by Prysmatic Labs. Please consider running your own ETH1 node for better uptime, security, and decentralization of ETH2. Visit https://docs.prylabs.network/docs/prysm-usage/setup-eth1 for more information.")
 	}
 
+	var depositSnapshot *protodb.DepositSnapshot
+	if snapshotPath := b.cliCtx.String(flags.DepositSnapshotFlag.Name); snapshotPath != "" {
+		enc, err := ioutil.ReadFile(snapshotPath)
+		if err != nil {
+			return errors.Wrap(err, "could not read deposit snapshot")
+		}
+		depositSnapshot = &protodb.DepositSnapshot{}
+		if err := depositSnapshot.Unmarshal(enc); err != nil {
+			return errors.Wrap(err, "could not unmarshal deposit snapshot")
+		}
+	}
+
 	cfg := &powchain.Web3ServiceConfig{
-		HTTPEndPoint:    b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name),
-		DepositContract: common.HexToAddress(depAddress),
-		BeaconDB:        b.db,
-		DepositCache:    b.depositCache,
-		StateNotifier:   b,
+		HTTPEndPoint:      b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name),
+		FallbackEndPoints: b.cliCtx.StringSlice(flags.FallbackWeb3ProviderFlag.Name),
+		DepositContract:   common.HexToAddress(depAddress),
+		BeaconDB:          b.db,
+		DepositCache:      b.depositCache,
+		StateNotifier:     b,
+		DepositSnapshot:   depositSnapshot,
 	}
 	web3Service, err := powchain.NewService(b.ctx, cfg)
 	if err != nil {
@@ -486,6 +508,19 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerDutiesService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc := duties.NewService(b.ctx, &duties.Config{
+		HeadFetcher:   chainService,
+		StateNotifier: b,
+		DutiesCache:   b.dutiesCache,
+	})
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -558,6 +593,7 @@ func (b *BeaconNode) registerRPCService() error {
 		SlasherCert:             slasherCert,
 		SlasherProvider:         slasherProvider,
 		StateGen:                b.stateGen,
+		DutiesCache:             b.dutiesCache,
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
 	})
 
@@ -582,6 +618,7 @@ func (b *BeaconNode) registerPrometheusService() error {
 	}
 
 	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/tree", Handler: c.TreeHandler})
+	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/forkchoice", Handler: c.ForkChoiceHandler})
 
 	service := prometheus.NewPrometheusService(
 		fmt.Sprintf("%s:%d", b.cliCtx.String(cmd.MonitoringHostFlag.Name), b.cliCtx.Int64(flags.MonitoringPortFlag.Name)),
@@ -649,6 +686,7 @@ func (b *BeaconNode) registerArchiverService() error {
 		HeadFetcher:          chainService,
 		ParticipationFetcher: chainService,
 		StateNotifier:        b,
+		StateGen:             b.stateGen,
 	})
 	return b.services.RegisterService(svc)
 }
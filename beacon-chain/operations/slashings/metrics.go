@@ -24,6 +24,12 @@ var (
 			Help: "Number of attester slashings included in blocks",
 		},
 	)
+	numAttesterSlashingsDropped = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "attester_slashings_dropped_total",
+			Help: "Number of pending attester slashings dropped from the pool as no longer valid",
+		},
+	)
 	attesterSlashingReattempts = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "attester_slashing_reattempts_total",
@@ -48,6 +54,12 @@ var (
 			Help: "Number of proposer slashings included in blocks",
 		},
 	)
+	numProposerSlashingsDropped = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "proposer_slashings_dropped_total",
+			Help: "Number of pending proposer slashings dropped from the pool as no longer valid",
+		},
+	)
 	proposerSlashingReattempts = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "proposer_slashing_reattempts_total",
@@ -25,76 +25,162 @@ func NewPool() *Pool {
 }
 
 // PendingAttesterSlashings returns attester slashings that are able to be included into a block.
-// This method will not return more than the block enforced MaxAttesterSlashings.
+// Every pooled slashing is revalidated against the state, which may be any state, so slashings which
+// are not valid are only skipped. The slashings with the highest whistleblower reward are returned
+// first. This method will not return more than the block enforced MaxAttesterSlashings.
 func (p *Pool) PendingAttesterSlashings(ctx context.Context, state *beaconstate.BeaconState) []*ethpb.AttesterSlashing {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
 	ctx, span := trace.StartSpan(ctx, "operations.PendingAttesterSlashing")
 	defer span.End()
 
-	// Update prom metric.
-	numPendingAttesterSlashings.Set(float64(len(p.pendingAttesterSlashing)))
-
-	included := make(map[uint64]bool)
-	pending := make([]*ethpb.AttesterSlashing, 0, params.BeaconConfig().MaxAttesterSlashings)
-	for i := 0; i < len(p.pendingAttesterSlashing); i++ {
-		slashing := p.pendingAttesterSlashing[i]
-		if len(pending) >= int(params.BeaconConfig().MaxAttesterSlashings) {
-			break
-		}
-		valid, err := p.validatorSlashingPreconditionCheck(state, slashing.validatorToSlash)
-		if err != nil {
-			log.WithError(err).Error("could not validate attester slashing")
+	// An attester slashing is pooled once per validator to slash, but only verified once.
+	verified := make(map[*ethpb.AttesterSlashing]bool)
+	candidates := make([]*ethpb.AttesterSlashing, 0)
+	for _, slashing := range p.pendingAttesterSlashing {
+		if _, seen := verified[slashing.attesterSlashing]; seen {
 			continue
 		}
-		if included[slashing.validatorToSlash] || !valid {
-			p.pendingAttesterSlashing = append(p.pendingAttesterSlashing[:i], p.pendingAttesterSlashing[i+1:]...)
-			i--
+		if valid, err := p.validatorSlashingPreconditionCheck(state, slashing.validatorToSlash); err != nil || !valid {
 			continue
 		}
-		attSlashing := slashing.attesterSlashing
+		ok := blocks.VerifyAttesterSlashing(ctx, state, slashing.attesterSlashing) == nil
+		verified[slashing.attesterSlashing] = ok
+		if ok {
+			candidates = append(candidates, slashing.attesterSlashing)
+		}
+	}
+
+	// Greedily pick the slashing with the highest reward for the validators it slashes, which are
+	// not slashed by the slashings already picked. Ties are broken by the order of the pool.
+	slashed := make(map[uint64]bool)
+	pending := make([]*ethpb.AttesterSlashing, 0, params.BeaconConfig().MaxAttesterSlashings)
+	for len(pending) < int(params.BeaconConfig().MaxAttesterSlashings) {
+		best := -1
+		bestReward, bestCount := uint64(0), 0
+		for i, slashing := range candidates {
+			if slashing == nil {
+				continue
+			}
+			slashedVal := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+			reward, count := p.whistleblowerReward(state, slashedVal, slashed)
+			if reward > bestReward || (reward == bestReward && count > bestCount) {
+				best, bestReward, bestCount = i, reward, count
+			}
+		}
+		if best < 0 {
+			break
+		}
+		attSlashing := candidates[best]
 		slashedVal := sliceutil.IntersectionUint64(attSlashing.Attestation_1.AttestingIndices, attSlashing.Attestation_2.AttestingIndices)
 		for _, idx := range slashedVal {
-			included[idx] = true
+			slashed[idx] = true
 		}
-
 		pending = append(pending, attSlashing)
+		candidates[best] = nil
 	}
 
 	return pending
 }
 
 // PendingProposerSlashings returns proposer slashings that are able to be included into a block.
-// This method will not return more than the block enforced MaxProposerSlashings.
+// Every pooled slashing is revalidated against the state, which may be any state, so slashings which
+// are not valid are only skipped. The slashings with the highest whistleblower reward are returned
+// first. This method will not return more than the block enforced MaxProposerSlashings.
 func (p *Pool) PendingProposerSlashings(ctx context.Context, state *beaconstate.BeaconState) []*ethpb.ProposerSlashing {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
 	ctx, span := trace.StartSpan(ctx, "operations.PendingProposerSlashing")
 	defer span.End()
 
-	// Update prom metric.
-	numPendingProposerSlashings.Set(float64(len(p.pendingProposerSlashing)))
+	pending := make([]*ethpb.ProposerSlashing, 0, len(p.pendingProposerSlashing))
+	rewards := make(map[*ethpb.ProposerSlashing]uint64)
+	for _, slashing := range p.pendingProposerSlashing {
+		idx := slashing.Header_1.Header.ProposerIndex
+		if valid, err := p.validatorSlashingPreconditionCheck(state, idx); err != nil || !valid {
+			continue
+		}
+		if err := blocks.VerifyProposerSlashing(state, slashing); err != nil {
+			continue
+		}
+		pending = append(pending, slashing)
+		rewards[slashing], _ = p.whistleblowerReward(state, []uint64{idx}, nil)
+	}
 
-	pending := make([]*ethpb.ProposerSlashing, 0, params.BeaconConfig().MaxProposerSlashings)
-	for i := 0; i < len(p.pendingProposerSlashing); i++ {
-		slashing := p.pendingProposerSlashing[i]
-		if len(pending) >= int(params.BeaconConfig().MaxProposerSlashings) {
-			break
+	sort.SliceStable(pending, func(i, j int) bool {
+		return rewards[pending[i]] > rewards[pending[j]]
+	})
+	if len(pending) > int(params.BeaconConfig().MaxProposerSlashings) {
+		pending = pending[:params.BeaconConfig().MaxProposerSlashings]
+	}
+	return pending
+}
+
+// Prune evicts from the pool the slashings which are no longer valid for the canonical head state. It
+// must only be called with the head state, as a slashing which is invalid for another state may still
+// be included on the canonical chain.
+func (p *Pool) Prune(ctx context.Context, headState *beaconstate.BeaconState) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	ctx, span := trace.StartSpan(ctx, "operations.PruneSlashings")
+	defer span.End()
+
+	// An attester slashing is pooled once per validator to slash, but only verified once.
+	verified := make(map[*ethpb.AttesterSlashing]bool)
+	keptAttesterSlashings := p.pendingAttesterSlashing[:0]
+	for _, slashing := range p.pendingAttesterSlashing {
+		valid, err := p.validatorSlashingPreconditionCheck(headState, slashing.validatorToSlash)
+		if err != nil {
+			log.WithError(err).Error("could not validate attester slashing")
+			keptAttesterSlashings = append(keptAttesterSlashings, slashing)
+			continue
 		}
-		valid, err := p.validatorSlashingPreconditionCheck(state, slashing.Header_1.Header.ProposerIndex)
+		if valid {
+			ok, seen := verified[slashing.attesterSlashing]
+			if !seen {
+				err := blocks.VerifyAttesterSlashing(ctx, headState, slashing.attesterSlashing)
+				if err != nil {
+					log.WithError(err).WithField("validatorIndex", slashing.validatorToSlash).Debug("Dropping invalid attester slashing")
+				}
+				ok = err == nil
+				verified[slashing.attesterSlashing] = ok
+			}
+			valid = ok
+		}
+		if !valid {
+			numAttesterSlashingsDropped.Inc()
+			continue
+		}
+		keptAttesterSlashings = append(keptAttesterSlashings, slashing)
+	}
+	p.pendingAttesterSlashing = keptAttesterSlashings
+
+	keptProposerSlashings := p.pendingProposerSlashing[:0]
+	for _, slashing := range p.pendingProposerSlashing {
+		idx := slashing.Header_1.Header.ProposerIndex
+		valid, err := p.validatorSlashingPreconditionCheck(headState, idx)
 		if err != nil {
 			log.WithError(err).Error("could not validate proposer slashing")
+			keptProposerSlashings = append(keptProposerSlashings, slashing)
 			continue
 		}
+		if valid {
+			if err := blocks.VerifyProposerSlashing(headState, slashing); err != nil {
+				log.WithError(err).WithField("validatorIndex", idx).Debug("Dropping invalid proposer slashing")
+				valid = false
+			}
+		}
 		if !valid {
-			p.pendingProposerSlashing = append(p.pendingProposerSlashing[:i], p.pendingProposerSlashing[i+1:]...)
-			i--
+			numProposerSlashingsDropped.Inc()
 			continue
 		}
-
-		pending = append(pending, slashing)
+		keptProposerSlashings = append(keptProposerSlashings, slashing)
 	}
-	return pending
+	p.pendingProposerSlashing = keptProposerSlashings
+
+	// Update prom metrics.
+	numPendingAttesterSlashings.Set(float64(len(p.pendingAttesterSlashing)))
+	numPendingProposerSlashings.Set(float64(len(p.pendingProposerSlashing)))
 }
 
 // InsertAttesterSlashing into the pool. This method is a no-op if the attester slashing already exists in the pool,
@@ -243,6 +329,33 @@ func (p *Pool) MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing) {
 	numProposerSlashingsIncluded.Inc()
 }
 
+// AllAttesterSlashings returns all the distinct attester slashings in the pool, whether or not
+// they are still valid.
+func (p *Pool) AllAttesterSlashings() []*ethpb.AttesterSlashing {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	seen := make(map[*ethpb.AttesterSlashing]bool)
+	slashings := make([]*ethpb.AttesterSlashing, 0, len(p.pendingAttesterSlashing))
+	for _, slashing := range p.pendingAttesterSlashing {
+		if seen[slashing.attesterSlashing] {
+			continue
+		}
+		seen[slashing.attesterSlashing] = true
+		slashings = append(slashings, slashing.attesterSlashing)
+	}
+	return slashings
+}
+
+// AllProposerSlashings returns all the proposer slashings in the pool, whether or not they are
+// still valid.
+func (p *Pool) AllProposerSlashings() []*ethpb.ProposerSlashing {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	slashings := make([]*ethpb.ProposerSlashing, len(p.pendingProposerSlashing))
+	copy(slashings, p.pendingProposerSlashing)
+	return slashings
+}
+
 // this function checks a few items about a validator before proceeding with inserting
 // a proposer/attester slashing into the pool. First, it checks if the validator
 // has been recently included in the pool, then it checks if the validator is slashable.
@@ -264,3 +377,33 @@ func (p *Pool) validatorSlashingPreconditionCheck(
 	}
 	return true, nil
 }
+
+// whistleblowerReward returns the whistleblower reward of slashing the validators, which a proposer
+// receives for including the slashing, and the number of validators slashed. Validators which are
+// not slashable or which are excluded are not counted.
+//
+// Spec pseudocode definition:
+//   whistleblower_reward = Gwei(validator.effective_balance // WHISTLEBLOWER_REWARD_QUOTIENT)
+func (p *Pool) whistleblowerReward(
+	state *beaconstate.BeaconState,
+	valIndices []uint64,
+	excluded map[uint64]bool,
+) (uint64, int) {
+	reward := uint64(0)
+	count := 0
+	for _, idx := range valIndices {
+		if excluded[idx] {
+			continue
+		}
+		if ok, err := p.validatorSlashingPreconditionCheck(state, idx); err != nil || !ok {
+			continue
+		}
+		validator, err := state.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			continue
+		}
+		reward += validator.EffectiveBalance() / params.BeaconConfig().WhistleBlowerRewardQuotient
+		count++
+	}
+	return reward, count
+}
@@ -691,3 +691,112 @@ func TestPool_PendingAttesterSlashings_NoDuplicates(t *testing.T) {
 		t.Errorf("Unexpected return from PendingAttesterSlashings, wanted %v, received %v", want, got)
 	}
 }
+
+func TestPool_PendingAttesterSlashings_MostProfitableFirst(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	conf := params.BeaconConfig()
+	conf.MaxAttesterSlashings = 2
+	params.OverrideBeaconConfig(conf)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	p := NewPool()
+	single := validAttesterSlashingForValIdx(t, beaconState, privKeys, 1)
+	double := validAttesterSlashingForValIdx(t, beaconState, privKeys, 2, 3)
+	triple := validAttesterSlashingForValIdx(t, beaconState, privKeys, 3, 4, 5)
+	for _, slashing := range []*ethpb.AttesterSlashing{single, double, triple} {
+		if err := p.InsertAttesterSlashing(context.Background(), beaconState, slashing); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The slashing of validators 3 to 5 has the highest reward. Of the other slashings, which then
+	// both slash a single validator, the first one in the pool is picked.
+	want := []*ethpb.AttesterSlashing{triple, single}
+	if got := p.PendingAttesterSlashings(context.Background(), beaconState); !reflect.DeepEqual(want, got) {
+		t.Errorf("Unexpected return from PendingAttesterSlashings, wanted %v, received %v", want, got)
+	}
+}
+
+func TestPool_PendingAttesterSlashings_SkipsInvalid(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	valid, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[1], 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[2], 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Sign the slashing with the key of another validator.
+	invalid.Attestation_1.Signature = valid.Attestation_1.Signature
+	p := &Pool{
+		pendingAttesterSlashing: []*PendingAttesterSlashing{
+			{attesterSlashing: valid, validatorToSlash: 1},
+			{attesterSlashing: invalid, validatorToSlash: 2},
+		},
+	}
+
+	want := []*ethpb.AttesterSlashing{valid}
+	if got := p.PendingAttesterSlashings(context.Background(), beaconState); !reflect.DeepEqual(want, got) {
+		t.Errorf("Unexpected return from PendingAttesterSlashings, wanted %v, received %v", want, got)
+	}
+	if len(p.pendingAttesterSlashing) != 2 {
+		t.Errorf("Expected the pool to be unchanged, pool has %v", p.pendingAttesterSlashing)
+	}
+}
+
+func TestPool_Prune_AttesterSlashings(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	valid, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[1], 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[2], 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Sign the slashing with the key of another validator.
+	invalid.Attestation_1.Signature = valid.Attestation_1.Signature
+	slashed, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[3], 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val, err := beaconState.ValidatorAtIndex(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val.Slashed = true
+	if err := beaconState.UpdateValidatorAtIndex(3, val); err != nil {
+		t.Fatal(err)
+	}
+	p := &Pool{
+		pendingAttesterSlashing: []*PendingAttesterSlashing{
+			{attesterSlashing: valid, validatorToSlash: 1},
+			{attesterSlashing: invalid, validatorToSlash: 2},
+			{attesterSlashing: slashed, validatorToSlash: 3},
+		},
+	}
+
+	p.Prune(context.Background(), beaconState)
+	if len(p.pendingAttesterSlashing) != 1 || p.pendingAttesterSlashing[0].validatorToSlash != 1 {
+		t.Errorf("Expected the invalid slashings to be evicted from the pool, pool has %v", p.pendingAttesterSlashing)
+	}
+}
+
+func TestPool_AllAttesterSlashings(t *testing.T) {
+	double := attesterSlashingForValIdx(2, 3)
+	single := attesterSlashingForValIdx(5)
+	p := &Pool{
+		pendingAttesterSlashing: []*PendingAttesterSlashing{
+			{attesterSlashing: double, validatorToSlash: 2},
+			{attesterSlashing: double, validatorToSlash: 3},
+			{attesterSlashing: single, validatorToSlash: 5},
+		},
+	}
+
+	want := []*ethpb.AttesterSlashing{double, single}
+	if got := p.AllAttesterSlashings(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Unexpected return from AllAttesterSlashings, wanted %v, received %v", want, got)
+	}
+}
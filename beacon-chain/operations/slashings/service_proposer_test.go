@@ -498,3 +498,66 @@ func TestPool_PendingProposerSlashings_Slashed(t *testing.T) {
 		})
 	}
 }
+
+func TestPool_PendingProposerSlashings_MostProfitableFirst(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	val, err := beaconState.ValidatorAtIndex(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val.EffectiveBalance = params.BeaconConfig().MaxEffectiveBalance / 2
+	if err := beaconState.UpdateValidatorAtIndex(0, val); err != nil {
+		t.Fatal(err)
+	}
+	slashings := make([]*ethpb.ProposerSlashing, 4)
+	for i := 0; i < len(slashings); i++ {
+		sl, err := testutil.GenerateProposerSlashingForValidator(beaconState, privKeys[i], uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		slashings[i] = sl
+	}
+	// Sign the last slashing with the key of another validator.
+	slashings[3].Header_1.Signature = slashings[2].Header_1.Signature
+	p := &Pool{
+		pendingProposerSlashing: append([]*ethpb.ProposerSlashing{}, slashings...),
+	}
+
+	want := []*ethpb.ProposerSlashing{slashings[1], slashings[2], slashings[0]}
+	if got := p.PendingProposerSlashings(context.Background(), beaconState); !reflect.DeepEqual(want, got) {
+		t.Errorf("Unexpected return from PendingProposerSlashings, wanted %v, received %v", want, got)
+	}
+	if !reflect.DeepEqual(p.pendingProposerSlashing, slashings) {
+		t.Errorf("Expected the pool to be unchanged, pool has %v", p.pendingProposerSlashing)
+	}
+}
+
+func TestPool_Prune_ProposerSlashings(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	slashings := make([]*ethpb.ProposerSlashing, 3)
+	for i := 0; i < len(slashings); i++ {
+		sl, err := testutil.GenerateProposerSlashingForValidator(beaconState, privKeys[i], uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		slashings[i] = sl
+	}
+	// Sign the second slashing with the key of another validator.
+	slashings[1].Header_1.Signature = slashings[0].Header_1.Signature
+	val, err := beaconState.ValidatorAtIndex(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val.Slashed = true
+	if err := beaconState.UpdateValidatorAtIndex(2, val); err != nil {
+		t.Fatal(err)
+	}
+	p := &Pool{
+		pendingProposerSlashing: append([]*ethpb.ProposerSlashing{}, slashings...),
+	}
+
+	p.Prune(context.Background(), beaconState)
+	if !reflect.DeepEqual(p.pendingProposerSlashing, slashings[:1]) {
+		t.Errorf("Expected the invalid slashings to be evicted from the pool, pool has %v", p.pendingProposerSlashing)
+	}
+}
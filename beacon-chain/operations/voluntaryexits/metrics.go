@@ -0,0 +1,15 @@
+package voluntaryexits
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	numVoluntaryExitsDropped = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "voluntary_exits_dropped_total",
+			Help: "Number of pending voluntary exits dropped from the pool as no longer valid",
+		},
+	)
+)
@@ -6,17 +6,35 @@ import (
 	"sync"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
+// ExitStatus is the status of the voluntary exit of a validator, as seen by the pool.
+type ExitStatus int
+
+const (
+	// ExitUnknown is the status of an exit which the pool has not seen.
+	ExitUnknown ExitStatus = iota
+	// ExitPending is the status of an exit waiting in the pool to be included in a block.
+	ExitPending
+	// ExitIncluded is the status of an exit included in a block.
+	ExitIncluded
+	// ExitInvalid is the status of an exit evicted from the pool as it can no longer be included.
+	ExitInvalid
+)
+
 // Pool implements a struct to maintain pending and recently included voluntary exits. This pool
 // is used by proposers to insert into new blocks.
 type Pool struct {
-	lock     sync.RWMutex
-	pending  []*ethpb.SignedVoluntaryExit
-	included map[uint64]bool
+	lock    sync.RWMutex
+	pending []*ethpb.SignedVoluntaryExit
+	// Slots of the blocks which included an exit, by validator index.
+	included map[uint64]uint64
+	// Validator indices of the exits evicted from the pool as invalid.
+	invalid map[uint64]bool
 }
 
 // NewPool accepts a head fetcher (for reading the validator set) and returns an initialized
@@ -24,23 +42,28 @@ type Pool struct {
 func NewPool() *Pool {
 	return &Pool{
 		pending:  make([]*ethpb.SignedVoluntaryExit, 0),
-		included: make(map[uint64]bool),
+		included: make(map[uint64]uint64),
+		invalid:  make(map[uint64]bool),
 	}
 }
 
-// PendingExits returns exits that are ready for inclusion at the given slot. This method will not
-// return more than the block enforced MaxVoluntaryExits.
+// PendingExits returns exits that are ready for inclusion at the given slot. Every pooled exit is
+// revalidated against the state, which may be any state, so exits which cannot be included are only
+// skipped. This method will not return more than the block enforced MaxVoluntaryExits.
 func (p *Pool) PendingExits(state *beaconstate.BeaconState, slot uint64) []*ethpb.SignedVoluntaryExit {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
 	pending := make([]*ethpb.SignedVoluntaryExit, 0)
+	exiting := make(map[uint64]bool)
 	for _, e := range p.pending {
-		if e.Exit.Epoch > helpers.SlotToEpoch(slot) {
+		if exiting[e.Exit.ValidatorIndex] {
 			continue
 		}
-		if v, err := state.ValidatorAtIndexReadOnly(e.Exit.ValidatorIndex); err == nil && v.ExitEpoch() == params.BeaconConfig().FarFutureEpoch {
-			pending = append(pending, e)
+		if ready, err := exitReady(state, slot, e); err != nil || !ready {
+			continue
 		}
+		exiting[e.Exit.ValidatorIndex] = true
+		pending = append(pending, e)
 	}
 	if len(pending) > int(params.BeaconConfig().MaxVoluntaryExits) {
 		pending = pending[:params.BeaconConfig().MaxVoluntaryExits]
@@ -48,6 +71,42 @@ func (p *Pool) PendingExits(state *beaconstate.BeaconState, slot uint64) []*ethp
 	return pending
 }
 
+// Prune evicts from the pool the exits which can no longer be included on top of the canonical head
+// state. It must only be called with the head state, as an exit which is invalid for another state may
+// still be included on the canonical chain.
+func (p *Pool) Prune(headState *beaconstate.BeaconState) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	kept := p.pending[:0]
+	for _, e := range p.pending {
+		if _, err := exitReady(headState, headState.Slot(), e); err != nil {
+			p.invalid[e.Exit.ValidatorIndex] = true
+			numVoluntaryExitsDropped.Inc()
+			continue
+		}
+		kept = append(kept, e)
+	}
+	p.pending = kept
+}
+
+// exitReady checks whether the exit can be included at the given slot on top of the state. It returns
+// false without error for an exit which may become valid later, at its epoch and once the validator
+// has been active long enough, and an error for an exit which can no longer be included.
+func exitReady(state *beaconstate.BeaconState, slot uint64, e *ethpb.SignedVoluntaryExit) (bool, error) {
+	v, err := state.ValidatorAtIndexReadOnly(e.Exit.ValidatorIndex)
+	if err != nil {
+		return false, err
+	}
+	epoch := helpers.SlotToEpoch(slot)
+	if e.Exit.Epoch > epoch || v.ActivationEpoch() > epoch || epoch-v.ActivationEpoch() < params.BeaconConfig().ShardCommitteePeriod {
+		return false, nil
+	}
+	if err := blocks.VerifyExit(v, slot, state.Fork(), e, state.GenesisValidatorRoot()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // InsertVoluntaryExit into the pool. This method is a no-op if the pending exit already exists,
 // has been included recently, or the validator is already exited.
 func (p *Pool) InsertVoluntaryExit(ctx context.Context, state *beaconstate.BeaconState, exit *ethpb.SignedVoluntaryExit) {
@@ -55,7 +114,7 @@ func (p *Pool) InsertVoluntaryExit(ctx context.Context, state *beaconstate.Beaco
 	defer p.lock.Unlock()
 
 	// Has this validator index been included recently?
-	if p.included[exit.Exit.ValidatorIndex] {
+	if _, ok := p.included[exit.Exit.ValidatorIndex]; ok {
 		return
 	}
 
@@ -77,15 +136,16 @@ func (p *Pool) InsertVoluntaryExit(ctx context.Context, state *beaconstate.Beaco
 	}
 
 	// Insert into pending list and sort again.
+	delete(p.invalid, exit.Exit.ValidatorIndex)
 	p.pending = append(p.pending, exit)
 	sort.Slice(p.pending, func(i, j int) bool {
 		return p.pending[i].Exit.ValidatorIndex < p.pending[j].Exit.ValidatorIndex
 	})
 }
 
-// MarkIncluded is used when an exit has been included in a beacon block. Every block seen by this
-// node should call this method to include the exit.
-func (p *Pool) MarkIncluded(exit *ethpb.SignedVoluntaryExit) {
+// MarkIncluded is used when an exit has been included in a beacon block at the given slot. Every
+// block seen by this node should call this method to include the exit.
+func (p *Pool) MarkIncluded(exit *ethpb.SignedVoluntaryExit, slot uint64) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 	i := sort.Search(len(p.pending), func(i int) bool {
@@ -94,5 +154,34 @@ func (p *Pool) MarkIncluded(exit *ethpb.SignedVoluntaryExit) {
 	if i != len(p.pending) {
 		p.pending = append(p.pending[:i], p.pending[i+1:]...)
 	}
-	p.included[exit.Exit.ValidatorIndex] = true
+	p.included[exit.Exit.ValidatorIndex] = slot
+	delete(p.invalid, exit.Exit.ValidatorIndex)
+}
+
+// AllExits returns all the exits in the pool, whether or not they are ready for inclusion.
+func (p *Pool) AllExits() []*ethpb.SignedVoluntaryExit {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	exits := make([]*ethpb.SignedVoluntaryExit, len(p.pending))
+	copy(exits, p.pending)
+	return exits
+}
+
+// ExitStatus returns the status of the voluntary exit of a validator, with the slot of the block
+// which included the exit if it is included.
+func (p *Pool) ExitStatus(validatorIndex uint64) (ExitStatus, uint64) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if slot, ok := p.included[validatorIndex]; ok {
+		return ExitIncluded, slot
+	}
+	for _, e := range p.pending {
+		if e.Exit.ValidatorIndex == validatorIndex {
+			return ExitPending, 0
+		}
+	}
+	if p.invalid[validatorIndex] {
+		return ExitInvalid, 0
+	}
+	return ExitUnknown, 0
 }
@@ -7,15 +7,18 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 func TestPool_InsertVoluntaryExit(t *testing.T) {
 	type fields struct {
 		pending  []*ethpb.SignedVoluntaryExit
-		included map[uint64]bool
+		included map[uint64]uint64
 	}
 	type args struct {
 		exit *ethpb.SignedVoluntaryExit
@@ -30,7 +33,7 @@ func TestPool_InsertVoluntaryExit(t *testing.T) {
 			name: "Empty list",
 			fields: fields{
 				pending:  make([]*ethpb.SignedVoluntaryExit, 0),
-				included: make(map[uint64]bool),
+				included: make(map[uint64]uint64),
 			},
 			args: args{
 				exit: &ethpb.SignedVoluntaryExit{
@@ -60,7 +63,7 @@ func TestPool_InsertVoluntaryExit(t *testing.T) {
 						},
 					},
 				},
-				included: make(map[uint64]bool),
+				included: make(map[uint64]uint64),
 			},
 			args: args{
 				exit: &ethpb.SignedVoluntaryExit{
@@ -90,7 +93,7 @@ func TestPool_InsertVoluntaryExit(t *testing.T) {
 						},
 					},
 				},
-				included: make(map[uint64]bool),
+				included: make(map[uint64]uint64),
 			},
 			args: args{
 				exit: &ethpb.SignedVoluntaryExit{
@@ -113,7 +116,7 @@ func TestPool_InsertVoluntaryExit(t *testing.T) {
 			name: "Exit for already exited validator",
 			fields: fields{
 				pending:  []*ethpb.SignedVoluntaryExit{},
-				included: make(map[uint64]bool),
+				included: make(map[uint64]uint64),
 			},
 			args: args{
 				exit: &ethpb.SignedVoluntaryExit{
@@ -142,7 +145,7 @@ func TestPool_InsertVoluntaryExit(t *testing.T) {
 						},
 					},
 				},
-				included: make(map[uint64]bool),
+				included: make(map[uint64]uint64),
 			},
 			args: args{
 				exit: &ethpb.SignedVoluntaryExit{
@@ -177,8 +180,8 @@ func TestPool_InsertVoluntaryExit(t *testing.T) {
 			name: "Already included",
 			fields: fields{
 				pending: make([]*ethpb.SignedVoluntaryExit, 0),
-				included: map[uint64]bool{
-					1: true,
+				included: map[uint64]uint64{
+					1: 10,
 				},
 			},
 			args: args{
@@ -233,10 +236,11 @@ func TestPool_InsertVoluntaryExit(t *testing.T) {
 func TestPool_MarkIncluded(t *testing.T) {
 	type fields struct {
 		pending  []*ethpb.SignedVoluntaryExit
-		included map[uint64]bool
+		included map[uint64]uint64
 	}
 	type args struct {
 		exit *ethpb.SignedVoluntaryExit
+		slot uint64
 	}
 	tests := []struct {
 		name   string
@@ -252,12 +256,13 @@ func TestPool_MarkIncluded(t *testing.T) {
 						Exit: &ethpb.VoluntaryExit{ValidatorIndex: 2},
 					},
 				},
-				included: make(map[uint64]bool),
+				included: make(map[uint64]uint64),
 			},
 			args: args{
 				exit: &ethpb.SignedVoluntaryExit{
 					Exit: &ethpb.VoluntaryExit{ValidatorIndex: 3},
 				},
+				slot: 5,
 			},
 			want: fields{
 				pending: []*ethpb.SignedVoluntaryExit{
@@ -265,8 +270,8 @@ func TestPool_MarkIncluded(t *testing.T) {
 						Exit: &ethpb.VoluntaryExit{ValidatorIndex: 2},
 					},
 				},
-				included: map[uint64]bool{
-					3: true,
+				included: map[uint64]uint64{
+					3: 5,
 				},
 			},
 		},
@@ -284,14 +289,15 @@ func TestPool_MarkIncluded(t *testing.T) {
 						Exit: &ethpb.VoluntaryExit{ValidatorIndex: 3},
 					},
 				},
-				included: map[uint64]bool{
-					0: true,
+				included: map[uint64]uint64{
+					0: 4,
 				},
 			},
 			args: args{
 				exit: &ethpb.SignedVoluntaryExit{
 					Exit: &ethpb.VoluntaryExit{ValidatorIndex: 2},
 				},
+				slot: 9,
 			},
 			want: fields{
 				pending: []*ethpb.SignedVoluntaryExit{
@@ -302,9 +308,9 @@ func TestPool_MarkIncluded(t *testing.T) {
 						Exit: &ethpb.VoluntaryExit{ValidatorIndex: 3},
 					},
 				},
-				included: map[uint64]bool{
-					0: true,
-					2: true,
+				included: map[uint64]uint64{
+					0: 4,
+					2: 9,
 				},
 			},
 		},
@@ -315,7 +321,7 @@ func TestPool_MarkIncluded(t *testing.T) {
 				pending:  tt.fields.pending,
 				included: tt.fields.included,
 			}
-			p.MarkIncluded(tt.args.exit)
+			p.MarkIncluded(tt.args.exit, tt.args.slot)
 			if len(p.pending) != len(tt.want.pending) {
 				t.Fatalf("Mismatched lengths of pending list. Got %d, wanted %d.", len(p.pending), len(tt.want.pending))
 			}
@@ -331,7 +337,47 @@ func TestPool_MarkIncluded(t *testing.T) {
 	}
 }
 
+func signedExit(t *testing.T, state *beaconstate.BeaconState, priv *bls.SecretKey, valIdx uint64, epoch uint64) *ethpb.SignedVoluntaryExit {
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit: &ethpb.VoluntaryExit{
+			Epoch:          epoch,
+			ValidatorIndex: valIdx,
+		},
+	}
+	domain, err := helpers.Domain(state.Fork(), epoch, params.BeaconConfig().DomainVoluntaryExit, state.GenesisValidatorRoot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigRoot, err := helpers.ComputeSigningRoot(exit.Exit, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exit.Signature = priv.Sign(sigRoot[:]).Marshal()
+	return exit
+}
+
 func TestPool_PendingExits(t *testing.T) {
+	state, privKeys := testutil.DeterministicGenesisState(t, 64)
+	val, err := state.ValidatorAtIndex(30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val.ExitEpoch = 5
+	if err := state.UpdateValidatorAtIndex(30, val); err != nil {
+		t.Fatal(err)
+	}
+	// The validators have been active long enough to exit.
+	epoch := params.BeaconConfig().ShardCommitteePeriod
+	slot := epoch * params.BeaconConfig().SlotsPerEpoch
+
+	exits := make([]*ethpb.SignedVoluntaryExit, 31)
+	for i := range exits {
+		exits[i] = signedExit(t, state, privKeys[i], uint64(i), 0)
+	}
+	futureExit := signedExit(t, state, privKeys[1], 1, epoch+1)
+	// Signed with the key of another validator.
+	badSigExit := signedExit(t, state, privKeys[2], 3, 0)
+
 	type fields struct {
 		pending []*ethpb.SignedVoluntaryExit
 	}
@@ -350,113 +396,159 @@ func TestPool_PendingExits(t *testing.T) {
 				pending: []*ethpb.SignedVoluntaryExit{},
 			},
 			args: args{
-				slot: 100000,
+				slot: slot,
 			},
 			want: []*ethpb.SignedVoluntaryExit{},
 		},
 		{
 			name: "All eligible",
 			fields: fields{
-				pending: []*ethpb.SignedVoluntaryExit{
-					{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
-				},
+				pending: exits[:5],
 			},
 			args: args{
-				slot: 1000000,
-			},
-			want: []*ethpb.SignedVoluntaryExit{
-				{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
+				slot: slot,
 			},
+			want: exits[:5],
 		},
 		{
 			name: "All eligible, more than max",
 			fields: fields{
-				pending: []*ethpb.SignedVoluntaryExit{
-					{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 5}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 6}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 7}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 8}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 9}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 10}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 11}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 12}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 13}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 14}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 15}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 16}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 17}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 18}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 19}},
-				},
+				pending: exits[:20],
 			},
 			args: args{
-				slot: 1000000,
-			},
-			want: []*ethpb.SignedVoluntaryExit{
-				{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 5}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 6}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 7}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 8}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 9}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 10}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 11}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 12}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 13}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 14}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 15}},
+				slot: slot,
 			},
+			want: exits[:params.BeaconConfig().MaxVoluntaryExits],
 		},
 		{
 			name: "Some eligible",
 			fields: fields{
-				pending: []*ethpb.SignedVoluntaryExit{
-					{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-				},
+				pending: []*ethpb.SignedVoluntaryExit{exits[0], futureExit, exits[2]},
 			},
 			args: args{
-				slot: 2 * params.BeaconConfig().SlotsPerEpoch,
+				slot: slot,
 			},
-			want: []*ethpb.SignedVoluntaryExit{
-				{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
+			want: []*ethpb.SignedVoluntaryExit{exits[0], exits[2]},
+		},
+		{
+			name: "Validators not active long enough",
+			fields: fields{
+				pending: exits[:5],
 			},
+			args: args{
+				slot: slot - 1,
+			},
+			want: []*ethpb.SignedVoluntaryExit{},
+		},
+		{
+			name: "Skips invalid exits",
+			fields: fields{
+				pending: []*ethpb.SignedVoluntaryExit{exits[0], badSigExit, exits[30]},
+			},
+			args: args{
+				slot: slot,
+			},
+			want: []*ethpb.SignedVoluntaryExit{exits[0]},
+		},
+		{
+			name: "Duplicate exits",
+			fields: fields{
+				pending: []*ethpb.SignedVoluntaryExit{exits[0], exits[0]},
+			},
+			args: args{
+				slot: slot,
+			},
+			want: []*ethpb.SignedVoluntaryExit{exits[0]},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := &Pool{
-				pending: tt.fields.pending,
+				pending: append([]*ethpb.SignedVoluntaryExit{}, tt.fields.pending...),
+				invalid: make(map[uint64]bool),
 			}
-			s, err := beaconstate.InitializeFromProtoUnsafe(&p2ppb.BeaconState{Validators: []*ethpb.Validator{{ExitEpoch: params.BeaconConfig().FarFutureEpoch}}})
-			if err != nil {
-				t.Fatal(err)
-			}
-			if got := p.PendingExits(s, tt.args.slot); !reflect.DeepEqual(got, tt.want) {
+			if got := p.PendingExits(state, tt.args.slot); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("PendingExits() = %v, want %v", got, tt.want)
 			}
+			if !reflect.DeepEqual(p.pending, tt.fields.pending) {
+				t.Errorf("Pending exits = %v, want the pool unchanged %v", p.pending, tt.fields.pending)
+			}
 		})
 	}
 }
+
+func TestPool_Prune(t *testing.T) {
+	state, privKeys := testutil.DeterministicGenesisState(t, 64)
+	val, err := state.ValidatorAtIndex(30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val.ExitEpoch = 5
+	if err := state.UpdateValidatorAtIndex(30, val); err != nil {
+		t.Fatal(err)
+	}
+	epoch := params.BeaconConfig().ShardCommitteePeriod
+	if err := state.SetSlot(epoch * params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatal(err)
+	}
+
+	exit := signedExit(t, state, privKeys[0], 0, 0)
+	futureExit := signedExit(t, state, privKeys[1], 1, epoch+1)
+	// Signed with the key of another validator.
+	badSigExit := signedExit(t, state, privKeys[2], 3, 0)
+	alreadyExiting := signedExit(t, state, privKeys[30], 30, 0)
+	p := &Pool{
+		pending: []*ethpb.SignedVoluntaryExit{exit, futureExit, badSigExit, alreadyExiting},
+		invalid: make(map[uint64]bool),
+	}
+
+	p.Prune(state)
+	if want := []*ethpb.SignedVoluntaryExit{exit, futureExit}; !reflect.DeepEqual(p.pending, want) {
+		t.Errorf("Pending exits = %v, want %v", p.pending, want)
+	}
+	if !p.invalid[3] || !p.invalid[30] {
+		t.Errorf("Expected the evicted exits to be invalid, received %v", p.invalid)
+	}
+}
+
+func TestPool_ExitStatus(t *testing.T) {
+	state, privKeys := testutil.DeterministicGenesisState(t, 64)
+	epoch := params.BeaconConfig().ShardCommitteePeriod
+	slot := epoch * params.BeaconConfig().SlotsPerEpoch
+	ctx := context.Background()
+
+	p := NewPool()
+	p.InsertVoluntaryExit(ctx, state, signedExit(t, state, privKeys[0], 0, 0))
+	p.InsertVoluntaryExit(ctx, state, signedExit(t, state, privKeys[1], 1, 0))
+	// Signed with the key of another validator.
+	p.InsertVoluntaryExit(ctx, state, signedExit(t, state, privKeys[3], 2, 0))
+	headState := state.Copy()
+	if err := headState.SetSlot(slot); err != nil {
+		t.Fatal(err)
+	}
+	p.Prune(headState)
+	p.MarkIncluded(signedExit(t, state, privKeys[1], 1, 0), slot+1)
+
+	tests := []struct {
+		validatorIndex uint64
+		wantStatus     ExitStatus
+		wantSlot       uint64
+	}{
+		{validatorIndex: 0, wantStatus: ExitPending},
+		{validatorIndex: 1, wantStatus: ExitIncluded, wantSlot: slot + 1},
+		{validatorIndex: 2, wantStatus: ExitInvalid},
+		{validatorIndex: 3, wantStatus: ExitUnknown},
+	}
+	for _, tt := range tests {
+		status, inclusionSlot := p.ExitStatus(tt.validatorIndex)
+		if status != tt.wantStatus {
+			t.Errorf("Wanted status %d for validator %d, received %d", tt.wantStatus, tt.validatorIndex, status)
+		}
+		if inclusionSlot != tt.wantSlot {
+			t.Errorf("Wanted inclusion slot %d for validator %d, received %d", tt.wantSlot, tt.validatorIndex, inclusionSlot)
+		}
+	}
+	if exits := p.AllExits(); len(exits) != 1 || exits[0].Exit.ValidatorIndex != 0 {
+		t.Errorf("Expected only the exit of validator 0 in the pool, received %v", exits)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"sync"
 
 	fastssz "github.com/ferranbt/fastssz"
@@ -166,28 +167,36 @@ func (e SszNetworkEncoder) DecodeWithLength(r io.Reader, to interface{}) error {
 }
 
 // DecodeWithMaxLength the bytes from io.Reader to the protobuf message provided.
-// This checks that the decoded message isn't larger than the provided max limit.
+// This checks that the decoded message isn't larger than the provided max limit, nor than the
+// maximum size of the message type as derived from its SSZ schema. The length prefix is validated
+// before any of the message is read, and the message is then read through a reader bounded by that
+// length, so memory only grows with the bytes the remote peer actually sends.
 func (e SszNetworkEncoder) DecodeWithMaxLength(r io.Reader, to interface{}, maxSize uint64) error {
 	if maxSize > MaxChunkSize {
 		return fmt.Errorf("maxSize %d exceeds max chunk size %d", maxSize, MaxChunkSize)
 	}
+	if typeMaxSize, ok := MaxSSZSize(to); ok && typeMaxSize < maxSize {
+		maxSize = typeMaxSize
+	}
 	msgLen, err := readVarint(r)
 	if err != nil {
 		return err
 	}
+	if msgLen > maxSize {
+		return fmt.Errorf("size of decoded message is %d which is larger than the provided max limit of %d", msgLen, maxSize)
+	}
 	if e.UseSnappyCompression {
 		r = newBufferedReader(r)
 		defer bufReaderPool.Put(r)
 	}
-	if msgLen > maxSize {
-		return fmt.Errorf("size of decoded message is %d which is larger than the provided max limit of %d", msgLen, maxSize)
-	}
-	b := make([]byte, e.MaxLength(int(msgLen)))
-	numOfBytes, err := r.Read(b)
+	b, err := ioutil.ReadAll(io.LimitReader(r, int64(msgLen)))
 	if err != nil {
 		return err
 	}
-	return e.doDecode(b[:numOfBytes], to)
+	if uint64(len(b)) != msgLen {
+		return io.ErrUnexpectedEOF
+	}
+	return e.doDecode(b, to)
 }
 
 // ProtocolSuffix returns the appropriate suffix for protocol IDs.
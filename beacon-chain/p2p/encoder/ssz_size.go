@@ -0,0 +1,142 @@
+package encoder
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// bytesPerLengthOffset is the size of an SSZ offset, which prefixes every variable sized field.
+const bytesPerLengthOffset = 4
+
+var bitlistType = reflect.TypeOf(bitfield.Bitlist{})
+
+// sszSizeBound is the cached upper bound of the SSZ encoded size of a type.
+type sszSizeBound struct {
+	size     uint64
+	bounded  bool
+	variable bool
+}
+
+// sszSizeBounds caches computed size bounds by type, as types are walked by reflection.
+var sszSizeBounds = new(sync.Map)
+
+// MaxSSZSize returns the maximum size of the SSZ encoding of the provided object's type, as derived
+// from the ssz-size and ssz-max tags of its schema. Returns false for types without an upper bound,
+// such as lists without an ssz-max tag.
+func MaxSSZSize(obj interface{}) (uint64, bool) {
+	if obj == nil {
+		return 0, false
+	}
+	bound := typeSizeBound(reflect.TypeOf(obj))
+	return bound.size, bound.bounded
+}
+
+// typeSizeBound computes the size bound of a type which does not depend on any struct tags.
+func typeSizeBound(t reflect.Type) *sszSizeBound {
+	if cached, ok := sszSizeBounds.Load(t); ok {
+		return cached.(*sszSizeBound)
+	}
+	bound := computeTypeSizeBound(t)
+	sszSizeBounds.Store(t, bound)
+	return bound
+}
+
+func computeTypeSizeBound(t reflect.Type) *sszSizeBound {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return typeSizeBound(t.Elem())
+	case reflect.Bool, reflect.Uint8:
+		return &sszSizeBound{size: 1, bounded: true}
+	case reflect.Uint16:
+		return &sszSizeBound{size: 2, bounded: true}
+	case reflect.Uint32:
+		return &sszSizeBound{size: 4, bounded: true}
+	case reflect.Uint64:
+		return &sszSizeBound{size: 8, bounded: true}
+	case reflect.Array:
+		return vectorSizeBound(uint64(t.Len()), typeSizeBound(t.Elem()))
+	case reflect.Struct:
+		bound := &sszSizeBound{bounded: true}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if strings.HasPrefix(f.Name, "XXX_") || f.PkgPath != "" {
+				continue
+			}
+			fieldBound := fieldSizeBound(f.Type, tagDimensions(f.Tag.Get("ssz-size")), tagDimensions(f.Tag.Get("ssz-max")))
+			if !fieldBound.bounded {
+				return &sszSizeBound{}
+			}
+			bound.size += fieldBound.size
+			if fieldBound.variable {
+				bound.size += bytesPerLengthOffset
+				bound.variable = true
+			}
+		}
+		return bound
+	default:
+		// Slices require ssz-size or ssz-max tags to be bounded.
+		return &sszSizeBound{}
+	}
+}
+
+// fieldSizeBound computes the size bound of a struct field, given the remaining dimensions of its
+// ssz-size and ssz-max tags. A "?" size dimension denotes a list, bounded by the next ssz-max dimension.
+func fieldSizeBound(t reflect.Type, sizes, maxes []string) *sszSizeBound {
+	if t.Kind() != reflect.Slice {
+		return typeSizeBound(t)
+	}
+	if len(sizes) > 0 && sizes[0] != "?" {
+		length, err := strconv.ParseUint(sizes[0], 10, 64)
+		if err != nil {
+			return &sszSizeBound{}
+		}
+		return vectorSizeBound(length, fieldSizeBound(t.Elem(), sizes[1:], maxes))
+	}
+	if len(sizes) > 0 {
+		sizes = sizes[1:]
+	}
+	if len(maxes) == 0 {
+		return &sszSizeBound{}
+	}
+	limit, err := strconv.ParseUint(maxes[0], 10, 64)
+	if err != nil {
+		return &sszSizeBound{}
+	}
+	if t == bitlistType {
+		// Bitlist limits are expressed in bits, and an extra bit marks the length of the list.
+		return &sszSizeBound{size: limit/8 + 1, bounded: true, variable: true}
+	}
+	elem := fieldSizeBound(t.Elem(), sizes, maxes[1:])
+	if !elem.bounded {
+		return &sszSizeBound{}
+	}
+	size := limit * elem.size
+	if elem.variable {
+		size += limit * bytesPerLengthOffset
+	}
+	return &sszSizeBound{size: size, bounded: true, variable: true}
+}
+
+// vectorSizeBound computes the size bound of a fixed length sequence of elements.
+func vectorSizeBound(length uint64, elem *sszSizeBound) *sszSizeBound {
+	if !elem.bounded {
+		return &sszSizeBound{}
+	}
+	size := length * elem.size
+	if elem.variable {
+		size += length * bytesPerLengthOffset
+	}
+	return &sszSizeBound{size: size, bounded: true, variable: elem.variable}
+}
+
+// tagDimensions splits a comma separated ssz tag into its dimensions.
+func tagDimensions(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+	return strings.Split(tag, ",")
+}
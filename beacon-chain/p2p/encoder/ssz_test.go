@@ -3,11 +3,14 @@ package encoder_test
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	testpb "github.com/prysmaticlabs/prysm/proto/testing"
 )
 
@@ -134,3 +137,90 @@ func TestSszNetworkEncoder_DecodeWithMaxLength_TooLarge(t *testing.T) {
 		t.Error("Expected error to contain 'exceeds max chunk size'")
 	}
 }
+
+func TestSszNetworkEncoder_DecodeWithMaxLength_TypeLimit(t *testing.T) {
+	e := &encoder.SszNetworkEncoder{UseSnappyCompression: false}
+	buf := new(bytes.Buffer)
+	// Announce a status message larger than the maximum size of its schema.
+	if _, err := buf.Write(proto.EncodeVarint(85)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := buf.Write(make([]byte, 85)); err != nil {
+		t.Fatal(err)
+	}
+	err := e.DecodeWithMaxLength(buf, &pb.Status{}, encoder.MaxChunkSize)
+	wanted := "which is larger than the provided max limit of 84"
+	if err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("error did not contain wanted message. Wanted: %s but Got: %v", wanted, err)
+	}
+}
+
+func TestSszNetworkEncoder_DecodeWithMaxLength_ShortStream(t *testing.T) {
+	e := &encoder.SszNetworkEncoder{UseSnappyCompression: false}
+	buf := new(bytes.Buffer)
+	// Announce the maximum chunk size, but send only a few bytes of the message.
+	if _, err := buf.Write(proto.EncodeVarint(encoder.MaxChunkSize)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := buf.Write(make([]byte, 10)); err != nil {
+		t.Fatal(err)
+	}
+	err := e.DecodeWithMaxLength(buf, &testpb.TestSimpleMessage{}, encoder.MaxChunkSize)
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("Expected %v, received %v", io.ErrUnexpectedEOF, err)
+	}
+}
+
+func TestSszNetworkEncoder_RoundTripWithLength_MultipleSnappyChunks(t *testing.T) {
+	e := &encoder.SszNetworkEncoder{UseSnappyCompression: true}
+	buf := new(bytes.Buffer)
+	// Snappy frames hold at most 64KiB of uncompressed data per chunk.
+	foo := make([]byte, 1<<18)
+	for i := range foo {
+		foo[i] = byte(i * 31)
+	}
+	msg := &testpb.TestSimpleMessage{
+		Foo: foo,
+		Bar: 9001,
+	}
+	if _, err := e.EncodeWithLength(buf, msg); err != nil {
+		t.Fatal(err)
+	}
+	decoded := &testpb.TestSimpleMessage{}
+	if err := e.DecodeWithLength(buf, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(decoded, msg) {
+		t.Error("Decoded message is not the same as original")
+	}
+}
+
+func TestMaxSSZSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		obj     interface{}
+		size    uint64
+		bounded bool
+	}{
+		{name: "uint64", obj: new(uint64), size: 8, bounded: true},
+		{name: "status", obj: &pb.Status{}, size: 84, bounded: true},
+		{name: "blocks by range request", obj: &pb.BeaconBlocksByRangeRequest{}, size: 24, bounded: true},
+		{name: "blocks by root request", obj: &pb.BeaconBlocksByRootRequest{}, size: 4 + 1024*32, bounded: true},
+		{name: "error response", obj: &pb.ErrorResponse{}, size: 4 + 256, bounded: true},
+		{name: "metadata", obj: &pb.MetaData{}, size: 16, bounded: true},
+		{name: "attestation", obj: &ethpb.Attestation{}, size: 4 + 2048/8 + 1 + 128 + 96, bounded: true},
+		{name: "no ssz tags", obj: &testpb.TestSimpleMessage{}, bounded: false},
+		{name: "nil", obj: nil, bounded: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, bounded := encoder.MaxSSZSize(tt.obj)
+			if bounded != tt.bounded {
+				t.Fatalf("Unexpected bounded, want: %v, got: %v", tt.bounded, bounded)
+			}
+			if size != tt.size {
+				t.Errorf("Unexpected size, want: %d, got: %d", tt.size, size)
+			}
+		})
+	}
+}
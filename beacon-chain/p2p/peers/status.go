@@ -20,6 +20,7 @@
 package peers
 
 import (
+	"bytes"
 	"errors"
 	"sort"
 	"sync"
@@ -421,58 +422,94 @@ func (p *Status) Decay() {
 	}
 }
 
+// FinalizedChain groups the peers which advertise the same finalized checkpoint.
+type FinalizedChain struct {
+	Root  [32]byte
+	Epoch uint64
+	Peers []peer.ID
+}
+
+// FinalizedChains groups connected, not known-bad, peers by the finalized checkpoint they advertise, considering
+// only checkpoints at or beyond our own finalized epoch. Chains are ordered by the number of supporting peers, in
+// decreasing order, with ties broken by the higher finalized epoch and then by root, so that callers consistently
+// agree on the majority chain.
+func (p *Status) FinalizedChains(ourFinalizedEpoch uint64) []*FinalizedChain {
+	connected := p.Connected()
+	chains := make(map[[32]byte]*FinalizedChain)
+	for _, pid := range connected {
+		if p.IsBad(pid) {
+			continue
+		}
+		peerChainState, err := p.ChainState(pid)
+		if err != nil || peerChainState == nil || peerChainState.FinalizedEpoch < ourFinalizedEpoch {
+			continue
+		}
+		root := bytesutil.ToBytes32(peerChainState.FinalizedRoot)
+		if _, ok := chains[root]; !ok {
+			chains[root] = &FinalizedChain{
+				Root:  root,
+				Epoch: peerChainState.FinalizedEpoch,
+				Peers: make([]peer.ID, 0),
+			}
+		}
+		chains[root].Peers = append(chains[root].Peers, pid)
+	}
+
+	result := make([]*FinalizedChain, 0, len(chains))
+	for _, chain := range chains {
+		result = append(result, chain)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if len(result[i].Peers) != len(result[j].Peers) {
+			return len(result[i].Peers) > len(result[j].Peers)
+		}
+		if result[i].Epoch != result[j].Epoch {
+			return result[i].Epoch > result[j].Epoch
+		}
+		return bytes.Compare(result[i].Root[:], result[j].Root[:]) < 0
+	})
+	return result
+}
+
 // BestFinalized returns the highest finalized epoch equal to or higher than ours that is agreed upon by the majority of peers.
 // This method may not return the absolute highest finalized, but the finalized epoch in which most peers can serve blocks.
 // Ideally, all peers would be reporting the same finalized epoch but some may be behind due to their own latency, or because of
-// their finalized epoch at the time we queried them.
+// their finalized epoch at the time we queried them. Peers which advertise a different root for the same finalized epoch are
+// on a conflicting chain, and are never returned.
 // Returns the best finalized root, epoch number, and list of peers that are at or beyond that epoch.
 func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) ([]byte, uint64, []peer.ID) {
-	connected := p.Connected()
-	finalized := make(map[[32]byte]uint64)
-	rootToEpoch := make(map[[32]byte]uint64)
-	pidEpochs := make(map[peer.ID]uint64)
-	potentialPIDs := make([]peer.ID, 0, len(connected))
-	for _, pid := range connected {
-		peerChainState, err := p.ChainState(pid)
-		if err == nil && peerChainState != nil && peerChainState.FinalizedEpoch >= ourFinalizedEpoch {
-			root := bytesutil.ToBytes32(peerChainState.FinalizedRoot)
-			finalized[root]++
-			rootToEpoch[root] = peerChainState.FinalizedEpoch
-			pidEpochs[pid] = peerChainState.FinalizedEpoch
-			potentialPIDs = append(potentialPIDs, pid)
-		}
+	chains := p.FinalizedChains(ourFinalizedEpoch)
+	if len(chains) == 0 {
+		return make([]byte, 32), 0, []peer.ID{}
 	}
 
-	// Select the target epoch, which is the epoch most peers agree upon.
-	var targetRoot [32]byte
-	var mostVotes uint64
-	for root, count := range finalized {
-		if count > mostVotes {
-			mostVotes = count
-			targetRoot = root
+	// Select the target chain, which is the one most peers agree upon.
+	target := chains[0]
+	pidEpochs := make(map[peer.ID]uint64)
+	potentialPIDs := make([]peer.ID, 0, len(target.Peers))
+	for _, chain := range chains {
+		// Peers that finalized further may still be on the target chain, those that finalized a different
+		// root at the target epoch cannot be.
+		if chain != target && chain.Epoch <= target.Epoch {
+			continue
+		}
+		for _, pid := range chain.Peers {
+			pidEpochs[pid] = chain.Epoch
+			potentialPIDs = append(potentialPIDs, pid)
 		}
 	}
-	targetEpoch := rootToEpoch[targetRoot]
 
 	// Sort PIDs by finalized epoch, in decreasing order.
-	sort.Slice(potentialPIDs, func(i, j int) bool {
+	sort.SliceStable(potentialPIDs, func(i, j int) bool {
 		return pidEpochs[potentialPIDs[i]] > pidEpochs[potentialPIDs[j]]
 	})
 
-	// Trim potential peers to those on or after target epoch.
-	for i, pid := range potentialPIDs {
-		if pidEpochs[pid] < targetEpoch {
-			potentialPIDs = potentialPIDs[:i]
-			break
-		}
-	}
-
 	// Trim potential peers to at most maxPeers.
 	if len(potentialPIDs) > maxPeers {
 		potentialPIDs = potentialPIDs[:maxPeers]
 	}
 
-	return targetRoot[:], targetEpoch, potentialPIDs
+	return target.Root[:], target.Epoch, potentialPIDs
 }
 
 // fetch is a helper function that fetches a peer status, possibly creating it.
@@ -652,6 +652,81 @@ func TestBestFinalized_returnsMaxValue(t *testing.T) {
 	}
 }
 
+func TestBestFinalized_excludesConflictingPeers(t *testing.T) {
+	maxBadResponses := 2
+	p := peers.NewStatus(maxBadResponses)
+	majorityRoot := [32]byte{'m', 'a', 'j'}
+	minorityRoot := [32]byte{'m', 'i', 'n'}
+	aheadRoot := [32]byte{'a', 'h', 'e', 'a', 'd'}
+
+	majority := make([]peer.ID, 0)
+	for i := 0; i < 3; i++ {
+		pid := addPeer(t, p, peers.PeerConnected)
+		p.SetChainState(pid, &pb.Status{FinalizedEpoch: 4, FinalizedRoot: majorityRoot[:]})
+		majority = append(majority, pid)
+	}
+	minority := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(minority, &pb.Status{FinalizedEpoch: 4, FinalizedRoot: minorityRoot[:]})
+	ahead := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(ahead, &pb.Status{FinalizedEpoch: 5, FinalizedRoot: aheadRoot[:]})
+
+	root, epoch, pids := p.BestFinalized(15, 0)
+	if !bytes.Equal(root, majorityRoot[:]) {
+		t.Errorf("Incorrect finalized root retrieved; wanted %#x but got %#x", majorityRoot, root)
+	}
+	if epoch != 4 {
+		t.Errorf("Incorrect finalized epoch retrieved; wanted %d but got %d", 4, epoch)
+	}
+	if len(pids) != len(majority)+1 {
+		t.Fatalf("Incorrect number of peers retrieved; wanted %d but got %d", len(majority)+1, len(pids))
+	}
+	if pids[0] != ahead {
+		t.Errorf("Incorrect first peer; wanted %v but got %v", ahead, pids[0])
+	}
+	for _, pid := range pids {
+		if pid == minority {
+			t.Errorf("Peer %v on a conflicting finalized chain was returned", minority)
+		}
+	}
+}
+
+func TestFinalizedChains(t *testing.T) {
+	maxBadResponses := 2
+	p := peers.NewStatus(maxBadResponses)
+	root1 := [32]byte{'o', 'n', 'e'}
+	root2 := [32]byte{'t', 'w', 'o'}
+	root3 := [32]byte{'t', 'h', 'r', 'e', 'e'}
+
+	for i := 0; i < 2; i++ {
+		pid := addPeer(t, p, peers.PeerConnected)
+		p.SetChainState(pid, &pb.Status{FinalizedEpoch: 3, FinalizedRoot: root1[:]})
+	}
+	pid := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(pid, &pb.Status{FinalizedEpoch: 4, FinalizedRoot: root2[:]})
+	// Behind our finalized epoch.
+	pid = addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(pid, &pb.Status{FinalizedEpoch: 1, FinalizedRoot: root3[:]})
+	// Bad peers are not taken into account.
+	for i := 0; i < 2; i++ {
+		pid := addPeer(t, p, peers.PeerConnected)
+		p.SetChainState(pid, &pb.Status{FinalizedEpoch: 4, FinalizedRoot: root2[:]})
+		for j := 0; j < maxBadResponses; j++ {
+			p.IncrementBadResponses(pid)
+		}
+	}
+
+	chains := p.FinalizedChains(2)
+	if len(chains) != 2 {
+		t.Fatalf("Unexpected number of chains; wanted %d but got %d", 2, len(chains))
+	}
+	if chains[0].Root != root1 || chains[0].Epoch != 3 || len(chains[0].Peers) != 2 {
+		t.Errorf("Unexpected majority chain: %#x at epoch %d with %d peers", chains[0].Root, chains[0].Epoch, len(chains[0].Peers))
+	}
+	if chains[1].Root != root2 || chains[1].Epoch != 4 || len(chains[1].Peers) != 1 {
+		t.Errorf("Unexpected minority chain: %#x at epoch %d with %d peers", chains[1].Root, chains[1].Epoch, len(chains[1].Peers))
+	}
+}
+
 func TestStatus_CurrentEpoch(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(maxBadResponses)
@@ -15,23 +15,15 @@ func (s *Service) BlockExists(ctx context.Context, hash common.Hash) (bool, *big
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockExists")
 	defer span.End()
 
-	if exists, blkInfo, err := s.blockCache.BlockInfoByHash(hash); exists || err != nil {
-		if err != nil {
-			return false, nil, err
-		}
-		span.AddAttributes(trace.BoolAttribute("blockCacheHit", true))
-		return true, blkInfo.Number, nil
+	if header := s.headerStore.headerByHash(hash); header != nil {
+		span.AddAttributes(trace.BoolAttribute("headerStoreHit", true))
+		return true, new(big.Int).SetUint64(header.Number), nil
 	}
-	span.AddAttributes(trace.BoolAttribute("blockCacheHit", false))
+	span.AddAttributes(trace.BoolAttribute("headerStoreHit", false))
 	block, err := s.blockFetcher.BlockByHash(ctx, hash)
 	if err != nil {
 		return false, big.NewInt(0), errors.Wrap(err, "could not query block with given hash")
 	}
-
-	if err := s.blockCache.AddBlock(block); err != nil {
-		return false, big.NewInt(0), err
-	}
-
 	return true, block.Number(), nil
 }
 
@@ -40,21 +32,15 @@ func (s *Service) BlockHashByHeight(ctx context.Context, height *big.Int) (commo
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockHashByHeight")
 	defer span.End()
 
-	if exists, blkInfo, err := s.blockCache.BlockInfoByHeight(height); exists || err != nil {
-		if err != nil {
-			return [32]byte{}, err
-		}
-		span.AddAttributes(trace.BoolAttribute("blockCacheHit", true))
-		return blkInfo.Hash, nil
+	if header := s.headerStore.headerByNumber(height.Uint64()); header != nil {
+		span.AddAttributes(trace.BoolAttribute("headerStoreHit", true))
+		return common.BytesToHash(header.Hash), nil
 	}
-	span.AddAttributes(trace.BoolAttribute("blockCacheHit", false))
+	span.AddAttributes(trace.BoolAttribute("headerStoreHit", false))
 	block, err := s.blockFetcher.BlockByNumber(ctx, height)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, fmt.Sprintf("could not query block with height %d", height.Uint64()))
 	}
-	if err := s.blockCache.AddBlock(block); err != nil {
-		return [32]byte{}, err
-	}
 	return block.Hash(), nil
 }
 
@@ -62,6 +48,12 @@ func (s *Service) BlockHashByHeight(ctx context.Context, height *big.Int) (commo
 func (s *Service) BlockTimeByHeight(ctx context.Context, height *big.Int) (uint64, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockTimeByHeight")
 	defer span.End()
+
+	if header := s.headerStore.headerByNumber(height.Uint64()); header != nil {
+		span.AddAttributes(trace.BoolAttribute("headerStoreHit", true))
+		return header.Timestamp, nil
+	}
+	span.AddAttributes(trace.BoolAttribute("headerStoreHit", false))
 	block, err := s.blockFetcher.BlockByNumber(ctx, height)
 	if err != nil {
 		return 0, errors.Wrap(err, fmt.Sprintf("could not query block with height %d", height.Uint64()))
@@ -70,41 +62,44 @@ func (s *Service) BlockTimeByHeight(ctx context.Context, height *big.Int) (uint6
 }
 
 // BlockNumberByTimestamp returns the most recent block number up to a given timestamp.
-// This is a naive implementation that will use O(ETH1_FOLLOW_DISTANCE) calls to cache
-// or ETH1. This is called for multiple times but only changes every
-// SlotsPerEth1VotingPeriod (1024 slots) so the whole method should be cached.
+// The header store is searched first, and the eth1 chain is binary searched with
+// O(log(head)) calls when the timestamp precedes the stored headers.
 func (s *Service) BlockNumberByTimestamp(ctx context.Context, time uint64) (*big.Int, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockByTimestamp")
 	defer span.End()
 
+	if number, ok := s.headerStore.numberByTimestamp(time); ok {
+		span.AddAttributes(trace.BoolAttribute("headerStoreHit", true))
+		return new(big.Int).SetUint64(number), nil
+	}
+	span.AddAttributes(trace.BoolAttribute("headerStoreHit", false))
+
 	head, err := s.blockFetcher.BlockByNumber(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
-
-	for bn := head.Number(); ; bn = big.NewInt(0).Sub(bn, big.NewInt(1)) {
+	if head.Time() <= time {
+		return head.Number(), nil
+	}
+	// Search for the first block after the timestamp, between the genesis block and the head.
+	low, high := uint64(0), head.NumberU64()
+	for low < high {
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
 		}
-
-		exists, info, err := s.blockCache.BlockInfoByHeight(bn)
+		mid := low + (high-low)/2
+		blk, err := s.blockFetcher.BlockByNumber(ctx, new(big.Int).SetUint64(mid))
 		if err != nil {
 			return nil, err
 		}
-
-		if !exists {
-			blk, err := s.blockFetcher.BlockByNumber(ctx, bn)
-			if err != nil {
-				return nil, err
-			}
-			if err := s.blockCache.AddBlock(blk); err != nil {
-				return nil, err
-			}
-			info = blockToBlockInfo(blk)
-		}
-
-		if info.Time <= time {
-			return info.Number, nil
+		if blk.Time() > time {
+			high = mid
+		} else {
+			low = mid + 1
 		}
 	}
+	if low == 0 {
+		return nil, fmt.Errorf("no eth1 block with a timestamp up to %d", time)
+	}
+	return new(big.Int).SetUint64(low - 1), nil
 }
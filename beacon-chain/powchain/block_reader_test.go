@@ -13,6 +13,7 @@ import (
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
@@ -79,19 +80,12 @@ func TestLatestMainchainInfo_OK(t *testing.T) {
 		t.Errorf("block time not set, expected %v, got %v", time.Unix(int64(header.Time), 0), web3Service.latestEth1Data.BlockTime)
 	}
 
-	blockInfoExistsInCache, info, err := web3Service.blockCache.BlockInfoByHash(bytesutil.ToBytes32(web3Service.latestEth1Data.BlockHash))
-	if err != nil {
-		t.Fatal(err)
+	stored := web3Service.headerStore.headerByHash(bytesutil.ToBytes32(web3Service.latestEth1Data.BlockHash))
+	if stored == nil {
+		t.Fatal("Expected latest header to be indexed in header store")
 	}
-	if !blockInfoExistsInCache {
-		t.Error("Expected block info to exist in cache")
-	}
-	if info.Hash != bytesutil.ToBytes32(web3Service.latestEth1Data.BlockHash) {
-		t.Errorf(
-			"Expected block info hash to be %v, got %v",
-			bytesutil.ToBytes32(web3Service.latestEth1Data.BlockHash),
-			info.Hash,
-		)
+	if stored.Number != header.Number.Uint64() {
+		t.Errorf("Expected stored header number to be %d, got %d", header.Number.Uint64(), stored.Number)
 	}
 }
 
@@ -126,14 +120,6 @@ func TestBlockHashByHeight_ReturnsHash(t *testing.T) {
 	if !bytes.Equal(hash.Bytes(), wanted.Bytes()) {
 		t.Fatalf("Block hash did not equal expected hash, expected: %v, got: %v", wanted, hash)
 	}
-
-	exists, _, err := web3Service.blockCache.BlockInfoByHash(wanted)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !exists {
-		t.Error("Expected block info to be cached")
-	}
 }
 
 func TestBlockExists_ValidHash(t *testing.T) {
@@ -167,14 +153,6 @@ func TestBlockExists_ValidHash(t *testing.T) {
 	if height.Cmp(block.Number()) != 0 {
 		t.Fatalf("Block height did not equal expected height, expected: %v, got: %v", big.NewInt(42), height)
 	}
-
-	exists, _, err = web3Service.blockCache.BlockInfoByHeight(height)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !exists {
-		t.Error("Expected block to be cached")
-	}
 }
 
 func TestBlockExists_InvalidHash(t *testing.T) {
@@ -194,7 +172,7 @@ func TestBlockExists_InvalidHash(t *testing.T) {
 	}
 }
 
-func TestBlockExists_UsesCachedBlockInfo(t *testing.T) {
+func TestBlockExists_UsesHeaderStore(t *testing.T) {
 	beaconDB := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
 		HTTPEndPoint: endpoint,
@@ -203,7 +181,7 @@ func TestBlockExists_UsesCachedBlockInfo(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
 	}
-	// nil blockFetcher would panic if cached value not used
+	// nil blockFetcher would panic if stored header not used
 	web3Service.blockFetcher = nil
 
 	block := gethTypes.NewBlock(
@@ -215,7 +193,7 @@ func TestBlockExists_UsesCachedBlockInfo(t *testing.T) {
 		[]*gethTypes.Receipt{},
 	)
 
-	if err := web3Service.blockCache.AddBlock(block); err != nil {
+	if err := web3Service.headerStore.insert(context.Background(), []*protodb.ETH1Header{eth1Header(block.Header())}); err != nil {
 		t.Fatal(err)
 	}
 
@@ -0,0 +1,289 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+// DepositSnapshot builds a snapshot of the deposit tree up to the given deposit count from the
+// eth1 data persisted by a synced node. The hash of the eth1 block which contains the last
+// deposit of the snapshot and the genesis state are left for the caller to fill in from an
+// eth1 node and the beacon database.
+func DepositSnapshot(eth1Data *protodb.ETH1ChainData, depositCount uint64) (*protodb.DepositSnapshot, error) {
+	if eth1Data == nil || eth1Data.Trie == nil {
+		return nil, errors.New("no deposit trie in eth1 data")
+	}
+	chainStart := eth1Data.ChainstartData
+	if chainStart == nil || !chainStart.Chainstarted {
+		return nil, errors.New("cannot take a deposit snapshot before chainstart")
+	}
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	finalized, err := trieutil.CreateTrieFromProto(eth1Data.Trie).FinalizedBranches(depositCount)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized branches of deposit trie")
+	}
+	snapshotTrie, err := trieutil.CreateTrieFromFinalized(finalized, depositCount, depth)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create deposit trie from finalized branches")
+	}
+	height, err := depositBlockHeight(eth1Data, int64(depositCount)-1)
+	if err != nil {
+		return nil, err
+	}
+	root := snapshotTrie.Root()
+	return &protodb.DepositSnapshot{
+		Finalized:       finalized,
+		DepositRoot:     root[:],
+		DepositCount:    depositCount,
+		Eth1BlockHeight: height,
+		ChainstartData:  snapshotChainStartData(chainStart),
+	}, nil
+}
+
+// snapshotChainStartData returns the chainstart data of a deposit snapshot, which leaves out the
+// chainstart deposits.
+func snapshotChainStartData(chainStart *protodb.ChainStartData) *protodb.ChainStartData {
+	return &protodb.ChainStartData{
+		Chainstarted:       chainStart.Chainstarted,
+		GenesisTime:        chainStart.GenesisTime,
+		GenesisBlock:       chainStart.GenesisBlock,
+		Eth1Data:           chainStart.Eth1Data,
+		ChainstartDeposits: []*ethpb.Deposit{},
+	}
+}
+
+// depositBlockHeight returns the height of the eth1 block which contains the deposit with the given index.
+func depositBlockHeight(eth1Data *protodb.ETH1ChainData, index int64) (uint64, error) {
+	for _, ctr := range eth1Data.DepositContainers {
+		if ctr.Index == index {
+			return ctr.Eth1BlockHeight, nil
+		}
+	}
+	// The deposit may be the last deposit of the snapshot the node was seeded from.
+	if snapshot := eth1Data.DepositSnapshot; snapshot != nil && int64(snapshot.DepositCount)-1 == index {
+		return snapshot.Eth1BlockHeight, nil
+	}
+	return 0, fmt.Errorf("no deposit with index %d in eth1 data", index)
+}
+
+// importDepositSnapshot seeds the deposit trie and cache of a node with no eth1 data from a deposit
+// snapshot, so that only the deposit contract logs following the snapshot need to be processed. The
+// genesis state cannot be built from the chainstart deposits left out of the snapshot, so it is saved
+// from the snapshot when the database has none.
+func (s *Service) importDepositSnapshot(ctx context.Context, snapshot *protodb.DepositSnapshot) error {
+	if snapshot.ChainstartData == nil || !snapshot.ChainstartData.Chainstarted {
+		return errors.New("deposit snapshot was taken before chainstart")
+	}
+	genesisState, err := s.beaconDB.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis state")
+	}
+	if genesisState == nil {
+		if snapshot.GenesisState == nil {
+			return errors.New("a deposit snapshot without genesis state can only be imported into a database with the genesis state")
+		}
+		if err := s.saveSnapshotGenesisState(ctx, snapshot); err != nil {
+			return err
+		}
+	}
+	// The genesis state is not persisted with the eth1 data.
+	snapshot = &protodb.DepositSnapshot{
+		Finalized:       snapshot.Finalized,
+		DepositRoot:     snapshot.DepositRoot,
+		DepositCount:    snapshot.DepositCount,
+		Eth1BlockHash:   snapshot.Eth1BlockHash,
+		Eth1BlockHeight: snapshot.Eth1BlockHeight,
+		ChainstartData:  snapshot.ChainstartData,
+	}
+	trie, err := s.seedFinalizedDeposits(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+	s.depositTrie = trie.Copy()
+	s.lastReceivedMerkleIndex = int64(snapshot.DepositCount) - 1
+	s.chainStartData = &protodb.ChainStartData{
+		Chainstarted:       true,
+		GenesisTime:        snapshot.ChainstartData.GenesisTime,
+		GenesisBlock:       snapshot.ChainstartData.GenesisBlock,
+		Eth1Data:           snapshot.ChainstartData.Eth1Data,
+		ChainstartDeposits: make([]*ethpb.Deposit, 0),
+	}
+	// Request the logs from the block of the last deposit of the snapshot, as the block may contain
+	// later deposits. The deposits of the snapshot are skipped when processing the logs.
+	s.latestEth1Data.LastRequestedBlock = snapshot.Eth1BlockHeight
+	log.WithFields(logrus.Fields{
+		"depositCount": snapshot.DepositCount,
+		"depositRoot":  fmt.Sprintf("%#x", snapshot.DepositRoot),
+		"blockNumber":  snapshot.Eth1BlockHeight,
+	}).Info("Imported deposit snapshot")
+	return s.savePowchainData(ctx)
+}
+
+// saveSnapshotGenesisState checks the genesis state of the deposit snapshot against its chainstart
+// data, and saves it with the genesis block as the head and the finalized checkpoint of the database.
+func (s *Service) saveSnapshotGenesisState(ctx context.Context, snapshot *protodb.DepositSnapshot) error {
+	chainStart := snapshot.ChainstartData
+	if snapshot.GenesisState.GenesisTime != chainStart.GenesisTime {
+		return fmt.Errorf("genesis time %d of snapshot genesis state does not match chainstart genesis time %d",
+			snapshot.GenesisState.GenesisTime, chainStart.GenesisTime)
+	}
+	if !proto.Equal(snapshot.GenesisState.Eth1Data, chainStart.Eth1Data) {
+		return errors.New("eth1 data of snapshot genesis state does not match chainstart eth1 data")
+	}
+	genesisState, err := stateTrie.InitializeFromProto(snapshot.GenesisState)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize genesis state")
+	}
+	stateRoot, err := genesisState.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash genesis state")
+	}
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesisBlkRoot, err := stateutil.BlockRoot(genesisBlk.Block)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block root")
+	}
+	if err := s.beaconDB.SaveBlock(ctx, genesisBlk); err != nil {
+		return errors.Wrap(err, "could not save genesis block")
+	}
+	if err := s.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{
+		Slot: 0,
+		Root: genesisBlkRoot[:],
+	}); err != nil {
+		return err
+	}
+	if err := s.beaconDB.SaveState(ctx, genesisState, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could not save genesis state")
+	}
+	if err := s.beaconDB.SaveGenesisBlockRoot(ctx, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could not save genesis block root")
+	}
+	if err := s.beaconDB.SaveHeadBlockRoot(ctx, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	genesisCheckpoint := &ethpb.Checkpoint{Root: genesisBlkRoot[:]}
+	if err := s.beaconDB.SaveJustifiedCheckpoint(ctx, genesisCheckpoint); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := s.beaconDB.SaveFinalizedCheckpoint(ctx, genesisCheckpoint); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	log.WithField("genesisRoot", fmt.Sprintf("%#x", genesisBlkRoot)).Info("Saved genesis state of deposit snapshot")
+	return nil
+}
+
+// seedFinalizedDeposits creates the deposit trie of the snapshot, checks it against the deposit root
+// of the snapshot and inserts it into the deposit cache as the finalized deposits.
+func (s *Service) seedFinalizedDeposits(ctx context.Context, snapshot *protodb.DepositSnapshot) (*trieutil.SparseMerkleTrie, error) {
+	trie, err := trieutil.CreateTrieFromFinalized(snapshot.Finalized, snapshot.DepositCount, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create deposit trie from snapshot")
+	}
+	root := trie.Root()
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		return nil, fmt.Errorf("deposit snapshot root %#x does not match the root of its deposit trie %#x", snapshot.DepositRoot, root)
+	}
+	s.depositCache.InsertFinalizedDeposits(ctx, &depositcache.FinalizedDeposits{
+		Deposits:        trie,
+		MerkleTrieIndex: int64(snapshot.DepositCount) - 1,
+		Eth1BlockHeight: snapshot.Eth1BlockHeight,
+	})
+	s.depositSnapshot = snapshot
+	return trie, nil
+}
+
+// verifyDepositSnapshot checks the eth1 block of the deposit snapshot the node was seeded from
+// against the eth1 chain of the connected endpoint. The snapshots of the deposits finalized by
+// the node itself have no eth1 block hash, and need no verification.
+func (s *Service) verifyDepositSnapshot(ctx context.Context) error {
+	if s.depositSnapshot == nil || len(s.depositSnapshot.Eth1BlockHash) == 0 || s.depositSnapshotVerified {
+		return nil
+	}
+	height := new(big.Int).SetUint64(s.depositSnapshot.Eth1BlockHeight)
+	header, err := s.blockFetcher.HeaderByNumber(ctx, height)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch deposit snapshot block")
+	}
+	if !bytes.Equal(header.Hash().Bytes(), s.depositSnapshot.Eth1BlockHash) {
+		return fmt.Errorf("deposit snapshot block hash %#x does not match the hash %#x of eth1 block %d",
+			s.depositSnapshot.Eth1BlockHash, header.Hash(), height)
+	}
+	s.depositSnapshotVerified = true
+	return nil
+}
+
+// compactDepositTrie snapshots the finalized deposits of the deposit cache, and replaces the deposit
+// trie with a copy of the finalized deposit trie into which the following deposits are inserted, so
+// that neither the trie nor the persisted eth1 data keep the leaves of the finalized deposits.
+func (s *Service) compactDepositTrie(ctx context.Context) error {
+	fd := s.depositCache.FinalizedDeposits(ctx)
+	if fd == nil || (s.depositSnapshot != nil && int64(s.depositSnapshot.DepositCount)-1 >= fd.MerkleTrieIndex) {
+		return nil
+	}
+	depositTrie := fd.Deposits.Copy()
+	nextIndex := fd.MerkleTrieIndex + 1
+	for _, ctnr := range s.depositCache.AllDepositContainers(ctx) {
+		// More deposits may have been finalized since the finalized deposits were retrieved,
+		// the trie is then compacted on the next save.
+		if ctnr.Index != nextIndex {
+			return nil
+		}
+		depositHash, err := ssz.HashTreeRoot(ctnr.Deposit.Data)
+		if err != nil {
+			return errors.Wrap(err, "could not hash deposit data")
+		}
+		depositTrie.Insert(depositHash[:], int(ctnr.Index))
+		nextIndex++
+	}
+	if nextIndex-1 != s.lastReceivedMerkleIndex || depositTrie.Root() != s.depositTrie.Root() {
+		return nil
+	}
+
+	depositCount := uint64(fd.MerkleTrieIndex + 1)
+	finalized, err := fd.Deposits.FinalizedBranches(depositCount)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized branches of deposit trie")
+	}
+	root := fd.Deposits.Root()
+	s.depositSnapshot = &protodb.DepositSnapshot{
+		Finalized:       finalized,
+		DepositRoot:     root[:],
+		DepositCount:    depositCount,
+		Eth1BlockHeight: fd.Eth1BlockHeight,
+		ChainstartData:  snapshotChainStartData(s.chainStartData),
+	}
+	s.depositTrie = depositTrie
+	return nil
+}
+
+// savePowchainData persists the eth1 data of the service.
+func (s *Service) savePowchainData(ctx context.Context) error {
+	if err := s.compactDepositTrie(ctx); err != nil {
+		return errors.Wrap(err, "could not compact deposit trie")
+	}
+	eth1Data := &protodb.ETH1ChainData{
+		CurrentEth1Data:   s.latestEth1Data,
+		ChainstartData:    s.chainStartData,
+		BeaconState:       s.preGenesisState.InnerStateUnsafe(), // I promise not to mutate it!
+		Trie:              s.depositTrie.ToProto(),
+		DepositContainers: s.depositCache.AllDepositContainers(ctx),
+		DepositSnapshot:   s.depositSnapshot,
+	}
+	return s.beaconDB.SavePowchainData(ctx, eth1Data)
+}
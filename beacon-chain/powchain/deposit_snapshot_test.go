@@ -0,0 +1,408 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// depositSnapshotOfChainData returns the eth1 data of a synced node with 10 deposits, each in its
+// own eth1 block from height 100 onwards, and the snapshot of its first 6 deposits.
+func depositSnapshotOfChainData(t *testing.T) (*protodb.ETH1ChainData, *protodb.DepositSnapshot) {
+	testutil.ResetCache()
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trie, _, err := testutil.DeterministicDepositTrie(len(deposits))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctrs := make([]*protodb.DepositContainer, len(deposits))
+	for i, d := range deposits {
+		ctrs[i] = &protodb.DepositContainer{
+			Index:           int64(i),
+			Eth1BlockHeight: uint64(100 + i),
+			Deposit:         d,
+		}
+	}
+	eth1Data := &protodb.ETH1ChainData{
+		ChainstartData: &protodb.ChainStartData{
+			Chainstarted: true,
+			GenesisTime:  10,
+			GenesisBlock: 5,
+			Eth1Data:     &ethpb.Eth1Data{},
+		},
+		Trie:              trie.ToProto(),
+		DepositContainers: ctrs,
+	}
+	snapshot, err := DepositSnapshot(eth1Data, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return eth1Data, snapshot
+}
+
+func saveGenesisState(t *testing.T, beaconDB db.Database) {
+	root := [32]byte{'a'}
+	if err := beaconDB.SaveState(context.Background(), testutil.NewBeaconState(), root); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(context.Background(), root); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDepositSnapshot_OK(t *testing.T) {
+	_, snapshot := depositSnapshotOfChainData(t)
+	wantTrie, _, err := testutil.DeterministicDepositTrie(6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRoot := wantTrie.Root()
+	if !bytes.Equal(snapshot.DepositRoot, wantRoot[:]) {
+		t.Errorf("Wanted deposit root %#x, received %#x", wantRoot, snapshot.DepositRoot)
+	}
+	if snapshot.DepositCount != 6 {
+		t.Errorf("Wanted deposit count 6, received %d", snapshot.DepositCount)
+	}
+	if snapshot.Eth1BlockHeight != 105 {
+		t.Errorf("Wanted eth1 block height 105, received %d", snapshot.Eth1BlockHeight)
+	}
+	// 6 deposits are covered by a subtree of 4 deposits and a subtree of 2 deposits.
+	if len(snapshot.Finalized) != 2 {
+		t.Errorf("Wanted 2 finalized branches, received %d", len(snapshot.Finalized))
+	}
+	if len(snapshot.ChainstartData.ChainstartDeposits) != 0 {
+		t.Error("Wanted no chainstart deposits in snapshot")
+	}
+}
+
+func TestDepositSnapshot_BeforeChainstart(t *testing.T) {
+	eth1Data, _ := depositSnapshotOfChainData(t)
+	eth1Data.ChainstartData.Chainstarted = false
+	if _, err := DepositSnapshot(eth1Data, 6); err == nil {
+		t.Error("Expected failure to take a snapshot before chainstart")
+	}
+}
+
+func TestImportDepositSnapshot_SeedsTrieAndCache(t *testing.T) {
+	ctx := context.Background()
+	eth1Data, snapshot := depositSnapshotOfChainData(t)
+	beaconDB := dbutil.SetupDB(t)
+	saveGenesisState(t, beaconDB)
+
+	web3Service, err := NewService(ctx, &Web3ServiceConfig{
+		HTTPEndPoint:    endpoint,
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+		DepositSnapshot: snapshot,
+	})
+	if err != nil {
+		t.Fatalf("Unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	if web3Service.lastReceivedMerkleIndex != 5 {
+		t.Errorf("Wanted last received merkle index 5, received %d", web3Service.lastReceivedMerkleIndex)
+	}
+	if web3Service.latestEth1Data.LastRequestedBlock != 105 {
+		t.Errorf("Wanted last requested block 105, received %d", web3Service.latestEth1Data.LastRequestedBlock)
+	}
+	if !web3Service.chainStartData.Chainstarted || web3Service.chainStartData.GenesisTime != 10 {
+		t.Errorf("Unexpected chainstart data %v", web3Service.chainStartData)
+	}
+	root := web3Service.DepositRoot()
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		t.Errorf("Wanted deposit root %#x, received %#x", snapshot.DepositRoot, root)
+	}
+	fd := web3Service.depositCache.FinalizedDeposits(ctx)
+	if fd == nil || fd.MerkleTrieIndex != 5 || fd.Eth1BlockHeight != 105 {
+		t.Fatalf("Unexpected finalized deposits %v", fd)
+	}
+
+	// Deposits following the snapshot are inserted into the seeded trie.
+	fullTrie := trieutil.CreateTrieFromProto(eth1Data.Trie)
+	for i := 6; i < 10; i++ {
+		web3Service.depositTrie.Insert(fullTrie.Items()[i], i)
+	}
+	if web3Service.DepositRoot() != fullTrie.Root() {
+		t.Errorf("Wanted deposit root %#x, received %#x", fullTrie.Root(), web3Service.DepositRoot())
+	}
+
+	// The snapshot is restored from the database on restart.
+	restarted, err := NewService(ctx, &Web3ServiceConfig{
+		HTTPEndPoint: endpoint,
+		BeaconDB:     beaconDB,
+		DepositCache: depositcache.NewDepositCache(),
+	})
+	if err != nil {
+		t.Fatalf("Unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	if restarted.lastReceivedMerkleIndex != 5 {
+		t.Errorf("Wanted last received merkle index 5, received %d", restarted.lastReceivedMerkleIndex)
+	}
+	root = restarted.DepositRoot()
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		t.Errorf("Wanted deposit root %#x, received %#x", snapshot.DepositRoot, root)
+	}
+	if restarted.depositCache.FinalizedDeposits(ctx) == nil {
+		t.Error("Expected finalized deposits to be restored")
+	}
+}
+
+func TestImportDepositSnapshot_RequiresGenesisState(t *testing.T) {
+	_, snapshot := depositSnapshotOfChainData(t)
+	_, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndPoint:    endpoint,
+		BeaconDB:        dbutil.SetupDB(t),
+		DepositCache:    depositcache.NewDepositCache(),
+		DepositSnapshot: snapshot,
+	})
+	if err == nil || !strings.Contains(err.Error(), "genesis state") {
+		t.Errorf("Expected failure to import snapshot without genesis state, received %v", err)
+	}
+}
+
+// snapshotGenesisState returns the genesis state matching the chainstart data of the snapshot.
+func snapshotGenesisState(t *testing.T, snapshot *protodb.DepositSnapshot) *pb.BeaconState {
+	st := testutil.NewBeaconState()
+	if err := st.SetGenesisTime(snapshot.ChainstartData.GenesisTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.SetEth1Data(snapshot.ChainstartData.Eth1Data); err != nil {
+		t.Fatal(err)
+	}
+	return st.InnerStateUnsafe()
+}
+
+func TestImportDepositSnapshot_FreshDatabase(t *testing.T) {
+	ctx := context.Background()
+	_, snapshot := depositSnapshotOfChainData(t)
+	snapshot.GenesisState = snapshotGenesisState(t, snapshot)
+	beaconDB := dbutil.SetupDB(t)
+
+	web3Service, err := NewService(ctx, &Web3ServiceConfig{
+		HTTPEndPoint:    endpoint,
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+		DepositSnapshot: snapshot,
+	})
+	if err != nil {
+		t.Fatalf("Unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	if web3Service.lastReceivedMerkleIndex != 5 {
+		t.Errorf("Wanted last received merkle index 5, received %d", web3Service.lastReceivedMerkleIndex)
+	}
+
+	genesisState, err := beaconDB.GenesisState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genesisState == nil || genesisState.GenesisTime() != 10 {
+		t.Fatalf("Unexpected genesis state %v", genesisState)
+	}
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headState == nil {
+		t.Fatal("Expected the genesis state to be the head state")
+	}
+	genesisBlock, err := beaconDB.GenesisBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := stateutil.BlockRoot(genesisBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp, err := beaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(cp.Root, genesisRoot[:]) {
+		t.Errorf("Wanted finalized root %#x, received %#x", genesisRoot, cp.Root)
+	}
+
+	// The genesis state is not persisted with the eth1 data.
+	saved, err := beaconDB.PowchainData(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.DepositSnapshot == nil || saved.DepositSnapshot.DepositCount != 6 {
+		t.Fatalf("Unexpected persisted deposit snapshot %v", saved.DepositSnapshot)
+	}
+	if saved.DepositSnapshot.GenesisState != nil {
+		t.Error("Wanted no genesis state in the persisted deposit snapshot")
+	}
+}
+
+func TestImportDepositSnapshot_GenesisStateMismatch(t *testing.T) {
+	_, snapshot := depositSnapshotOfChainData(t)
+	snapshot.GenesisState = snapshotGenesisState(t, snapshot)
+	snapshot.GenesisState.GenesisTime = 11
+	beaconDB := dbutil.SetupDB(t)
+	_, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndPoint:    endpoint,
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+		DepositSnapshot: snapshot,
+	})
+	if err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Errorf("Expected failure to import snapshot with a mismatching genesis state, received %v", err)
+	}
+	genesisState, err := beaconDB.GenesisState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genesisState != nil {
+		t.Error("Expected no genesis state to be saved")
+	}
+}
+
+func TestImportDepositSnapshot_RootMismatch(t *testing.T) {
+	_, snapshot := depositSnapshotOfChainData(t)
+	snapshot.DepositRoot = []byte{'b'}
+	beaconDB := dbutil.SetupDB(t)
+	saveGenesisState(t, beaconDB)
+	_, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndPoint:    endpoint,
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+		DepositSnapshot: snapshot,
+	})
+	if err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Errorf("Expected failure to import snapshot with a mismatching root, received %v", err)
+	}
+}
+
+func TestVerifyDepositSnapshot(t *testing.T) {
+	_, snapshot := depositSnapshotOfChainData(t)
+	beaconDB := dbutil.SetupDB(t)
+	saveGenesisState(t, beaconDB)
+	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndPoint:    endpoint,
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+		DepositSnapshot: snapshot,
+	})
+	if err != nil {
+		t.Fatalf("Unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service = setDefaultMocks(web3Service)
+
+	snapshot.Eth1BlockHash = []byte{'b'}
+	if err := web3Service.verifyDepositSnapshot(context.Background()); err == nil {
+		t.Error("Expected failure to verify a snapshot of another eth1 chain")
+	}
+	// The good fetcher returns the same header for every height.
+	snapshot.Eth1BlockHash = (&gethTypes.Header{Number: big.NewInt(0)}).Hash().Bytes()
+	if err := web3Service.verifyDepositSnapshot(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !web3Service.depositSnapshotVerified {
+		t.Error("Expected deposit snapshot to be verified")
+	}
+}
+
+func TestCompactDepositTrie_SnapshotsFinalizedDeposits(t *testing.T) {
+	ctx := context.Background()
+	eth1Data, _ := depositSnapshotOfChainData(t)
+	beaconDB := dbutil.SetupDB(t)
+	depositCache := depositcache.NewDepositCache()
+	web3Service, err := NewService(ctx, &Web3ServiceConfig{
+		HTTPEndPoint: endpoint,
+		BeaconDB:     beaconDB,
+		DepositCache: depositCache,
+	})
+	if err != nil {
+		t.Fatalf("Unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	fullTrie := trieutil.CreateTrieFromProto(eth1Data.Trie)
+	web3Service.depositTrie = fullTrie.Copy()
+	web3Service.lastReceivedMerkleIndex = 9
+	web3Service.chainStartData = eth1Data.ChainstartData
+	for _, ctnr := range eth1Data.DepositContainers {
+		depositCache.InsertDeposit(ctx, ctnr.Deposit, ctnr.Eth1BlockHeight, ctnr.Index, [32]byte{})
+	}
+
+	// The trie is left as is until deposits are finalized.
+	if err := web3Service.savePowchainData(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if web3Service.depositSnapshot != nil {
+		t.Error("Expected no deposit snapshot before deposits are finalized")
+	}
+
+	if err := depositCache.FinalizeDeposits(ctx, 6); err != nil {
+		t.Fatal(err)
+	}
+	if err := web3Service.savePowchainData(ctx); err != nil {
+		t.Fatal(err)
+	}
+	snapshot := web3Service.depositSnapshot
+	if snapshot == nil || snapshot.DepositCount != 6 || snapshot.Eth1BlockHeight != 105 {
+		t.Fatalf("Unexpected deposit snapshot %v", snapshot)
+	}
+	if len(snapshot.Eth1BlockHash) != 0 {
+		t.Error("Wanted no eth1 block hash in the snapshot of finalized deposits")
+	}
+	if web3Service.DepositRoot() != fullTrie.Root() {
+		t.Errorf("Wanted deposit root %#x, received %#x", fullTrie.Root(), web3Service.DepositRoot())
+	}
+	if _, err := web3Service.depositTrie.MerkleProof(0); err == nil {
+		t.Error("Expected no proof for a finalized deposit in the compacted trie")
+	}
+	if _, err := web3Service.depositTrie.MerkleProof(9); err != nil {
+		t.Errorf("Could not get proof of a pending deposit: %v", err)
+	}
+	if err := web3Service.verifyDepositSnapshot(ctx); err != nil {
+		t.Error(err)
+	}
+
+	saved, err := beaconDB.PowchainData(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.DepositContainers) != 4 {
+		t.Errorf("Wanted 4 persisted deposit containers, received %d", len(saved.DepositContainers))
+	}
+	if saved.DepositSnapshot == nil || saved.DepositSnapshot.DepositCount != 6 {
+		t.Errorf("Unexpected persisted deposit snapshot %v", saved.DepositSnapshot)
+	}
+
+	// The compacted trie and the finalized deposits are restored from the database on restart.
+	restarted, err := NewService(ctx, &Web3ServiceConfig{
+		HTTPEndPoint: endpoint,
+		BeaconDB:     beaconDB,
+		DepositCache: depositcache.NewDepositCache(),
+	})
+	if err != nil {
+		t.Fatalf("Unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	if restarted.lastReceivedMerkleIndex != 9 {
+		t.Errorf("Wanted last received merkle index 9, received %d", restarted.lastReceivedMerkleIndex)
+	}
+	if restarted.DepositRoot() != fullTrie.Root() {
+		t.Errorf("Wanted deposit root %#x, received %#x", fullTrie.Root(), restarted.DepositRoot())
+	}
+	fd := restarted.depositCache.FinalizedDeposits(ctx)
+	if fd == nil || fd.MerkleTrieIndex != 5 {
+		t.Fatalf("Unexpected finalized deposits %v", fd)
+	}
+	if len(restarted.depositCache.AllDepositContainers(ctx)) != 4 {
+		t.Errorf("Wanted 4 deposit containers, received %d", len(restarted.depositCache.AllDepositContainers(ctx)))
+	}
+}
@@ -0,0 +1,244 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxEndpointHeadLag is the number of blocks an endpoint's head can be behind the best head
+	// among the endpoints before the endpoint is considered unhealthy.
+	maxEndpointHeadLag = 8
+	// maxHeadFetchFailures is the number of consecutive failures to fetch the head from the
+	// active endpoint before failing over to another endpoint.
+	maxHeadFetchFailures = 3
+)
+
+var (
+	// time between two health checks of the eth1 endpoints.
+	endpointHealthCheckPeriod = time.Minute
+	// time after which the active endpoint is stalled when its head block is not newer. A 5 minutes
+	// timeout is used for block time, because the max mining time is 278 sec (block 7208027)
+	// (analyzed the time of the block from 2018-09-01 to 2019-02-13).
+	endpointStallTimeout = 5 * time.Minute
+	// time after which the health check of an eth1 endpoint fails when the endpoint has not answered.
+	endpointHealthCheckTimeout = 10 * time.Second
+)
+
+var (
+	activeEndpointGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_active",
+		Help: "Set to 1 for the eth1 endpoint the beacon node follows, 0 otherwise",
+	}, []string{"endpoint"})
+	healthyEndpointGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_healthy",
+		Help: "Set to 1 for eth1 endpoints which passed their latest health check, 0 otherwise",
+	}, []string{"endpoint"})
+	endpointHeadGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_head_block_number",
+		Help: "The head block number of the eth1 endpoints at their latest health check",
+	}, []string{"endpoint"})
+	endpointFailoverCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_endpoint_failovers",
+		Help: "The number of times the beacon node failed over to another eth1 endpoint",
+	})
+)
+
+// endpointClient defines the calls made to an eth1 endpoint to check its health.
+type endpointClient interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error)
+}
+
+// eth1Endpoint is a configured eth1 endpoint with the result of its latest health check.
+type eth1Endpoint struct {
+	url        string
+	label      string // url without credentials, for logs and metrics.
+	client     endpointClient
+	healthy    bool
+	headNumber uint64
+	err        error
+}
+
+// newEth1Endpoints returns the endpoints to follow, the primary endpoint first and then the
+// fallback endpoints in the given order. Empty and duplicate endpoints are skipped.
+func newEth1Endpoints(primary string, fallbacks []string) []*eth1Endpoint {
+	seen := make(map[string]bool)
+	endpoints := make([]*eth1Endpoint, 0, len(fallbacks)+1)
+	for _, e := range append([]string{primary}, fallbacks...) {
+		if e == "" || seen[e] {
+			continue
+		}
+		seen[e] = true
+		endpoints = append(endpoints, &eth1Endpoint{
+			url:   e,
+			label: endpointLabel(e),
+		})
+	}
+	return endpoints
+}
+
+// endpointLabel strips the path, query and user information of an endpoint, which may hold
+// credentials, so it can be logged and used as a metric label.
+func endpointLabel(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// checkEndpointsHealth checks the sync status, chain ID and head of every eth1 endpoint. Endpoints
+// which cannot be reached, are syncing, follow another chain or lag behind the best head among
+// the endpoints are unhealthy. The endpoints are checked in parallel, each within the health check
+// timeout, so an endpoint which does not answer cannot hold up the caller.
+func (s *Service) checkEndpointsHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, e := range s.endpoints {
+		wg.Add(1)
+		go func(e *eth1Endpoint) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, endpointHealthCheckTimeout)
+			defer cancel()
+			e.err = s.checkEndpoint(checkCtx, e)
+		}(e)
+	}
+	wg.Wait()
+
+	bestHead := uint64(0)
+	for _, e := range s.endpoints {
+		if e.err == nil && e.headNumber > bestHead {
+			bestHead = e.headNumber
+		}
+	}
+	for _, e := range s.endpoints {
+		if e.err == nil && e.headNumber+maxEndpointHeadLag < bestHead {
+			e.err = errors.Errorf("head %d lags %d blocks behind", e.headNumber, bestHead-e.headNumber)
+		}
+		e.healthy = e.err == nil
+		if e.healthy {
+			healthyEndpointGauge.WithLabelValues(e.label).Set(1)
+		} else {
+			healthyEndpointGauge.WithLabelValues(e.label).Set(0)
+			log.WithError(e.err).WithField("endpoint", e.label).Debug("Eth1 endpoint is unhealthy")
+		}
+		endpointHeadGauge.WithLabelValues(e.label).Set(float64(e.headNumber))
+	}
+}
+
+func (s *Service) checkEndpoint(ctx context.Context, e *eth1Endpoint) error {
+	if e.client == nil {
+		rpcClient, err := gethRPC.DialContext(ctx, e.url)
+		if err != nil {
+			return errors.Wrap(err, "could not dial endpoint")
+		}
+		e.client = ethclient.NewClient(rpcClient)
+	}
+	chainID, err := e.client.ChainID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get chain ID")
+	}
+	if s.eth1ChainID != nil && chainID.Cmp(s.eth1ChainID) != 0 {
+		return errors.Errorf("endpoint is on chain %d, wanted %d", chainID, s.eth1ChainID)
+	}
+	progress, err := e.client.SyncProgress(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get sync status")
+	}
+	if progress != nil {
+		return errors.Errorf("endpoint is syncing, at block %d of %d", progress.CurrentBlock, progress.HighestBlock)
+	}
+	header, err := e.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not get head")
+	}
+	e.headNumber = header.Number.Uint64()
+	return nil
+}
+
+// isHeadStalled checks whether the latest head of the active endpoint is older than the stall timeout.
+func (s *Service) isHeadStalled() bool {
+	return time.Unix(int64(s.latestEth1Data.BlockTime), 0).Before(roughtime.Now().Add(-endpointStallTimeout))
+}
+
+// failoverToHealthyEndpoint makes the first healthy endpoint in the configured order the active
+// endpoint, failing over from an unhealthy active endpoint and failing back to a healthier
+// endpoint earlier in the order. It returns true if the active endpoint changed.
+func (s *Service) failoverToHealthyEndpoint() bool {
+	for i, e := range s.endpoints {
+		if !e.healthy {
+			continue
+		}
+		if i == s.currEndpoint {
+			return false
+		}
+		s.switchEndpoint(i)
+		return true
+	}
+	return false
+}
+
+// failover makes the next endpoint the active endpoint after a failure of the active endpoint: the
+// first other healthy endpoint in the configured order, or the next endpoint in the order when
+// no other endpoint is known to be healthy.
+func (s *Service) failover() {
+	if len(s.endpoints) < 2 {
+		return
+	}
+	next := (s.currEndpoint + 1) % len(s.endpoints)
+	for i, e := range s.endpoints {
+		if i != s.currEndpoint && e.healthy {
+			next = i
+			break
+		}
+	}
+	// The active endpoint failed, it is healthy again only once it passes a health check.
+	s.endpoints[s.currEndpoint].healthy = false
+	s.switchEndpoint(next)
+}
+
+// switchEndpoint makes the endpoint at the given index the active endpoint. Connecting to it is
+// left to the caller.
+func (s *Service) switchEndpoint(idx int) {
+	log.WithFields(logrus.Fields{
+		"from": s.endpoints[s.currEndpoint].label,
+		"to":   s.endpoints[idx].label,
+	}).Warn("Failing over to another eth1 endpoint")
+	endpointFailoverCount.Inc()
+	s.currEndpoint = idx
+	s.httpEndpoint = s.endpoints[idx].url
+	s.setActiveEndpointMetric()
+}
+
+func (s *Service) setActiveEndpointMetric() {
+	for i, e := range s.endpoints {
+		if i == s.currEndpoint {
+			activeEndpointGauge.WithLabelValues(e.label).Set(1)
+		} else {
+			activeEndpointGauge.WithLabelValues(e.label).Set(0)
+		}
+	}
+}
+
+// reconnect connects to the active endpoint again after a failover or a failure of the endpoint.
+func (s *Service) reconnect(err error) {
+	s.runError = err
+	s.connectedETH1 = false
+	s.waitForConnection()
+	// reset value in the event of a successful connection.
+	s.runError = nil
+}
@@ -0,0 +1,166 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+var eth1ServerDepositContract = common.HexToAddress("0x4242424242424242424242424242424242424242")
+
+// connectToEth1Server returns a service which is connected to the eth1 server through its client.
+func connectToEth1Server(t *testing.T, srv *mockPOW.Eth1Server, depositCache *depositcache.DepositCache) *Service {
+	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndPoint:    srv.HTTPEndpoint(),
+		DepositContract: eth1ServerDepositContract,
+		BeaconDB:        dbutil.SetupDB(t),
+		DepositCache:    depositCache,
+	})
+	if err != nil {
+		t.Fatalf("Unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service.stateNotifier = &goodNotifier{}
+	if err := web3Service.connectToPowChain(); err != nil {
+		t.Fatal(err)
+	}
+	return web3Service
+}
+
+func TestEth1Server_ProcessesDepositLogs(t *testing.T) {
+	ctx := context.Background()
+	srv, err := mockPOW.NewEth1Server(eth1ServerDepositContract, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+
+	testutil.ResetCache()
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, deposit := range deposits {
+		srv.AddDeposit(deposit)
+		if _, err := srv.MineBlocks(1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	head, err := srv.MineBlocks(params.BeaconConfig().Eth1FollowDistance)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	depositCache := depositcache.NewDepositCache()
+	web3Service := connectToEth1Server(t, srv, depositCache)
+	web3Service.processBlockHeader(head)
+	if err := web3Service.processPastLogs(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if received := len(depositCache.AllDeposits(ctx, nil)); received != len(deposits) {
+		t.Errorf("Wanted %d deposits, received %d", len(deposits), received)
+	}
+	if web3Service.lastReceivedMerkleIndex != int64(len(deposits)-1) {
+		t.Errorf("Wanted last received merkle index %d, received %d", len(deposits)-1, web3Service.lastReceivedMerkleIndex)
+	}
+	processed, err := web3Service.AreAllDepositsProcessed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !processed {
+		t.Error("Expected all deposits of the deposit contract to be processed")
+	}
+	root, err := web3Service.depositContractCaller.GetDepositRoot(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != web3Service.depositTrie.HashTreeRoot() {
+		t.Errorf("Wanted deposit root %#x, received %#x", root, web3Service.depositTrie.HashTreeRoot())
+	}
+}
+
+func TestEth1Server_FollowsReorg(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	srv, err := mockPOW.NewEth1Server(eth1ServerDepositContract, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+
+	head, err := srv.MineBlocks(20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	web3Service := connectToEth1Server(t, srv, depositcache.NewDepositCache())
+	web3Service.processBlockHeader(head)
+
+	orphaned := srv.HeaderByNumber(18)
+	head, err = srv.Reorg(15, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	web3Service.processBlockHeader(head)
+
+	testutil.AssertLogsContain(t, hook, "Eth1 chain reorg occurred")
+	if web3Service.headerStore.headerByHash(orphaned.Hash()) != nil {
+		t.Error("Expected orphaned header to be removed from the header store")
+	}
+	for _, number := range []uint64{15, 16, 18, 25} {
+		hash, err := web3Service.BlockHashByHeight(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hash != srv.HeaderByNumber(number).Hash() {
+			t.Errorf("Wanted hash %#x of block %d, received %#x", srv.HeaderByNumber(number).Hash(), number, hash)
+		}
+	}
+}
+
+func TestEth1Server_SubscribeNewHead(t *testing.T) {
+	ctx := context.Background()
+	srv, err := mockPOW.NewEth1Server(eth1ServerDepositContract, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+
+	client, err := ethclient.Dial(srv.WSEndpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	heads := make(chan *gethTypes.Header, 1)
+	sub, err := client.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	head, err := srv.MineBlocks(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case received := <-heads:
+		if received.Hash() != head.Hash() {
+			t.Errorf("Wanted head %#x, received %#x", head.Hash(), received.Hash())
+		}
+	case err := <-sub.Err():
+		t.Fatal(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the new head")
+	}
+}
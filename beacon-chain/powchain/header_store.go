@@ -0,0 +1,313 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// maxHeaderStoreSize is 4x of the follow distance. The candidate blocks of an eth1 data vote lie
+	// between 1x and 2x of the follow distance before the start of the voting period, which itself
+	// lies up to a voting period before the head.
+	maxHeaderStoreSize = 4 * params.BeaconConfig().Eth1FollowDistance
+
+	// Metrics
+	headerStoreMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_header_store_miss",
+		Help: "The number of block requests that aren't present in the header store.",
+	})
+	headerStoreHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_header_store_hit",
+		Help: "The number of block requests that are present in the header store.",
+	})
+	headerStoreSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_header_store_size",
+		Help: "The number of headers in the header store",
+	})
+)
+
+// headerStore indexes the headers of the latest blocks of the eth1 chain followed by the service. The
+// headers are contiguous, in block number order, and persisted in the database so that they survive
+// a restart.
+type headerStore struct {
+	db      db.HeadAccessDatabase
+	headers []*protodb.ETH1Header
+	byHash  map[common.Hash]uint64
+	lock    sync.RWMutex
+}
+
+// newHeaderStore creates a header store from the headers saved in the database.
+func newHeaderStore(ctx context.Context, beaconDB db.HeadAccessDatabase) (*headerStore, error) {
+	headers, err := beaconDB.Eth1Headers(ctx, 0, math.MaxUint64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve eth1 headers")
+	}
+	h := &headerStore{
+		db:     beaconDB,
+		byHash: make(map[common.Hash]uint64, len(headers)),
+	}
+	for _, header := range headers {
+		// Only keep the latest contiguous headers, should a previous save have been interrupted.
+		if len(h.headers) > 0 && h.headers[len(h.headers)-1].Number+1 != header.Number {
+			h.headers = h.headers[:0]
+			h.byHash = make(map[common.Hash]uint64)
+		}
+		h.headers = append(h.headers, header)
+		h.byHash[common.BytesToHash(header.Hash)] = header.Number
+	}
+	headerStoreSize.Set(float64(len(h.headers)))
+	return h, nil
+}
+
+// eth1Header converts a header of the eth1 chain into the header of the store.
+func eth1Header(header *gethTypes.Header) *protodb.ETH1Header {
+	return &protodb.ETH1Header{
+		Number:     header.Number.Uint64(),
+		Hash:       header.Hash().Bytes(),
+		ParentHash: header.ParentHash.Bytes(),
+		Timestamp:  header.Time,
+	}
+}
+
+// latest returns the header with the highest block number in the store, nil if the store is empty.
+func (h *headerStore) latest() *protodb.ETH1Header {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	if len(h.headers) == 0 {
+		return nil
+	}
+	return h.headers[len(h.headers)-1]
+}
+
+// headerByNumber returns the header of the block with the given number, nil if it is not in the store.
+func (h *headerStore) headerByNumber(number uint64) *protodb.ETH1Header {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	header := h.headerByNumberUnsafe(number)
+	if header == nil {
+		headerStoreMiss.Inc()
+		return nil
+	}
+	headerStoreHit.Inc()
+	return header
+}
+
+func (h *headerStore) headerByNumberUnsafe(number uint64) *protodb.ETH1Header {
+	if len(h.headers) == 0 || number < h.headers[0].Number || number > h.headers[len(h.headers)-1].Number {
+		return nil
+	}
+	return h.headers[number-h.headers[0].Number]
+}
+
+// headerByHash returns the header of the block with the given hash, nil if it is not in the store.
+func (h *headerStore) headerByHash(hash common.Hash) *protodb.ETH1Header {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	number, ok := h.byHash[hash]
+	if !ok {
+		headerStoreMiss.Inc()
+		return nil
+	}
+	headerStoreHit.Inc()
+	return h.headerByNumberUnsafe(number)
+}
+
+// numberByTimestamp returns the number of the most recent block of the store with a timestamp up to the
+// given time. It returns false when the store holds no block with a timestamp up to the given time, or
+// when the time is at or after the latest stored block, as a newer block may not be stored yet.
+func (h *headerStore) numberByTimestamp(time uint64) (uint64, bool) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	// Block timestamps are strictly increasing with block numbers.
+	idx := sort.Search(len(h.headers), func(i int) bool { return h.headers[i].Timestamp > time })
+	if idx == 0 || idx == len(h.headers) {
+		headerStoreMiss.Inc()
+		return 0, false
+	}
+	headerStoreHit.Inc()
+	return h.headers[idx-1].Number, true
+}
+
+// insert adds contiguous headers, in block number order, to the store. The stored headers from the block
+// number of the first header onwards are replaced, as they belong to blocks orphaned by an eth1 reorg.
+// The stored headers are dropped altogether when the first header does not follow a stored header. Only
+// the latest maxHeaderStoreSize headers are kept.
+func (h *headerStore) insert(ctx context.Context, headers []*protodb.ETH1Header) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	first := headers[0].Number
+	parent := h.headerByNumberUnsafe(first - 1)
+	if first == 0 || parent == nil || !bytes.Equal(parent.Hash, headers[0].ParentHash) {
+		if err := h.db.DeleteEth1Headers(ctx, 0, math.MaxUint64); err != nil {
+			return errors.Wrap(err, "could not delete eth1 headers")
+		}
+		h.headers = []*protodb.ETH1Header{}
+		h.byHash = make(map[common.Hash]uint64)
+	} else {
+		if err := h.db.DeleteEth1Headers(ctx, first, math.MaxUint64); err != nil {
+			return errors.Wrap(err, "could not delete eth1 headers of orphaned blocks")
+		}
+		for _, orphaned := range h.headers[first-h.headers[0].Number:] {
+			delete(h.byHash, common.BytesToHash(orphaned.Hash))
+		}
+		h.headers = h.headers[:first-h.headers[0].Number]
+	}
+	if err := h.db.SaveEth1Headers(ctx, headers); err != nil {
+		return errors.Wrap(err, "could not save eth1 headers")
+	}
+	for _, header := range headers {
+		h.headers = append(h.headers, header)
+		h.byHash[common.BytesToHash(header.Hash)] = header.Number
+	}
+
+	if uint64(len(h.headers)) > maxHeaderStoreSize {
+		pruned := uint64(len(h.headers)) - maxHeaderStoreSize
+		if err := h.db.DeleteEth1Headers(ctx, 0, h.headers[pruned-1].Number); err != nil {
+			return errors.Wrap(err, "could not prune eth1 headers")
+		}
+		for _, header := range h.headers[:pruned] {
+			delete(h.byHash, common.BytesToHash(header.Hash))
+		}
+		h.headers = append([]*protodb.ETH1Header{}, h.headers[pruned:]...)
+	}
+	headerStoreSize.Set(float64(len(h.headers)))
+	return nil
+}
+
+// indexHeader adds an eth1 head to the header store, along with the headers of the blocks between the
+// latest stored header and the head. When the head does not descend from the latest stored header, the
+// headers are fetched back to the common ancestor of the eth1 reorg, and the headers and deposits of the
+// orphaned blocks are rolled back.
+func (s *Service) indexHeader(ctx context.Context, head *gethTypes.Header) error {
+	latest := s.headerStore.latest()
+	followDistance := params.BeaconConfig().Eth1FollowDistance
+	if latest == nil || head.Number.Uint64() > latest.Number+followDistance {
+		return s.headerStore.insert(ctx, []*protodb.ETH1Header{eth1Header(head)})
+	}
+	if stored := s.headerStore.headerByNumber(head.Number.Uint64()); stored != nil && bytes.Equal(stored.Hash, head.Hash().Bytes()) {
+		return nil
+	}
+
+	// Walk back from the head, in reverse block number order, until a header descends from the store.
+	headers := []*protodb.ETH1Header{eth1Header(head)}
+	for {
+		oldest := headers[len(headers)-1]
+		if oldest.Number == 0 {
+			break
+		}
+		parentNumber := oldest.Number - 1
+		if parentNumber <= latest.Number {
+			parent := s.headerStore.headerByNumber(parentNumber)
+			if parent != nil && bytes.Equal(parent.Hash, oldest.ParentHash) {
+				break
+			}
+			if parent == nil || latest.Number-parentNumber >= followDistance {
+				// The deposits of the orphaned blocks cannot be rolled back, start over from the head.
+				if err := s.headerStore.insert(ctx, []*protodb.ETH1Header{eth1Header(head)}); err != nil {
+					return err
+				}
+				return fmt.Errorf("eth1 reorg of more than %d blocks below block %d", latest.Number-parentNumber, latest.Number)
+			}
+		}
+		parent, err := s.blockFetcher.HeaderByNumber(ctx, new(big.Int).SetUint64(parentNumber))
+		if err != nil {
+			return errors.Wrapf(err, "could not fetch header of eth1 block %d", parentNumber)
+		}
+		if parent == nil || !bytes.Equal(parent.Hash().Bytes(), oldest.ParentHash) {
+			return fmt.Errorf("eth1 chain changed while fetching header of block %d", parentNumber)
+		}
+		headers = append(headers, eth1Header(parent))
+	}
+	for i, j := 0, len(headers)-1; i < j; i, j = i+1, j-1 {
+		headers[i], headers[j] = headers[j], headers[i]
+	}
+
+	if err := s.headerStore.insert(ctx, headers); err != nil {
+		return err
+	}
+	// The blocks after the parent of the first fetched header were orphaned.
+	if headers[0].Number == 0 || headers[0].Number > latest.Number {
+		return nil
+	}
+	forkNumber := headers[0].Number - 1
+	log.WithFields(logrus.Fields{
+		"depth":          latest.Number - forkNumber,
+		"commonAncestor": forkNumber,
+		"oldHead":        fmt.Sprintf("%#x", latest.Hash),
+		"newHead":        head.Hash().Hex(),
+	}).Warn("Eth1 chain reorg occurred")
+	return s.rollbackDeposits(ctx, forkNumber)
+}
+
+// rollbackDeposits removes the deposits of the eth1 blocks after the common ancestor of an eth1 reorg from
+// the deposit trie and caches, and requests the deposit contract logs again from the common ancestor.
+func (s *Service) rollbackDeposits(ctx context.Context, forkNumber uint64) error {
+	if forkNumber >= s.latestEth1Data.LastRequestedBlock {
+		return nil
+	}
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+
+	orphaned := len(s.depositCache.AllDeposits(ctx, nil)) - len(s.depositCache.AllDeposits(ctx, new(big.Int).SetUint64(forkNumber)))
+	if orphaned > 0 {
+		if !s.chainStartData.Chainstarted {
+			return errors.New("cannot roll back the deposits of orphaned eth1 blocks before chainstart")
+		}
+		if fd := s.depositCache.FinalizedDeposits(ctx); fd != nil && fd.Eth1BlockHeight > forkNumber {
+			return errors.New("cannot roll back finalized deposits of orphaned eth1 blocks")
+		}
+		s.depositCache.RemoveDepositsAfterHeight(ctx, forkNumber)
+
+		// Rebuild the deposit trie from the remaining deposits.
+		depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+		if err != nil {
+			return errors.Wrap(err, "could not create deposit trie")
+		}
+		lastIndex := int64(-1)
+		if fd := s.depositCache.FinalizedDeposits(ctx); fd != nil {
+			depositTrie = fd.Deposits.Copy()
+			lastIndex = fd.MerkleTrieIndex
+		}
+		for _, ctnr := range s.depositCache.AllDepositContainers(ctx) {
+			depositHash, err := ssz.HashTreeRoot(ctnr.Deposit.Data)
+			if err != nil {
+				return errors.Wrap(err, "could not hash deposit data")
+			}
+			depositTrie.Insert(depositHash[:], int(ctnr.Index))
+			lastIndex = ctnr.Index
+		}
+		s.depositTrie = depositTrie
+		s.lastReceivedMerkleIndex = lastIndex
+		log.WithFields(logrus.Fields{
+			"removedDeposits":  orphaned,
+			"lastDepositIndex": lastIndex,
+		}).Warn("Removed deposits of orphaned eth1 blocks")
+	}
+	s.latestEth1Data.LastRequestedBlock = forkNumber
+	return s.savePowchainData(ctx)
+}
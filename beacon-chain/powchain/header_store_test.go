@@ -0,0 +1,221 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// chainFetcher serves the blocks of a fixed eth1 chain.
+type chainFetcher struct {
+	headers []*gethTypes.Header
+}
+
+func (c *chainFetcher) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	if number == nil {
+		return c.headers[len(c.headers)-1], nil
+	}
+	if number.Uint64() >= uint64(len(c.headers)) {
+		return nil, fmt.Errorf("no block %d", number)
+	}
+	return c.headers[number.Uint64()], nil
+}
+
+func (c *chainFetcher) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	header, err := c.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return gethTypes.NewBlockWithHeader(header), nil
+}
+
+func (c *chainFetcher) BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	for _, header := range c.headers {
+		if header.Hash() == hash {
+			return gethTypes.NewBlockWithHeader(header), nil
+		}
+	}
+	return nil, fmt.Errorf("no block %#x", hash)
+}
+
+// eth1Chain returns the headers of an eth1 chain from the genesis block up to the given head number,
+// which forks off the given chain after the given fork number, with blocks 10 seconds apart.
+func eth1Chain(chain []*gethTypes.Header, forkNumber uint64, headNumber uint64) []*gethTypes.Header {
+	headers := make([]*gethTypes.Header, 0, headNumber+1)
+	for i := uint64(0); i <= headNumber; i++ {
+		if i <= forkNumber && i < uint64(len(chain)) {
+			headers = append(headers, chain[i])
+			continue
+		}
+		header := &gethTypes.Header{
+			Number: new(big.Int).SetUint64(i),
+			Time:   10 * i,
+			Extra:  []byte(fmt.Sprintf("fork %d", forkNumber)),
+		}
+		if i > 0 {
+			header.ParentHash = headers[i-1].Hash()
+		}
+		headers = append(headers, header)
+	}
+	return headers
+}
+
+func storeHeaders(chain []*gethTypes.Header) []*protodb.ETH1Header {
+	headers := make([]*protodb.ETH1Header, len(chain))
+	for i, header := range chain {
+		headers[i] = eth1Header(header)
+	}
+	return headers
+}
+
+func TestHeaderStore_NumberByTimestamp(t *testing.T) {
+	ctx := context.Background()
+	store, err := newHeaderStore(ctx, dbutil.SetupDB(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := eth1Chain(nil, 0, 30)
+	if err := store.insert(ctx, storeHeaders(chain[10:])); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		time   uint64
+		number uint64
+		ok     bool
+	}{
+		{time: 99, ok: false},
+		{time: 100, number: 10, ok: true},
+		{time: 155, number: 15, ok: true},
+		{time: 160, number: 16, ok: true},
+		{time: 299, number: 29, ok: true},
+		// The store may not hold the blocks following the latest stored block.
+		{time: 300, ok: false},
+		{time: 1000, ok: false},
+	}
+	for _, tt := range tests {
+		number, ok := store.numberByTimestamp(tt.time)
+		if ok != tt.ok || number != tt.number {
+			t.Errorf("numberByTimestamp(%d) = (%d, %v), wanted (%d, %v)", tt.time, number, ok, tt.number, tt.ok)
+		}
+	}
+}
+
+func TestHeaderStore_InsertReplacesOrphanedHeaders(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	store, err := newHeaderStore(ctx, beaconDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := eth1Chain(nil, 0, 20)
+	if err := store.insert(ctx, storeHeaders(chain)); err != nil {
+		t.Fatal(err)
+	}
+	fork := eth1Chain(chain, 15, 22)
+	if err := store.insert(ctx, storeHeaders(fork[16:])); err != nil {
+		t.Fatal(err)
+	}
+
+	if store.headerByHash(chain[18].Hash()) != nil {
+		t.Error("Expected orphaned header to be removed from the store")
+	}
+	if header := store.headerByNumber(18); header == nil || header.Number != 18 || common.BytesToHash(header.Hash) != fork[18].Hash() {
+		t.Errorf("Wanted header of the fork at number 18, received %v", header)
+	}
+
+	// The headers are restored from the database.
+	restored, err := newHeaderStore(ctx, beaconDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest := restored.latest(); latest == nil || common.BytesToHash(latest.Hash) != fork[22].Hash() {
+		t.Errorf("Wanted latest header of the fork, received %v", latest)
+	}
+	if header := restored.headerByNumber(10); header == nil || common.BytesToHash(header.Hash) != chain[10].Hash() {
+		t.Errorf("Wanted header of the common chain at number 10, received %v", header)
+	}
+
+	// Headers which do not follow the stored headers replace them altogether.
+	unrelated := eth1Chain(nil, 0, 40)
+	if err := store.insert(ctx, storeHeaders(unrelated[35:])); err != nil {
+		t.Fatal(err)
+	}
+	if store.headerByNumber(20) != nil {
+		t.Error("Expected stored headers to be dropped")
+	}
+}
+
+func TestIndexHeader_RollsBackDepositsOfOrphanedBlocks(t *testing.T) {
+	ctx := context.Background()
+	eth1Data, _ := depositSnapshotOfChainData(t)
+	beaconDB := dbutil.SetupDB(t)
+	depositCache := depositcache.NewDepositCache()
+	web3Service, err := NewService(ctx, &Web3ServiceConfig{
+		HTTPEndPoint: endpoint,
+		BeaconDB:     beaconDB,
+		DepositCache: depositCache,
+	})
+	if err != nil {
+		t.Fatalf("Unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service.depositTrie = trieutil.CreateTrieFromProto(eth1Data.Trie)
+	web3Service.lastReceivedMerkleIndex = 9
+	web3Service.chainStartData = eth1Data.ChainstartData
+	web3Service.latestEth1Data.LastRequestedBlock = 110
+	for _, ctnr := range eth1Data.DepositContainers {
+		depositCache.InsertDeposit(ctx, ctnr.Deposit, ctnr.Eth1BlockHeight, ctnr.Index, [32]byte{})
+	}
+
+	// The deposits are included in blocks 100 to 109.
+	chain := eth1Chain(nil, 0, 110)
+	web3Service.blockFetcher = &chainFetcher{headers: chain}
+	if err := web3Service.headerStore.insert(ctx, storeHeaders(chain[90:])); err != nil {
+		t.Fatal(err)
+	}
+	if err := web3Service.indexHeader(ctx, chain[110]); err != nil {
+		t.Fatal(err)
+	}
+	if len(depositCache.AllDeposits(ctx, nil)) != 10 {
+		t.Fatal("Expected no deposits to be removed for a known head")
+	}
+
+	// Blocks 105 onwards are orphaned.
+	fork := eth1Chain(chain, 104, 112)
+	web3Service.blockFetcher = &chainFetcher{headers: fork}
+	if err := web3Service.indexHeader(ctx, fork[112]); err != nil {
+		t.Fatal(err)
+	}
+
+	if latest := web3Service.headerStore.latest(); latest == nil || common.BytesToHash(latest.Hash) != fork[112].Hash() {
+		t.Errorf("Wanted latest header of the fork, received %v", latest)
+	}
+	if web3Service.headerStore.headerByHash(chain[107].Hash()) != nil {
+		t.Error("Expected orphaned header to be removed from the header store")
+	}
+	if deposits := depositCache.AllDeposits(ctx, nil); len(deposits) != 5 {
+		t.Errorf("Wanted 5 deposits after rollback, received %d", len(deposits))
+	}
+	if web3Service.lastReceivedMerkleIndex != 4 {
+		t.Errorf("Wanted last received merkle index 4, received %d", web3Service.lastReceivedMerkleIndex)
+	}
+	if web3Service.latestEth1Data.LastRequestedBlock != 104 {
+		t.Errorf("Wanted last requested block 104, received %d", web3Service.latestEth1Data.LastRequestedBlock)
+	}
+	wantedTrie, _, err := testutil.DeterministicDepositTrie(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if web3Service.DepositRoot() != wantedTrie.Root() {
+		t.Errorf("Wanted deposit root %#x, received %#x", wantedTrie.Root(), web3Service.DepositRoot())
+	}
+}
@@ -30,7 +30,6 @@ import (
 	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
 )
@@ -123,11 +122,15 @@ type Service struct {
 	headerChan              chan *gethTypes.Header
 	headTicker              *time.Ticker
 	httpEndpoint            string
+	endpoints               []*eth1Endpoint // configured endpoints, the primary endpoint first.
+	currEndpoint            int             // index of the active endpoint in endpoints.
+	eth1ChainID             *big.Int
+	headFetchFailures       int
 	stateNotifier           statefeed.Notifier
 	httpLogger              bind.ContractFilterer
 	blockFetcher            RPCBlockFetcher
 	rpcClient               RPCClient
-	blockCache              *blockCache // cache to store block hash/block height.
+	headerStore             *headerStore // index of the headers of the latest eth1 blocks.
 	latestEth1Data          *protodb.LatestETH1Data
 	depositContractCaller   *contracts.DepositContractCaller
 	depositRoot             []byte
@@ -138,15 +141,19 @@ type Service struct {
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
 	preGenesisState         *stateTrie.BeaconState
+	depositSnapshot         *protodb.DepositSnapshot // snapshot the deposit trie and cache were seeded from.
+	depositSnapshotVerified bool
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
 type Web3ServiceConfig struct {
-	HTTPEndPoint    string
-	DepositContract common.Address
-	BeaconDB        db.HeadAccessDatabase
-	DepositCache    *depositcache.DepositCache
-	StateNotifier   statefeed.Notifier
+	HTTPEndPoint      string
+	FallbackEndPoints []string
+	DepositContract   common.Address
+	BeaconDB          db.HeadAccessDatabase
+	DepositCache      *depositcache.DepositCache
+	StateNotifier     statefeed.Notifier
+	DepositSnapshot   *protodb.DepositSnapshot
 }
 
 // NewService sets up a new instance with an ethclient when
@@ -169,13 +176,13 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		cancel:       cancel,
 		headerChan:   make(chan *gethTypes.Header),
 		httpEndpoint: config.HTTPEndPoint,
+		endpoints:    newEth1Endpoints(config.HTTPEndPoint, config.FallbackEndPoints),
 		latestEth1Data: &protodb.LatestETH1Data{
 			BlockHeight:        0,
 			BlockTime:          0,
 			BlockHash:          []byte{},
 			LastRequestedBlock: 0,
 		},
-		blockCache:             newBlockCache(),
 		depositContractAddress: config.DepositContract,
 		stateNotifier:          config.StateNotifier,
 		depositTrie:            depositTrie,
@@ -189,6 +196,12 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		preGenesisState:         genState,
 		headTicker:              time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
 	}
+	s.setActiveEndpointMetric()
+
+	s.headerStore, err = newHeaderStore(ctx, config.BeaconDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not setup eth1 header store")
+	}
 
 	eth1Data, err := config.BeaconDB.PowchainData(ctx)
 	if err != nil {
@@ -205,9 +218,21 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		}
 		s.latestEth1Data = eth1Data.CurrentEth1Data
 		s.lastReceivedMerkleIndex = int64(len(s.depositTrie.Items()) - 1)
+		if eth1Data.DepositSnapshot != nil {
+			if _, err := s.seedFinalizedDeposits(ctx, eth1Data.DepositSnapshot); err != nil {
+				return nil, errors.Wrap(err, "could not seed finalized deposits")
+			}
+		}
 		if err := s.initDepositCaches(ctx, eth1Data.DepositContainers); err != nil {
 			return nil, errors.Wrap(err, "could not initialize caches")
 		}
+		if config.DepositSnapshot != nil {
+			log.Warn("Ignoring deposit snapshot as the database already contains eth1 data")
+		}
+	} else if config.DepositSnapshot != nil {
+		if err := s.importDepositSnapshot(ctx, config.DepositSnapshot); err != nil {
+			return nil, errors.Wrap(err, "could not import deposit snapshot")
+		}
 	}
 	return s, nil
 }
@@ -305,7 +330,11 @@ func (s *Service) AreAllDepositsProcessed() (bool, error) {
 	}
 	count := bytesutil.FromBytes8(countByte)
 	deposits := s.depositCache.AllDeposits(context.TODO(), nil)
-	if count != uint64(len(deposits)) {
+	processed := uint64(len(deposits))
+	if fd := s.depositCache.FinalizedDeposits(context.TODO()); fd != nil {
+		processed += uint64(fd.MerkleTrieIndex + 1)
+	}
+	if count != processed {
 		return false, nil
 	}
 	return true, nil
@@ -360,9 +389,19 @@ func (s *Service) dialETH1Nodes() (*ethclient.Client, *gethRPC.Client, error) {
 	httpClient := ethclient.NewClient(httpRPCClient)
 
 	// Make a simple call to ensure we are actually connected to a working node.
-	if _, err := httpClient.ChainID(s.ctx); err != nil {
+	chainID, err := httpClient.ChainID(s.ctx)
+	if err != nil {
 		return nil, nil, err
 	}
+	// The chain of the first connected endpoint is the chain all endpoints must follow.
+	if s.eth1ChainID == nil {
+		s.eth1ChainID = chainID
+	} else if chainID.Cmp(s.eth1ChainID) != 0 {
+		return nil, nil, errors.Errorf("endpoint is on chain %d, wanted %d", chainID, s.eth1ChainID)
+	}
+	if s.currEndpoint < len(s.endpoints) {
+		s.endpoints[s.currEndpoint].client = httpClient
+	}
 
 	return httpClient, httpRPCClient, nil
 }
@@ -392,6 +431,8 @@ func (s *Service) waitForConnection() {
 	for {
 		select {
 		case <-ticker.C:
+			// Try the next endpoint, if any, when the active one cannot be reached.
+			s.failover()
 			err := s.connectToPowChain()
 			if err == nil {
 				s.connectedETH1 = true
@@ -439,17 +480,17 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 	currIndex := currentState.Eth1DepositIndex()
 	validDepositsCount.Add(float64(currIndex + 1))
 
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if len(ctrs) > int(currIndex) {
-		for _, c := range ctrs[currIndex:] {
+	// Only add pending deposits which have an index
+	// greater or equal to the current index in state.
+	for _, c := range ctrs {
+		if c.Index >= int64(currIndex) {
 			s.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
 	return nil
 }
 
-// processBlockHeader adds a newly observed eth1 block to the block cache and
+// processBlockHeader adds a newly observed eth1 block to the header store and
 // updates the latest blockHeight, blockHash, and blockTime properties of the service.
 func (s *Service) processBlockHeader(header *gethTypes.Header) {
 	defer safelyHandlePanic()
@@ -462,9 +503,9 @@ func (s *Service) processBlockHeader(header *gethTypes.Header) {
 		"blockHash":   hexutil.Encode(s.latestEth1Data.BlockHash),
 	}).Debug("Latest eth1 chain event")
 
-	if err := s.blockCache.AddBlock(gethTypes.NewBlockWithHeader(header)); err != nil {
+	if err := s.indexHeader(s.ctx, header); err != nil {
 		s.runError = err
-		log.Errorf("Unable to add block data to cache %v", err)
+		log.WithError(err).Error("Unable to index eth1 header")
 	}
 }
 
@@ -499,13 +540,6 @@ func (s *Service) batchRequestHeaders(startBlock uint64, endBlock uint64) ([]*ge
 			return nil, e
 		}
 	}
-	for _, h := range headers {
-		if h != nil {
-			if err := s.blockCache.AddBlock(gethTypes.NewBlockWithHeader(h)); err != nil {
-				return nil, err
-			}
-		}
-	}
 	return headers, nil
 }
 
@@ -524,11 +558,8 @@ func safelyHandlePanic() {
 func (s *Service) handleETH1FollowDistance() {
 	defer safelyHandlePanic()
 
-	// use a 5 minutes timeout for block time, because the max mining time is 278 sec (block 7208027)
-	// (analyzed the time of the block from 2018-09-01 to 2019-02-13)
-	fiveMinutesTimeout := roughtime.Now().Add(-5 * time.Minute)
 	// check that web3 client is syncing
-	if time.Unix(int64(s.latestEth1Data.BlockTime), 0).Before(fiveMinutesTimeout) {
+	if s.isHeadStalled() {
 		log.Warn("eth1 client is not syncing")
 	}
 	if !s.chainStartData.Chainstarted {
@@ -560,6 +591,7 @@ func (s *Service) initPOWService() {
 	retryETH1Node := func(err error) {
 		s.runError = err
 		s.connectedETH1 = false
+		s.failover()
 		s.waitForConnection()
 		// reset value in the event of a successful connection.
 		s.runError = nil
@@ -589,6 +621,12 @@ func (s *Service) initPOWService() {
 			s.latestEth1Data.BlockHash = header.Hash().Bytes()
 			s.latestEth1Data.BlockTime = header.Time
 
+			if err := s.verifyDepositSnapshot(context.Background()); err != nil {
+				log.Errorf("Unable to verify deposit snapshot: %v", err)
+				retryETH1Node(err)
+				continue
+			}
+
 			if err := s.processPastLogs(context.Background()); err != nil {
 				log.Errorf("Unable to process past logs %v", err)
 				retryETH1Node(err)
@@ -606,6 +644,8 @@ func (s *Service) run(done <-chan struct{}) {
 
 	s.initPOWService()
 
+	healthTicker := time.NewTicker(endpointHealthCheckPeriod)
+	defer healthTicker.Stop()
 	for {
 		select {
 		case <-done:
@@ -618,10 +658,33 @@ func (s *Service) run(done <-chan struct{}) {
 			head, err := s.blockFetcher.HeaderByNumber(s.ctx, nil)
 			if err != nil {
 				log.WithError(err).Debug("Could not fetch latest eth1 header")
+				s.headFetchFailures++
+				if s.headFetchFailures >= maxHeadFetchFailures && len(s.endpoints) > 1 {
+					s.headFetchFailures = 0
+					s.failover()
+					s.reconnect(err)
+				}
 				continue
 			}
+			s.headFetchFailures = 0
 			s.processBlockHeader(head)
 			s.handleETH1FollowDistance()
+			// Check the endpoints right away rather than on the next health check when the
+			// active endpoint stalls, so the follow block height keeps advancing.
+			if s.isHeadStalled() && len(s.endpoints) > 1 {
+				s.checkEndpointsHealth(s.ctx)
+				if s.failoverToHealthyEndpoint() {
+					s.reconnect(errors.New("eth1 endpoint stalled"))
+				}
+			}
+		case <-healthTicker.C:
+			if len(s.endpoints) < 2 {
+				continue
+			}
+			s.checkEndpointsHealth(s.ctx)
+			if s.failoverToHealthyEndpoint() {
+				s.reconnect(errors.New("eth1 endpoint unhealthy"))
+			}
 		}
 	}
 }
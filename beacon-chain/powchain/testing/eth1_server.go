@@ -0,0 +1,474 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+var (
+	depositEventSignature = crypto.Keccak256Hash([]byte("DepositEvent(bytes,bytes,bytes,bytes,bytes)"))
+	getDepositCountID     = crypto.Keccak256([]byte("get_deposit_count()"))[:4]
+	getDepositRootID      = crypto.Keccak256([]byte("get_deposit_root()"))[:4]
+	// depositContractCode is returned as the code of the deposit contract, which only needs to be non empty.
+	depositContractCode = hexutil.Bytes{0x60, 0x80, 0x60, 0x40}
+)
+
+// eth1Block is a block of the chain of the eth1 server with the deposits made in it.
+type eth1Block struct {
+	header   *gethTypes.Header
+	deposits []*ethpb.Deposit
+	logs     []*gethTypes.Log
+}
+
+// Eth1Server is an in-process eth1 node which serves the JSON-RPC methods used by the powchain
+// service over HTTP and websockets. It mines empty blocks on demand, emits the deposit logs of a
+// deposit contract, answers the calls to the deposit contract, and performs scripted reorgs, so that
+// the powchain service can be tested through its real client code path.
+type Eth1Server struct {
+	ChainID         *big.Int
+	DepositContract common.Address
+	contractABI     abi.ABI
+	rpcServer       *rpc.Server
+	httpServer      *httptest.Server
+	wsServer        *httptest.Server
+	headFeed        event.Feed
+	blocks          []*eth1Block
+	pending         []*ethpb.Deposit
+	forks           uint64
+	lock            sync.RWMutex
+}
+
+// NewEth1Server starts an eth1 server, with a genesis block of the given timestamp, which serves the
+// deposit contract at the given address. The server must be closed once the test is done.
+func NewEth1Server(depositContract common.Address, genesisTime uint64) (*Eth1Server, error) {
+	contractABI, err := abi.JSON(strings.NewReader(contracts.DepositContractABI))
+	if err != nil {
+		return nil, err
+	}
+	s := &Eth1Server{
+		ChainID:         big.NewInt(1337),
+		DepositContract: depositContract,
+		contractABI:     contractABI,
+		rpcServer:       rpc.NewServer(),
+	}
+	s.blocks = []*eth1Block{{header: s.newHeader(nil, genesisTime)}}
+
+	if err := s.rpcServer.RegisterName("eth", &ethAPI{server: s}); err != nil {
+		return nil, err
+	}
+	if err := s.rpcServer.RegisterName("net", &netAPI{server: s}); err != nil {
+		return nil, err
+	}
+	s.httpServer = httptest.NewServer(s.rpcServer)
+	s.wsServer = httptest.NewServer(s.rpcServer.WebsocketHandler([]string{"*"}))
+	return s, nil
+}
+
+// HTTPEndpoint of the JSON-RPC server.
+func (s *Eth1Server) HTTPEndpoint() string {
+	return s.httpServer.URL
+}
+
+// WSEndpoint of the websocket JSON-RPC server, which supports subscriptions to new heads.
+func (s *Eth1Server) WSEndpoint() string {
+	return "ws" + strings.TrimPrefix(s.wsServer.URL, "http")
+}
+
+// Close stops the HTTP and websocket servers.
+func (s *Eth1Server) Close() {
+	s.httpServer.Close()
+	s.wsServer.Close()
+	s.rpcServer.Stop()
+}
+
+// Head returns the header of the latest block of the chain.
+func (s *Eth1Server) Head() *gethTypes.Header {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.blocks[len(s.blocks)-1].header
+}
+
+// HeaderByNumber returns the header of the block of the chain with the given number, nil if there
+// is no such block.
+func (s *Eth1Server) HeaderByNumber(number uint64) *gethTypes.Header {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if number >= uint64(len(s.blocks)) {
+		return nil
+	}
+	return s.blocks[number].header
+}
+
+// AddDeposit makes a deposit to the deposit contract, which is included in the next mined block.
+func (s *Eth1Server) AddDeposit(deposit *ethpb.Deposit) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pending = append(s.pending, deposit)
+}
+
+// MineBlocks mines the given number of blocks on top of the head, SECONDS_PER_ETH1_BLOCK apart. The
+// first block includes the deposits added since the last mined block. It returns the new head.
+func (s *Eth1Server) MineBlocks(count uint64) (*gethTypes.Header, error) {
+	s.lock.Lock()
+	heads := make([]*gethTypes.Header, 0, count)
+	for i := uint64(0); i < count; i++ {
+		parent := s.blocks[len(s.blocks)-1].header
+		blk := &eth1Block{
+			header:   s.newHeader(parent, parent.Time+params.BeaconConfig().SecondsPerETH1Block),
+			deposits: s.pending,
+		}
+		s.pending = nil
+		depositCount := s.depositCountUnsafe(uint64(len(s.blocks) - 1))
+		for j, deposit := range blk.deposits {
+			depositLog, err := s.depositLog(blk.header, deposit, depositCount+uint64(j), uint(j))
+			if err != nil {
+				s.lock.Unlock()
+				return nil, err
+			}
+			blk.logs = append(blk.logs, depositLog)
+		}
+		s.blocks = append(s.blocks, blk)
+		heads = append(heads, blk.header)
+	}
+	head := s.blocks[len(s.blocks)-1].header
+	s.lock.Unlock()
+
+	// Notify the subscribers outside of the lock, as the feed blocks until they received the heads.
+	for _, header := range heads {
+		s.headFeed.Send(header)
+	}
+	return head, nil
+}
+
+// Reorg orphans the blocks after the given fork number, along with their deposits, and mines the
+// given number of blocks of a new fork on top of the block with the fork number. It returns the
+// new head.
+func (s *Eth1Server) Reorg(forkNumber uint64, count uint64) (*gethTypes.Header, error) {
+	s.lock.Lock()
+	if forkNumber >= uint64(len(s.blocks)) {
+		s.lock.Unlock()
+		return nil, fmt.Errorf("no block %d to fork from", forkNumber)
+	}
+	s.blocks = s.blocks[:forkNumber+1]
+	s.pending = nil
+	// The extra data of the blocks of the fork differs, so that the blocks get new hashes.
+	s.forks++
+	s.lock.Unlock()
+
+	return s.MineBlocks(count)
+}
+
+// newHeader returns the header of an empty block on top of the given parent.
+func (s *Eth1Server) newHeader(parent *gethTypes.Header, time uint64) *gethTypes.Header {
+	header := &gethTypes.Header{
+		UncleHash:   gethTypes.EmptyUncleHash,
+		TxHash:      gethTypes.EmptyRootHash,
+		ReceiptHash: gethTypes.EmptyRootHash,
+		Difficulty:  big.NewInt(1),
+		Number:      big.NewInt(0),
+		GasLimit:    8000000,
+		Time:        time,
+		Extra:       bytesutil.Bytes8(s.forks),
+	}
+	if parent != nil {
+		header.ParentHash = parent.Hash()
+		header.Number = new(big.Int).Add(parent.Number, big.NewInt(1))
+	}
+	return header
+}
+
+// depositLog returns the log emitted by the deposit contract for the deposit with the given index.
+func (s *Eth1Server) depositLog(header *gethTypes.Header, deposit *ethpb.Deposit, index uint64, logIndex uint) (*gethTypes.Log, error) {
+	data, err := s.contractABI.Events["DepositEvent"].Inputs.Pack(
+		deposit.Data.PublicKey,
+		deposit.Data.WithdrawalCredentials,
+		bytesutil.Bytes8(deposit.Data.Amount),
+		deposit.Data.Signature,
+		bytesutil.Bytes8(index),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &gethTypes.Log{
+		Address:     s.DepositContract,
+		Topics:      []common.Hash{depositEventSignature},
+		Data:        data,
+		BlockNumber: header.Number.Uint64(),
+		TxHash:      crypto.Keccak256Hash(header.Hash().Bytes(), bytesutil.Bytes8(index)),
+		TxIndex:     logIndex,
+		BlockHash:   header.Hash(),
+		Index:       logIndex,
+	}, nil
+}
+
+// depositCountUnsafe returns the number of deposits made up to the block with the given number.
+func (s *Eth1Server) depositCountUnsafe(number uint64) uint64 {
+	count := uint64(0)
+	for _, blk := range s.blocks[:number+1] {
+		count += uint64(len(blk.deposits))
+	}
+	return count
+}
+
+// depositRootUnsafe returns the root of the deposit contract after the block with the given number.
+func (s *Eth1Server) depositRootUnsafe(number uint64) ([32]byte, error) {
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	index := 0
+	for _, blk := range s.blocks[:number+1] {
+		for _, deposit := range blk.deposits {
+			depositHash, err := ssz.HashTreeRoot(deposit.Data)
+			if err != nil {
+				return [32]byte{}, err
+			}
+			depositTrie.Insert(depositHash[:], index)
+			index++
+		}
+	}
+	return depositTrie.HashTreeRoot(), nil
+}
+
+// blockUnsafe returns the block of the chain with the given number, nil if there is no such block.
+func (s *Eth1Server) blockUnsafe(number rpc.BlockNumber) *eth1Block {
+	if number == rpc.LatestBlockNumber || number == rpc.PendingBlockNumber {
+		return s.blocks[len(s.blocks)-1]
+	}
+	if number < 0 || int(number) >= len(s.blocks) {
+		return nil
+	}
+	return s.blocks[number]
+}
+
+// blockJSON encodes a block without transactions nor uncles, as returned by eth_getBlockByNumber.
+func blockJSON(header *gethTypes.Header) (map[string]interface{}, error) {
+	enc, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(enc, &fields); err != nil {
+		return nil, err
+	}
+	fields["transactions"] = []interface{}{}
+	fields["uncles"] = []interface{}{}
+	return fields, nil
+}
+
+// ethAPI serves the eth namespace of the JSON-RPC API.
+type ethAPI struct {
+	server *Eth1Server
+}
+
+// ChainId serves eth_chainId.
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.server.ChainID)
+}
+
+// BlockNumber serves eth_blockNumber.
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.server.Head().Number.Uint64())
+}
+
+// GetBlockByNumber serves eth_getBlockByNumber.
+func (api *ethAPI) GetBlockByNumber(number rpc.BlockNumber, _ bool) (map[string]interface{}, error) {
+	api.server.lock.RLock()
+	defer api.server.lock.RUnlock()
+
+	blk := api.server.blockUnsafe(number)
+	if blk == nil {
+		return nil, nil
+	}
+	return blockJSON(blk.header)
+}
+
+// GetBlockByHash serves eth_getBlockByHash.
+func (api *ethAPI) GetBlockByHash(hash common.Hash, _ bool) (map[string]interface{}, error) {
+	api.server.lock.RLock()
+	defer api.server.lock.RUnlock()
+
+	for _, blk := range api.server.blocks {
+		if blk.header.Hash() == hash {
+			return blockJSON(blk.header)
+		}
+	}
+	return nil, nil
+}
+
+// filterQuery is the argument of eth_getLogs.
+type filterQuery struct {
+	BlockHash *common.Hash     `json:"blockHash"`
+	FromBlock *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber `json:"toBlock"`
+	Addresses []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+}
+
+// GetLogs serves eth_getLogs.
+func (api *ethAPI) GetLogs(query filterQuery) ([]*gethTypes.Log, error) {
+	api.server.lock.RLock()
+	defer api.server.lock.RUnlock()
+
+	blocks := api.server.blocks
+	if query.BlockHash != nil {
+		blocks = nil
+		for _, blk := range api.server.blocks {
+			if blk.header.Hash() == *query.BlockHash {
+				blocks = []*eth1Block{blk}
+			}
+		}
+	} else {
+		from, to := rpc.BlockNumber(0), rpc.LatestBlockNumber
+		if query.FromBlock != nil {
+			from = *query.FromBlock
+		}
+		if query.ToBlock != nil {
+			to = *query.ToBlock
+		}
+		fromBlk, toBlk := api.server.blockUnsafe(from), api.server.blockUnsafe(to)
+		if fromBlk == nil || toBlk == nil || fromBlk.header.Number.Cmp(toBlk.header.Number) > 0 {
+			return []*gethTypes.Log{}, nil
+		}
+		blocks = blocks[fromBlk.header.Number.Uint64() : toBlk.header.Number.Uint64()+1]
+	}
+
+	logs := make([]*gethTypes.Log, 0)
+	for _, blk := range blocks {
+		for _, l := range blk.logs {
+			if matchesFilter(l, query.Addresses, query.Topics) {
+				logs = append(logs, l)
+			}
+		}
+	}
+	return logs, nil
+}
+
+// matchesFilter returns true if the log was emitted by one of the addresses, if any, and has the
+// topics of the filter, where an empty list of topics matches any topic.
+func matchesFilter(l *gethTypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			found = found || address == l.Address
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(topics) > len(l.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		found := len(sub) == 0
+		for _, topic := range sub {
+			found = found || topic == l.Topics[i]
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// callArgs is the argument of eth_call.
+type callArgs struct {
+	To   *common.Address `json:"to"`
+	Data hexutil.Bytes   `json:"data"`
+}
+
+// Call serves eth_call for the get_deposit_count and get_deposit_root methods of the deposit contract.
+func (api *ethAPI) Call(args callArgs, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	api.server.lock.RLock()
+	defer api.server.lock.RUnlock()
+
+	if args.To == nil || *args.To != api.server.DepositContract || len(args.Data) < 4 {
+		return hexutil.Bytes{}, nil
+	}
+	blk := api.server.blockUnsafe(number)
+	if blk == nil {
+		return nil, fmt.Errorf("no block %d", number)
+	}
+	blkNumber := blk.header.Number.Uint64()
+	switch {
+	case string(args.Data[:4]) == string(getDepositCountID):
+		count := api.server.depositCountUnsafe(blkNumber)
+		return api.server.contractABI.Methods["get_deposit_count"].Outputs.Pack(bytesutil.Bytes8(count))
+	case string(args.Data[:4]) == string(getDepositRootID):
+		root, err := api.server.depositRootUnsafe(blkNumber)
+		if err != nil {
+			return nil, err
+		}
+		return api.server.contractABI.Methods["get_deposit_root"].Outputs.Pack(root)
+	default:
+		return nil, errors.New("execution reverted")
+	}
+}
+
+// GetCode serves eth_getCode.
+func (api *ethAPI) GetCode(address common.Address, _ rpc.BlockNumber) hexutil.Bytes {
+	if address == api.server.DepositContract {
+		return depositContractCode
+	}
+	return hexutil.Bytes{}
+}
+
+// NewHeads serves the newHeads subscription of eth_subscribe.
+func (api *ethAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+	heads := make(chan *gethTypes.Header, 16)
+	headSub := api.server.headFeed.Subscribe(heads)
+	go func() {
+		defer headSub.Unsubscribe()
+		for {
+			select {
+			case header := <-heads:
+				if err := notifier.Notify(rpcSub.ID, header); err != nil {
+					return
+				}
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// netAPI serves the net namespace of the JSON-RPC API.
+type netAPI struct {
+	server *Eth1Server
+}
+
+// Version serves net_version.
+func (api *netAPI) Version() string {
+	return api.server.ChainID.String()
+}
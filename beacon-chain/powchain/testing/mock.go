@@ -78,7 +78,17 @@ func (m *POWChain) BlockTimeByHeight(_ context.Context, height *big.Int) (uint64
 
 // BlockNumberByTimestamp --
 func (m *POWChain) BlockNumberByTimestamp(_ context.Context, time uint64) (*big.Int, error) {
-	return m.BlockNumberByHeight[time], nil
+	if number, ok := m.BlockNumberByHeight[time]; ok {
+		return number, nil
+	}
+	// Fall back to the most recent block up to the timestamp.
+	var number *big.Int
+	for h, t := range m.TimesByHeight {
+		if t <= time && (number == nil || int64(h) > number.Int64()) {
+			number = big.NewInt(int64(h))
+		}
+	}
+	return number, nil
 }
 
 // DepositRoot --
@@ -0,0 +1,15 @@
+package debug
+
+import (
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+)
+
+func TestMain(m *testing.M) {
+	flags.Init(&flags.GlobalFlags{
+		MaxPageSize: 250,
+	})
+	os.Exit(m.Run())
+}
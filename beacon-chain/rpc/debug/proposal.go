@@ -0,0 +1,231 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSimulationEpochs is the number of epochs after the parent block up to which a block proposal
+// can be simulated.
+const maxSimulationEpochs = 2
+
+// BlockBuilder builds the unsigned block the node would propose at a slot on top of a parent block.
+type BlockBuilder interface {
+	BuildBlock(
+		ctx context.Context,
+		slot uint64,
+		parentRoot [32]byte,
+		parentState *stateTrie.BeaconState,
+		randaoReveal []byte,
+		graffiti []byte,
+	) (*ethpb.BeaconBlock, error)
+}
+
+// SimulateBlockProposal builds the block the beacon node would propose at the requested slot on top
+// of the requested parent block, and processes it to report its state root, the proposer reward and
+// the coverage of its attestations. The block is neither signed nor broadcast, and the signatures of
+// the block are not verified when processing it.
+func (ds *Server) SimulateBlockProposal(
+	ctx context.Context,
+	req *pbrpc.SimulateBlockProposalRequest,
+) (*pbrpc.SimulatedBlockProposal, error) {
+	parentRoot := bytesutil.ToBytes32(req.ParentRoot)
+	var parentState *stateTrie.BeaconState
+	var err error
+	if featureconfig.Get().NewStateMgmt {
+		parentState, err = ds.StateGen.StateByRoot(ctx, parentRoot)
+	} else {
+		parentState, err = ds.BeaconDB.State(ctx, parentRoot)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve parent state: %v", err)
+	}
+	if parentState == nil {
+		return nil, status.Errorf(codes.NotFound, "No state found for parent block root %#x", parentRoot)
+	}
+	if req.Slot <= parentState.Slot() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot simulate a block proposal at slot %d, which is not after the parent slot %d",
+			req.Slot,
+			parentState.Slot(),
+		)
+	}
+	// Processing the skipped slots up to the requested slot is bounded.
+	if maxSlot := parentState.Slot() + maxSimulationEpochs*params.BeaconConfig().SlotsPerEpoch; req.Slot > maxSlot {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot simulate a block proposal at slot %d, which is more than %d epochs after the parent slot %d",
+			req.Slot,
+			maxSimulationEpochs,
+			parentState.Slot(),
+		)
+	}
+
+	randaoReveal := req.RandaoReveal
+	if len(randaoReveal) == 0 {
+		randaoReveal = make([]byte, 96)
+	}
+	blk, err := ds.BlockBuilder.BuildBlock(ctx, req.Slot, parentRoot, parentState, randaoReveal, req.Graffiti)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not build block: %v", err)
+	}
+
+	preState, err := state.ProcessSlots(ctx, parentState.Copy(), req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process slots: %v", err)
+	}
+	attesting, newAttesters, committeeValidators, err := attestationCoverage(preState, blk.Body.Attestations)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute attestation coverage: %v", err)
+	}
+	postState, err := state.ProcessBlockForStateRoot(ctx, preState.Copy(), &ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process block: %v", err)
+	}
+	proposerReward, err := blockProposerReward(preState, postState, blk, newAttesters)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute proposer reward: %v", err)
+	}
+	stateRoot, err := postState.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	blk.StateRoot = stateRoot[:]
+	encoded, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal block: %v", err)
+	}
+
+	return &pbrpc.SimulatedBlockProposal{
+		EncodedBlock:           encoded,
+		StateRoot:              stateRoot[:],
+		ProposerIndex:          blk.ProposerIndex,
+		ProposerReward:         proposerReward,
+		AttestingValidators:    attesting,
+		NewAttestingValidators: uint64(len(newAttesters)),
+		CommitteeValidators:    committeeValidators,
+	}, nil
+}
+
+// blockProposerReward computes the reward of the proposer for the block, given the states before
+// and after processing the block and the validators whose attestation is first included by the
+// block. The proposer is credited at the end of the epoch with a share of the base reward of
+// every newly included attester which is not slashed, and with the whistleblower reward of every
+// validator the block slashes.
+func blockProposerReward(
+	preState *stateTrie.BeaconState,
+	postState *stateTrie.BeaconState,
+	blk *ethpb.BeaconBlock,
+	newAttesters []uint64,
+) (uint64, error) {
+	cfg := params.BeaconConfig()
+	totalBalance, err := helpers.TotalActiveBalance(preState)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get total active balance")
+	}
+	reward := uint64(0)
+	for _, idx := range newAttesters {
+		val, err := postState.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return 0, err
+		}
+		if val.Slashed() {
+			continue
+		}
+		baseReward := val.EffectiveBalance() * cfg.BaseRewardFactor / mathutil.IntegerSquareRoot(totalBalance) / cfg.BaseRewardsPerEpoch
+		reward += baseReward / cfg.ProposerRewardQuotient
+	}
+
+	// In phase 0, the proposer is the whistleblower and receives the full whistleblower reward.
+	slashable := make([]uint64, 0)
+	for _, slashing := range blk.Body.ProposerSlashings {
+		slashable = append(slashable, slashing.Header_1.Header.ProposerIndex)
+	}
+	for _, slashing := range blk.Body.AttesterSlashings {
+		slashable = append(slashable, slashing.Attestation_1.AttestingIndices...)
+	}
+	seen := make(map[uint64]bool)
+	for _, idx := range slashable {
+		if seen[idx] {
+			continue
+		}
+		seen[idx] = true
+		preVal, err := preState.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return 0, err
+		}
+		postVal, err := postState.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return 0, err
+		}
+		if !preVal.Slashed() && postVal.Slashed() {
+			reward += preVal.EffectiveBalance() / cfg.WhistleBlowerRewardQuotient
+		}
+	}
+	return reward, nil
+}
+
+// attestationCoverage counts the distinct validators attesting in the attestations, and the
+// validators of the committees of the attestations. It returns the validators whose attestation for
+// the same target epoch is not yet included in the state.
+func attestationCoverage(beaconState *stateTrie.BeaconState, atts []*ethpb.Attestation) (uint64, []uint64, uint64, error) {
+	// Validators are keyed by the target epoch they attest to.
+	included := make(map[[2]uint64]bool)
+	pending := append(beaconState.PreviousEpochAttestations(), beaconState.CurrentEpochAttestations()...)
+	for _, att := range pending {
+		committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return 0, nil, 0, errors.Wrap(err, "could not get committee of included attestation")
+		}
+		indices, err := helpers.AttestingIndices(att.AggregationBits, committee)
+		if err != nil {
+			return 0, nil, 0, errors.Wrap(err, "could not get attesting indices of included attestation")
+		}
+		for _, idx := range indices {
+			included[[2]uint64{att.Data.Target.Epoch, idx}] = true
+		}
+	}
+
+	attesting := make(map[[2]uint64]bool)
+	committees := make(map[[2]uint64]bool)
+	newAttesters := make([]uint64, 0)
+	committeeValidators := uint64(0)
+	for _, att := range atts {
+		committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return 0, nil, 0, errors.Wrap(err, "could not get committee of attestation")
+		}
+		if key := [2]uint64{att.Data.Slot, att.Data.CommitteeIndex}; !committees[key] {
+			committees[key] = true
+			committeeValidators += uint64(len(committee))
+		}
+		indices, err := helpers.AttestingIndices(att.AggregationBits, committee)
+		if err != nil {
+			return 0, nil, 0, errors.Wrap(err, "could not get attesting indices of attestation")
+		}
+		for _, idx := range indices {
+			key := [2]uint64{att.Data.Target.Epoch, idx}
+			if attesting[key] {
+				continue
+			}
+			attesting[key] = true
+			if !included[key] {
+				newAttesters = append(newAttesters, idx)
+			}
+		}
+	}
+	return uint64(len(attesting)), newAttesters, committeeValidators, nil
+}
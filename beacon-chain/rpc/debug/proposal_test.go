@@ -0,0 +1,183 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// fullBlockBuilder builds blocks with the operations of the config from the keys of the validators.
+type fullBlockBuilder struct {
+	privKeys []*bls.SecretKey
+	conf     *testutil.BlockGenConfig
+}
+
+func (f *fullBlockBuilder) BuildBlock(
+	_ context.Context,
+	slot uint64,
+	_ [32]byte,
+	parentState *stateTrie.BeaconState,
+	_ []byte,
+	_ []byte,
+) (*ethpb.BeaconBlock, error) {
+	blk, err := testutil.GenerateFullBlock(parentState, f.privKeys, f.conf, slot)
+	if err != nil {
+		return nil, err
+	}
+	return blk.Block, nil
+}
+
+// setupGenesisParent saves a genesis block and state, and returns the state, the validator keys and
+// the block root.
+func setupGenesisParent(t *testing.T, beaconDB db.Database) (*stateTrie.BeaconState, []*bls.SecretKey, [32]byte) {
+	ctx := context.Background()
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := genesisState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := blocks.NewGenesisBlock(stateRoot[:])
+	if err := beaconDB.SaveBlock(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := stateutil.BlockRoot(genesis.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveState(ctx, genesisState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	return genesisState, privKeys, genesisRoot
+}
+
+func TestServer_SimulateBlockProposal(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	genesisState, privKeys, genesisRoot := setupGenesisParent(t, db)
+	ds := &Server{
+		BeaconDB:     db,
+		BlockBuilder: &fullBlockBuilder{privKeys: privKeys, conf: &testutil.BlockGenConfig{NumAttestations: 1}},
+	}
+
+	res, err := ds.SimulateBlockProposal(ctx, &pbrpc.SimulateBlockProposalRequest{
+		Slot:       1,
+		ParentRoot: genesisRoot[:],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := &ethpb.BeaconBlock{}
+	if err := blk.UnmarshalSSZ(res.EncodedBlock); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(blk.StateRoot, res.StateRoot) {
+		t.Errorf("Wanted block state root %#x, received %#x", res.StateRoot, blk.StateRoot)
+	}
+	wantedRoot, err := state.CalculateStateRoot(ctx, genesisState, &ethpb.SignedBeaconBlock{Block: blk})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(wantedRoot[:], res.StateRoot) {
+		t.Errorf("Wanted state root %#x, received %#x", wantedRoot, res.StateRoot)
+	}
+	if res.ProposerIndex != blk.ProposerIndex {
+		t.Errorf("Wanted proposer index %d, received %d", blk.ProposerIndex, res.ProposerIndex)
+	}
+	if res.AttestingValidators == 0 {
+		t.Error("Expected the attestation of the block to cover validators")
+	}
+	if res.NewAttestingValidators != res.AttestingValidators {
+		t.Errorf("Wanted %d new attesting validators, received %d", res.AttestingValidators, res.NewAttestingValidators)
+	}
+	if res.CommitteeValidators < res.AttestingValidators {
+		t.Errorf("Committee validators %d less than attesting validators %d", res.CommitteeValidators, res.AttestingValidators)
+	}
+	// The deterministic validators have the same base reward.
+	baseReward, err := epoch.BaseReward(genesisState, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantedReward := res.NewAttestingValidators * (baseReward / params.BeaconConfig().ProposerRewardQuotient)
+	if wantedReward == 0 || res.ProposerReward != wantedReward {
+		t.Errorf("Wanted proposer reward %d, received %d", wantedReward, res.ProposerReward)
+	}
+}
+
+func TestServer_SimulateBlockProposal_WhistleblowerReward(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	_, privKeys, genesisRoot := setupGenesisParent(t, db)
+	ds := &Server{
+		BeaconDB:     db,
+		BlockBuilder: &fullBlockBuilder{privKeys: privKeys, conf: &testutil.BlockGenConfig{NumProposerSlashings: 1}},
+	}
+
+	res, err := ds.SimulateBlockProposal(ctx, &pbrpc.SimulateBlockProposalRequest{
+		Slot:       1,
+		ParentRoot: genesisRoot[:],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantedReward := params.BeaconConfig().MaxEffectiveBalance / params.BeaconConfig().WhistleBlowerRewardQuotient
+	if res.ProposerReward != wantedReward {
+		t.Errorf("Wanted proposer reward %d, received %d", wantedReward, res.ProposerReward)
+	}
+}
+
+func TestServer_SimulateBlockProposal_SlotTooFarAfterParent(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	_, privKeys, genesisRoot := setupGenesisParent(t, db)
+	ds := &Server{
+		BeaconDB:     db,
+		BlockBuilder: &fullBlockBuilder{privKeys: privKeys, conf: &testutil.BlockGenConfig{}},
+	}
+
+	_, err := ds.SimulateBlockProposal(ctx, &pbrpc.SimulateBlockProposalRequest{
+		Slot:       maxSimulationEpochs*params.BeaconConfig().SlotsPerEpoch + 1,
+		ParentRoot: genesisRoot[:],
+	})
+	if err == nil || !strings.Contains(err.Error(), "epochs after the parent slot") {
+		t.Errorf("Expected error for a slot too far after the parent slot, received %v", err)
+	}
+}
+
+func TestServer_SimulateBlockProposal_SlotNotAfterParent(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	st := testutil.NewBeaconState()
+	if err := st.SetSlot(10); err != nil {
+		t.Fatal(err)
+	}
+	root := [32]byte{'a'}
+	if err := db.SaveState(ctx, st, root); err != nil {
+		t.Fatal(err)
+	}
+	ds := &Server{
+		BeaconDB:     db,
+		BlockBuilder: &fullBlockBuilder{},
+	}
+
+	_, err := ds.SimulateBlockProposal(ctx, &pbrpc.SimulateBlockProposalRequest{
+		Slot:       10,
+		ParentRoot: root[:],
+	})
+	if err == nil || !strings.Contains(err.Error(), "not after the parent slot") {
+		t.Errorf("Expected error for a slot which is not after the parent slot, received %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package debug
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListReorgs retrieves the chain reorgs recorded by the beacon node which occurred between
+// the requested start and end slots, in slot order.
+func (ds *Server) ListReorgs(ctx context.Context, req *pbrpc.ListReorgsRequest) (*pbrpc.ListReorgsResponse, error) {
+	if int(req.PageSize) > flags.Get().MaxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, flags.Get().MaxPageSize)
+	}
+	endSlot := req.EndSlot
+	if endSlot == 0 {
+		endSlot = math.MaxUint64
+	}
+	if req.StartSlot > endSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "Start slot %d is after end slot %d", req.StartSlot, endSlot)
+	}
+
+	reorgs, err := ds.BeaconDB.Reorgs(ctx, req.StartSlot, endSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve reorgs: %v", err)
+	}
+	// If there are no reorgs, we simply return a response specifying this.
+	// Otherwise, attempting to paginate 0 reorgs below would result in an error.
+	if len(reorgs) == 0 {
+		return &pbrpc.ListReorgsResponse{
+			Reorgs:        make([]*pbrpc.Reorg, 0),
+			TotalSize:     int32(0),
+			NextPageToken: strconv.Itoa(0),
+		}, nil
+	}
+
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(reorgs))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+	res := make([]*pbrpc.Reorg, 0, end-start)
+	for _, r := range reorgs[start:end] {
+		res = append(res, &pbrpc.Reorg{
+			Slot:               r.Slot,
+			OldHeadRoot:        r.OldHeadRoot,
+			OldHeadSlot:        r.OldHeadSlot,
+			NewHeadRoot:        r.NewHeadRoot,
+			NewHeadSlot:        r.NewHeadSlot,
+			CommonAncestorRoot: r.CommonAncestorRoot,
+			CommonAncestorSlot: r.CommonAncestorSlot,
+			Depth:              r.Depth,
+			NewBranchWeight:    r.NewBranchWeight,
+			OldBranchWeight:    r.OldBranchWeight,
+		})
+	}
+	return &pbrpc.ListReorgsResponse{
+		Reorgs:        res,
+		TotalSize:     int32(len(reorgs)),
+		NextPageToken: nextPageToken,
+	}, nil
+}
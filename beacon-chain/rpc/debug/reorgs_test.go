@@ -0,0 +1,61 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+func TestServer_ListReorgs(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	for slot := uint64(1); slot <= 5; slot++ {
+		if err := db.SaveReorg(ctx, &dbpb.Reorg{
+			Slot:        slot * 10,
+			NewHeadRoot: []byte{byte(slot)},
+			OldHeadRoot: []byte{'o'},
+			Depth:       slot,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ds := &Server{BeaconDB: db}
+
+	res, err := ds.ListReorgs(ctx, &pbrpc.ListReorgsRequest{StartSlot: 20, PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalSize != 4 {
+		t.Errorf("Wanted total size 4, got %d", res.TotalSize)
+	}
+	if len(res.Reorgs) != 2 || res.Reorgs[0].Slot != 20 || res.Reorgs[1].Slot != 30 {
+		t.Fatalf("Unexpected first page %v", res.Reorgs)
+	}
+	if !bytes.Equal(res.Reorgs[0].OldHeadRoot, []byte{'o'}) || res.Reorgs[0].Depth != 2 {
+		t.Errorf("Unexpected reorg %v", res.Reorgs[0])
+	}
+
+	res, err = ds.ListReorgs(ctx, &pbrpc.ListReorgsRequest{StartSlot: 20, PageSize: 2, PageToken: res.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Reorgs) != 2 || res.Reorgs[0].Slot != 40 || res.Reorgs[1].Slot != 50 {
+		t.Errorf("Unexpected second page %v", res.Reorgs)
+	}
+
+	res, err = ds.ListReorgs(ctx, &pbrpc.ListReorgsRequest{StartSlot: 11, EndSlot: 19})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Reorgs) != 0 || res.TotalSize != 0 {
+		t.Errorf("Wanted no reorgs, got %v", res.Reorgs)
+	}
+
+	if _, err := ds.ListReorgs(ctx, &pbrpc.ListReorgsRequest{StartSlot: 20, EndSlot: 10}); err == nil {
+		t.Error("Wanted error for start slot after end slot")
+	}
+}
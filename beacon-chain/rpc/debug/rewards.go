@@ -0,0 +1,84 @@
+package debug
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListValidatorRewards retrieves the breakdown of the rewards and penalties validators received in
+// the epoch transition at the end of the requested epoch, as archived by the beacon node. The rewards
+// of all validators are returned unless specific validator indices are requested.
+func (ds *Server) ListValidatorRewards(
+	ctx context.Context,
+	req *pbrpc.ListValidatorRewardsRequest,
+) (*pbrpc.ListValidatorRewardsResponse, error) {
+	if int(req.PageSize) > flags.Get().MaxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, flags.Get().MaxPageSize)
+	}
+
+	archived, err := ds.BeaconDB.ArchivedValidatorRewards(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validator rewards: %v", err)
+	}
+	if archived == nil {
+		return nil, status.Errorf(codes.NotFound, "No validator rewards archived for epoch %d", req.Epoch)
+	}
+
+	numValidators := uint64(len(archived.SourceReward))
+	indices := req.Indices
+	if len(indices) == 0 {
+		indices = make([]uint64, numValidators)
+		for i := range indices {
+			indices[i] = uint64(i)
+		}
+	}
+	for _, idx := range indices {
+		if idx >= numValidators {
+			return nil, status.Errorf(codes.InvalidArgument, "Validator index %d >= validator count %d", idx, numValidators)
+		}
+	}
+	// If there are no validators, we simply return a response specifying this.
+	// Otherwise, attempting to paginate 0 validators below would result in an error.
+	if len(indices) == 0 {
+		return &pbrpc.ListValidatorRewardsResponse{
+			Epoch:         req.Epoch,
+			Rewards:       make([]*pbrpc.ValidatorRewards, 0),
+			TotalSize:     int32(0),
+			NextPageToken: strconv.Itoa(0),
+		}, nil
+	}
+
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(indices))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+	res := make([]*pbrpc.ValidatorRewards, 0, end-start)
+	for _, idx := range indices[start:end] {
+		res = append(res, &pbrpc.ValidatorRewards{
+			ValidatorIndex:       idx,
+			SourceReward:         archived.SourceReward[idx],
+			SourcePenalty:        archived.SourcePenalty[idx],
+			TargetReward:         archived.TargetReward[idx],
+			TargetPenalty:        archived.TargetPenalty[idx],
+			HeadReward:           archived.HeadReward[idx],
+			HeadPenalty:          archived.HeadPenalty[idx],
+			InclusionDelayReward: archived.InclusionDelayReward[idx],
+			ProposerReward:       archived.ProposerReward[idx],
+			InactivityPenalty:    archived.InactivityPenalty[idx],
+			SlashingPenalty:      archived.SlashingPenalty[idx],
+		})
+	}
+	return &pbrpc.ListValidatorRewardsResponse{
+		Epoch:         req.Epoch,
+		Rewards:       res,
+		TotalSize:     int32(len(indices)),
+		NextPageToken: nextPageToken,
+	}, nil
+}
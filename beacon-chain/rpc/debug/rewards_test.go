@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+func TestServer_ListValidatorRewards(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	count := 5
+	archived := &pb.ArchivedValidatorRewards{
+		SourceReward:         make([]uint64, count),
+		SourcePenalty:        make([]uint64, count),
+		TargetReward:         make([]uint64, count),
+		TargetPenalty:        make([]uint64, count),
+		HeadReward:           make([]uint64, count),
+		HeadPenalty:          make([]uint64, count),
+		InclusionDelayReward: make([]uint64, count),
+		ProposerReward:       make([]uint64, count),
+		InactivityPenalty:    make([]uint64, count),
+		SlashingPenalty:      make([]uint64, count),
+	}
+	for i := 0; i < count; i++ {
+		archived.SourceReward[i] = uint64(i) * 10
+		archived.ProposerReward[i] = uint64(i) * 100
+		archived.SlashingPenalty[i] = uint64(i) * 1000
+	}
+	if err := db.SaveArchivedValidatorRewards(ctx, 3, archived); err != nil {
+		t.Fatal(err)
+	}
+	ds := &Server{BeaconDB: db}
+
+	res, err := ds.ListValidatorRewards(ctx, &pbrpc.ListValidatorRewardsRequest{Epoch: 3, PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalSize != int32(count) {
+		t.Errorf("Wanted total size %d, got %d", count, res.TotalSize)
+	}
+	if len(res.Rewards) != 2 || res.Rewards[1].ValidatorIndex != 1 {
+		t.Fatalf("Unexpected first page %v", res.Rewards)
+	}
+	if res.Rewards[1].SourceReward != 10 || res.Rewards[1].ProposerReward != 100 || res.Rewards[1].SlashingPenalty != 1000 {
+		t.Errorf("Unexpected validator rewards %v", res.Rewards[1])
+	}
+
+	res, err = ds.ListValidatorRewards(ctx, &pbrpc.ListValidatorRewardsRequest{Epoch: 3, Indices: []uint64{4, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Rewards) != 2 || res.Rewards[0].ValidatorIndex != 4 || res.Rewards[0].SourceReward != 40 {
+		t.Errorf("Unexpected rewards for requested indices %v", res.Rewards)
+	}
+
+	if _, err := ds.ListValidatorRewards(ctx, &pbrpc.ListValidatorRewardsRequest{Epoch: 3, Indices: []uint64{5}}); err == nil {
+		t.Error("Wanted error for out of range validator index")
+	}
+	if _, err := ds.ListValidatorRewards(ctx, &pbrpc.ListValidatorRewardsRequest{Epoch: 4}); err == nil {
+		t.Error("Wanted error for epoch without archived rewards")
+	}
+}
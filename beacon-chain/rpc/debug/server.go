@@ -27,6 +27,7 @@ type Server struct {
 	GenesisTimeFetcher blockchain.TimeFetcher
 	StateGen           *stategen.State
 	HeadFetcher        blockchain.HeadFetcher
+	BlockBuilder       BlockBuilder
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
@@ -96,6 +96,7 @@ type Service struct {
 	slasherCredentialError  error
 	slasherClient           slashpb.SlasherClient
 	stateGen                *stategen.State
+	dutiesCache             *cache.DutiesCache
 	connectedRPCClients     map[net.Addr]bool
 }
 
@@ -132,6 +133,7 @@ type Config struct {
 	BlockNotifier           blockfeed.Notifier
 	OperationNotifier       opfeed.Notifier
 	StateGen                *stategen.State
+	DutiesCache             *cache.DutiesCache
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -173,6 +175,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		slasherProvider:         cfg.SlasherProvider,
 		slasherCert:             cfg.SlasherCert,
 		stateGen:                cfg.StateGen,
+		dutiesCache:             cfg.DutiesCache,
 		enableDebugRPCEndpoints: cfg.EnableDebugRPCEndpoints,
 		connectedRPCClients:     make(map[net.Addr]bool),
 	}
@@ -248,6 +251,7 @@ func (s *Service) Start() {
 		PendingDepositsFetcher: s.pendingDepositFetcher,
 		SlashingsPool:          s.slashingsPool,
 		StateGen:               s.stateGen,
+		DutiesCache:            s.dutiesCache,
 	}
 	nodeServer := &node.Server{
 		BeaconDB:           s.beaconDB,
@@ -284,13 +288,16 @@ func (s *Service) Start() {
 	if s.enableDebugRPCEndpoints {
 		log.Info("Enabled debug RPC endpoints")
 		debugServer := &debug.Server{
+			BeaconDB:           s.beaconDB,
 			GenesisTimeFetcher: s.genesisTimeFetcher,
 			StateGen:           s.stateGen,
 			HeadFetcher:        s.headFetcher,
+			BlockBuilder:       validatorServer,
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 	}
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
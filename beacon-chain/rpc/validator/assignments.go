@@ -107,6 +107,10 @@ func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNode
 // Compute the validator duties from the head state's corresponding epoch
 // for validators public key / indices requested.
 func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	if res, ok := vs.precomputedDuties(req); ok {
+		return res, nil
+	}
+
 	s, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
@@ -187,6 +191,62 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 	}, nil
 }
 
+// Answer the duties request from the duties precomputed for the requested and the next
+// epoch, without touching the head state. Returns false if the duties of either epoch were
+// not precomputed or a requested validator is not in the validator registry.
+func (vs *Server) precomputedDuties(req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, bool) {
+	if vs.DutiesCache == nil {
+		return nil, false
+	}
+	current := vs.DutiesCache.Duties(req.Epoch)
+	next := vs.DutiesCache.Duties(req.Epoch + 1)
+	if current == nil || next == nil {
+		return nil, false
+	}
+
+	validatorAssignments := make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys))
+	nextValidatorAssignments := make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys))
+	for _, pubKey := range req.PublicKeys {
+		idx, ok := current.ValidatorIndices[bytesutil.ToBytes48(pubKey)]
+		if !ok || idx >= uint64(len(current.Statuses)) {
+			return nil, false
+		}
+		assignment := &ethpb.DutiesResponse_Duty{
+			PublicKey:      pubKey,
+			ValidatorIndex: idx,
+			Status:         current.Statuses[idx],
+			ProposerSlots:  current.ProposerSlots[idx],
+		}
+		nextAssignment := &ethpb.DutiesResponse_Duty{
+			PublicKey:      pubKey,
+			ValidatorIndex: idx,
+			Status:         current.Statuses[idx],
+			ProposerSlots:  next.ProposerSlots[idx],
+		}
+		if ca, ok := current.Assignments[idx]; ok {
+			assignment.Committee = ca.Committee
+			assignment.AttesterSlot = ca.AttesterSlot
+			assignment.CommitteeIndex = ca.CommitteeIndex
+		}
+		if ca, ok := next.Assignments[idx]; ok {
+			nextAssignment.Committee = ca.Committee
+			nextAssignment.AttesterSlot = ca.AttesterSlot
+			nextAssignment.CommitteeIndex = ca.CommitteeIndex
+		}
+		validatorAssignments = append(validatorAssignments, assignment)
+		nextValidatorAssignments = append(nextValidatorAssignments, nextAssignment)
+		// Assign relevant validator to subnet.
+		assignValidatorToSubnet(pubKey, assignment.Status)
+		assignValidatorToSubnet(pubKey, nextAssignment.Status)
+	}
+
+	return &ethpb.DutiesResponse{
+		Duties:             validatorAssignments,
+		CurrentEpochDuties: validatorAssignments,
+		NextEpochDuties:    nextValidatorAssignments,
+	}, true
+}
+
 // assignValidatorToSubnet checks the status and pubkey of a particular validator
 // to discern whether persistent subnets need to be registered for them.
 func assignValidatorToSubnet(pubkey []byte, status ethpb.ValidatorStatus) {
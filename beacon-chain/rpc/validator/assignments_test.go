@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
@@ -19,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/duties"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
@@ -281,6 +283,83 @@ func TestGetDuties_MultipleKeys_OK(t *testing.T) {
 	}
 }
 
+func TestGetDuties_FromPrecomputedDuties(t *testing.T) {
+	db := dbutil.SetupDB(t)
+
+	genesis := testutil.NewBeaconBlock()
+	depChainStart := uint64(64)
+	testutil.ResetCache()
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(depChainStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := state.GenesisBeaconState(deposits, 0, eth1Data)
+	if err != nil {
+		t.Fatalf("Could not setup genesis bs: %v", err)
+	}
+	genesisRoot, err := stateutil.BlockRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+
+	dutiesCache := cache.NewDutiesCache()
+	for _, epoch := range []uint64{0, 1} {
+		d, err := duties.ComputeEpochDuties(context.Background(), bs, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dutiesCache.Put(d)
+	}
+
+	chain := &mockChain.ChainService{
+		State: bs, Root: genesisRoot[:], Genesis: time.Now(),
+	}
+	vs := &Server{
+		BeaconDB:           db,
+		HeadFetcher:        chain,
+		GenesisTimeFetcher: chain,
+		SyncChecker:        &mockSync.Sync{IsSyncing: false},
+	}
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{deposits[0].Data.PublicKey, deposits[1].Data.PublicKey, deposits[63].Data.PublicKey},
+	}
+	want, err := vs.GetDuties(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Duties are answered from the cache, without a head state to compute them from.
+	vs.HeadFetcher = &mockChain.ChainService{}
+	vs.DutiesCache = dutiesCache
+	res, err := vs.GetDuties(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted duties %v, received %v", want, res)
+	}
+
+	// Statuses are served from the cache, as refreshed by the duties service.
+	refreshed := *dutiesCache.Duties(0)
+	refreshed.Statuses = append([]ethpb.ValidatorStatus{}, refreshed.Statuses...)
+	refreshed.Statuses[1] = ethpb.ValidatorStatus_SLASHING
+	dutiesCache.Put(&refreshed)
+	res, err = vs.GetDuties(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.CurrentEpochDuties[1].Status != ethpb.ValidatorStatus_SLASHING {
+		t.Errorf("Wanted status %v, received %v", ethpb.ValidatorStatus_SLASHING, res.CurrentEpochDuties[1].Status)
+	}
+	if res.CurrentEpochDuties[0].Status != ethpb.ValidatorStatus_ACTIVE {
+		t.Errorf("Wanted status %v, received %v", ethpb.ValidatorStatus_ACTIVE, res.CurrentEpochDuties[0].Status)
+	}
+}
+
 func TestGetDuties_SyncNotReady(t *testing.T) {
 	vs := &Server{
 		SyncChecker: &mockSync.Sync{IsSyncing: true},
@@ -9,6 +9,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -51,3 +53,44 @@ func (vs *Server) ProposeExit(ctx context.Context, req *ethpb.SignedVoluntaryExi
 
 	return &ptypes.Empty{}, vs.P2P.Broadcast(ctx, req)
 }
+
+// GetVoluntaryExitStatus returns the status of the voluntary exit of a validator in the exit pool of
+// the beacon node, with the exit epoch of the validator in the head state. A validator whose exit is
+// initiated in the head state, but whose voluntary exit is unknown to the pool, is reported as exited
+// as it may have been ejected or slashed rather than have its voluntary exit included.
+func (vs *Server) GetVoluntaryExitStatus(
+	ctx context.Context,
+	req *pbrpc.VoluntaryExitStatusRequest,
+) (*pbrpc.VoluntaryExitStatusResponse, error) {
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if req.ValidatorIndex >= uint64(headState.NumValidators()) {
+		return nil, status.Errorf(codes.NotFound, "No validator with index %d", req.ValidatorIndex)
+	}
+	v, err := headState.ValidatorAtIndexReadOnly(req.ValidatorIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+	}
+
+	res := &pbrpc.VoluntaryExitStatusResponse{}
+	if v.ExitEpoch() != params.BeaconConfig().FarFutureEpoch {
+		res.ExitEpoch = v.ExitEpoch()
+	}
+	exitStatus, inclusionSlot := vs.ExitPool.ExitStatus(req.ValidatorIndex)
+	switch exitStatus {
+	case voluntaryexits.ExitPending:
+		res.Status = pbrpc.VoluntaryExitStatusResponse_PENDING
+	case voluntaryexits.ExitIncluded:
+		res.Status = pbrpc.VoluntaryExitStatusResponse_INCLUDED
+		res.InclusionSlot = inclusionSlot
+	case voluntaryexits.ExitInvalid:
+		res.Status = pbrpc.VoluntaryExitStatusResponse_INVALID
+	default:
+		if res.ExitEpoch != 0 {
+			res.Status = pbrpc.VoluntaryExitStatusResponse_EXITED
+		}
+	}
+	return res, nil
+}
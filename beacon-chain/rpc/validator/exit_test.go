@@ -2,6 +2,7 @@ package validator
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,8 +15,11 @@ import (
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -192,3 +196,78 @@ func TestProposeExit_NoPanic(t *testing.T) {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 }
+
+func TestGetVoluntaryExitStatus(t *testing.T) {
+	ctx := context.Background()
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	st, err := stateTrie.InitializeFromProto(&pbp2p.BeaconState{
+		Validators: []*ethpb.Validator{
+			{ExitEpoch: farFuture},
+			{ExitEpoch: 10},
+			{ExitEpoch: 12},
+			{ExitEpoch: 14, Slashed: true},
+			{ExitEpoch: farFuture},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := voluntaryexits.NewPool()
+	pool.InsertVoluntaryExit(ctx, st, &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 0}})
+	pool.MarkIncluded(&ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1}}, 7)
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: st},
+		ExitPool:    pool,
+	}
+
+	tests := []struct {
+		validatorIndex uint64
+		want           *pbrpc.VoluntaryExitStatusResponse
+	}{
+		{
+			validatorIndex: 0,
+			want:           &pbrpc.VoluntaryExitStatusResponse{Status: pbrpc.VoluntaryExitStatusResponse_PENDING},
+		},
+		{
+			validatorIndex: 1,
+			want: &pbrpc.VoluntaryExitStatusResponse{
+				Status:        pbrpc.VoluntaryExitStatusResponse_INCLUDED,
+				InclusionSlot: 7,
+				ExitEpoch:     10,
+			},
+		},
+		{
+			// Ejected, or exited before the node started, so only known from the head state.
+			validatorIndex: 2,
+			want: &pbrpc.VoluntaryExitStatusResponse{
+				Status:    pbrpc.VoluntaryExitStatusResponse_EXITED,
+				ExitEpoch: 12,
+			},
+		},
+		{
+			validatorIndex: 3,
+			want: &pbrpc.VoluntaryExitStatusResponse{
+				Status:    pbrpc.VoluntaryExitStatusResponse_EXITED,
+				ExitEpoch: 14,
+			},
+		},
+		{
+			validatorIndex: 4,
+			want:           &pbrpc.VoluntaryExitStatusResponse{Status: pbrpc.VoluntaryExitStatusResponse_UNKNOWN},
+		},
+	}
+	for _, tt := range tests {
+		res, err := vs.GetVoluntaryExitStatus(ctx, &pbrpc.VoluntaryExitStatusRequest{ValidatorIndex: tt.validatorIndex})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Status != tt.want.Status || res.InclusionSlot != tt.want.InclusionSlot || res.ExitEpoch != tt.want.ExitEpoch {
+			t.Errorf("Wanted %v for validator %d, received %v", tt.want, tt.validatorIndex, res)
+		}
+	}
+
+	_, err = vs.GetVoluntaryExitStatus(ctx, &pbrpc.VoluntaryExitStatusRequest{ValidatorIndex: 5})
+	if err == nil || !strings.Contains(err.Error(), "No validator with index") {
+		t.Errorf("Expected error for unknown validator, received %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	fastssz "github.com/ferranbt/fastssz"
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
@@ -51,68 +52,96 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
 	}
-	eth1Data, err := vs.eth1Data(ctx, req.Slot)
+	head, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get ETH1 data: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not get head state %v", err)
+	}
+	// Evict the pooled operations which can no longer be included on top of the canonical head, as
+	// the pools are otherwise only pruned when they are re-gossiped, which initial sync skips.
+	vs.ExitPool.Prune(head)
+	vs.SlashingsPool.Prune(ctx, head)
+	blk, err := vs.BuildBlock(ctx, req.Slot, bytesutil.ToBytes32(parentRoot), head, req.RandaoReveal, req.Graffiti)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not build block: %v", err)
 	}
 
-	// Pack ETH1 deposits which have not been included in the beacon chain.
-	deposits, err := vs.deposits(ctx, eth1Data)
+	// Compute state root with the newly constructed block.
+	stateRoot, err := vs.computeStateRoot(ctx, &ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get ETH1 deposits: %v", err)
+		interop.WriteBlockToDisk(&ethpb.SignedBeaconBlock{Block: blk}, true /*failed*/)
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
 	}
-	head, err := vs.HeadFetcher.HeadState(ctx)
+	blk.StateRoot = stateRoot
+
+	return blk, nil
+}
+
+// BuildBlock packs the block the node would propose at the slot on top of the parent block, given
+// the post state of the parent block: the eth1data vote, pending deposits, attestations, slashings
+// and voluntary exits. The block is unsigned and its state root is the zero hash.
+func (vs *Server) BuildBlock(
+	ctx context.Context,
+	slot uint64,
+	parentRoot [32]byte,
+	parentState *stateTrie.BeaconState,
+	randaoReveal []byte,
+	graffiti []byte,
+) (*ethpb.BeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.BuildBlock")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("slot", int64(slot)))
+
+	// The eth1 data votes of the parent state are reset when the slot starts a new voting period,
+	// so the vote and the deposits are computed on the state processed to the slot.
+	preState, err := state.ProcessSlots(ctx, parentState.Copy(), slot)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get head state %v", err)
+		return nil, errors.Wrap(err, "could not advance slot to calculate proposer index")
 	}
-	head, err = state.ProcessSlots(ctx, head, req.Slot)
+
+	eth1Data, err := vs.eth1Data(ctx, preState, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get ETH1 data")
+	}
+
+	// Pack ETH1 deposits which have not been included in the beacon chain.
+	deposits, err := vs.deposits(ctx, preState, eth1Data)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not advance slot to calculate proposer index: %v", err)
+		return nil, errors.Wrap(err, "could not get ETH1 deposits")
 	}
 
 	// Pack aggregated attestations which have not been included in the beacon chain.
-	atts, err := vs.packAttestations(ctx, head)
+	atts, err := vs.packAttestations(ctx, preState)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get attestations to pack into block: %v", err)
+		return nil, errors.Wrap(err, "could not get attestations to pack into block")
 	}
 
 	// Use zero hash as stub for state root to compute later.
-	stateRoot := params.BeaconConfig().ZeroHash[:]
+	stateRoot := params.BeaconConfig().ZeroHash
 
-	graffiti := bytesutil.ToBytes32(req.Graffiti)
+	blockGraffiti := bytesutil.ToBytes32(graffiti)
 
 	// Calculate new proposer index.
-	idx, err := helpers.BeaconProposerIndex(head)
+	idx, err := helpers.BeaconProposerIndex(preState)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not calculate proposer index %v", err)
+		return nil, errors.Wrap(err, "could not calculate proposer index")
 	}
 
-	blk := &ethpb.BeaconBlock{
-		Slot:          req.Slot,
+	return &ethpb.BeaconBlock{
+		Slot:          slot,
 		ParentRoot:    parentRoot[:],
-		StateRoot:     stateRoot,
+		StateRoot:     stateRoot[:],
 		ProposerIndex: idx,
 		Body: &ethpb.BeaconBlockBody{
 			Eth1Data:          eth1Data,
 			Deposits:          deposits,
 			Attestations:      atts,
-			RandaoReveal:      req.RandaoReveal,
-			ProposerSlashings: vs.SlashingsPool.PendingProposerSlashings(ctx, head),
-			AttesterSlashings: vs.SlashingsPool.PendingAttesterSlashings(ctx, head),
-			VoluntaryExits:    vs.ExitPool.PendingExits(head, req.Slot),
-			Graffiti:          graffiti[:],
+			RandaoReveal:      randaoReveal,
+			ProposerSlashings: vs.SlashingsPool.PendingProposerSlashings(ctx, preState),
+			AttesterSlashings: vs.SlashingsPool.PendingAttesterSlashings(ctx, preState),
+			VoluntaryExits:    vs.ExitPool.PendingExits(preState, slot),
+			Graffiti:          blockGraffiti[:],
 		},
-	}
-
-	// Compute state root with the newly constructed block.
-	stateRoot, err = vs.computeStateRoot(ctx, &ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)})
-	if err != nil {
-		interop.WriteBlockToDisk(&ethpb.SignedBeaconBlock{Block: blk}, true /*failed*/)
-		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
-	}
-	blk.StateRoot = stateRoot
-
-	return blk, nil
+	}, nil
 }
 
 // ProposeBlock is called by a proposer during its assigned slot to create a block in an attempt
@@ -147,43 +176,209 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 }
 
 // eth1Data determines the appropriate eth1data for a block proposal. The algorithm for this method
-// is as follows:
+// follows the honest validator eth1 data vote:
 //  - Determine the timestamp for the start slot for the eth1 voting period.
-//  - Determine the most recent eth1 block before that timestamp.
-//  - Subtract that eth1block.number by ETH1_FOLLOW_DISTANCE.
-//  - This is the eth1block to use for the block proposal.
-func (vs *Server) eth1Data(ctx context.Context, slot uint64) (*ethpb.Eth1Data, error) {
+//  - The candidate eth1 blocks are the blocks with a timestamp between 2 * ETH1_FOLLOW_DISTANCE and
+//    ETH1_FOLLOW_DISTANCE eth1 blocks worth of time before the voting period start.
+//  - The eth1data votes in the state for a candidate block, which do not decrease the state's deposit
+//    count, are valid votes.
+//  - Vote for the valid vote with the most votes, ties broken by the earliest vote, or by default for
+//    the eth1data of the latest candidate block.
+func (vs *Server) eth1Data(ctx context.Context, beaconState *stateTrie.BeaconState, slot uint64) (*ethpb.Eth1Data, error) {
 	ctx, cancel := context.WithTimeout(ctx, eth1dataTimeout)
 	defer cancel()
 
 	if vs.MockEth1Votes {
-		return vs.mockETH1DataVote(ctx, slot)
+		return vs.mockETH1DataVote(beaconState, slot), nil
 	}
 
 	if !vs.Eth1InfoFetcher.IsConnectedToETH1() {
-		return vs.randomETH1DataVote(ctx)
+		return vs.randomETH1DataVote(beaconState), nil
 	}
 	eth1DataNotification = false
 
-	eth1VotingPeriodStartTime, _ := vs.Eth1InfoFetcher.Eth2GenesisPowchainInfo()
-	eth1VotingPeriodStartTime += (slot - (slot % (params.BeaconConfig().EpochsPerEth1VotingPeriod * params.BeaconConfig().SlotsPerEpoch))) * params.BeaconConfig().SecondsPerSlot
+	genesisTime, _ := vs.Eth1InfoFetcher.Eth2GenesisPowchainInfo()
+	eth1VotingPeriodStartTime := genesisTime + (slot-(slot%(params.BeaconConfig().EpochsPerEth1VotingPeriod*params.BeaconConfig().SlotsPerEpoch)))*params.BeaconConfig().SecondsPerSlot
 
-	// Look up most recent block up to timestamp
-	blockNumber, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, eth1VotingPeriodStartTime)
+	firstCandidate, lastCandidate, err := vs.candidateEth1Blocks(ctx, eth1VotingPeriodStartTime)
 	if err != nil {
-		log.WithError(err).Error("Failed to get block number from timestamp")
-		return vs.randomETH1DataVote(ctx)
+		log.WithError(err).Error("Failed to get candidate eth1 blocks of the voting period")
+		return vs.randomETH1DataVote(beaconState), nil
 	}
-	eth1Data, err := vs.defaultEth1DataResponse(ctx, blockNumber)
+	eth1Data, err := vs.eth1DataMajorityVote(ctx, beaconState, firstCandidate, lastCandidate)
 	if err != nil {
-		log.WithError(err).Error("Failed to get eth1 data from block number")
-		return vs.randomETH1DataVote(ctx)
+		log.WithError(err).Error("Failed to get eth1 data majority vote")
+		return vs.randomETH1DataVote(beaconState), nil
 	}
 
 	return eth1Data, nil
 }
 
-func (vs *Server) mockETH1DataVote(ctx context.Context, slot uint64) (*ethpb.Eth1Data, error) {
+// candidateEth1Blocks returns the range of eth1 block heights eligible for an eth1data vote in the
+// voting period starting at the given time. The range is nil when there is no candidate block.
+//
+// Spec pseudocode definition:
+//   def is_candidate_block(block: Eth1Block, period_start: uint64) -> bool:
+//     return (
+//         block.timestamp + SECONDS_PER_ETH1_BLOCK * ETH1_FOLLOW_DISTANCE <= period_start
+//         and block.timestamp + SECONDS_PER_ETH1_BLOCK * ETH1_FOLLOW_DISTANCE * 2 >= period_start
+//     )
+func (vs *Server) candidateEth1Blocks(ctx context.Context, periodStart uint64) (*big.Int, *big.Int, error) {
+	followTime := params.BeaconConfig().SecondsPerETH1Block * params.BeaconConfig().Eth1FollowDistance
+	if periodStart < followTime {
+		return nil, nil, nil
+	}
+	lastCandidate, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, periodStart-followTime)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get latest candidate block number")
+	}
+	if lastCandidate == nil {
+		return nil, nil, nil
+	}
+
+	earliestTime := uint64(0)
+	if periodStart > 2*followTime {
+		earliestTime = periodStart - 2*followTime
+	}
+	// The first candidate is the block after the latest block before the earliest candidate time.
+	firstCandidate, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, earliestTime)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get earliest candidate block number")
+	}
+	if firstCandidate == nil {
+		firstCandidate = big.NewInt(0)
+	}
+	firstCandidateTime, err := vs.Eth1BlockFetcher.BlockTimeByHeight(ctx, firstCandidate)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get earliest candidate block time")
+	}
+	if firstCandidateTime < earliestTime {
+		firstCandidate = big.NewInt(0).Add(firstCandidate, big.NewInt(1))
+	}
+	if firstCandidate.Cmp(lastCandidate) > 0 {
+		return nil, nil, nil
+	}
+	return firstCandidate, lastCandidate, nil
+}
+
+// eth1DataMajorityVote tallies the eth1data votes of the state and returns the most popular valid
+// vote, or the eth1data of the latest candidate block when there is no valid vote. Without candidate
+// blocks or a candidate deposit count lower than the state's, the state's eth1data is the default vote.
+//
+// Spec pseudocode definition:
+//   def get_eth1_vote(state: BeaconState, eth1_chain: Sequence[Eth1Block]) -> Eth1Data:
+//     period_start = voting_period_start_time(state)
+//     # `eth1_chain` abstractly represents all blocks in the eth1 chain sorted by ascending block height
+//     votes_to_consider = [
+//         get_eth1_data(block) for block in eth1_chain
+//         if (
+//             is_candidate_block(block, period_start)
+//             # Ensure cannot move back to earlier deposit contract states
+//             and get_eth1_data(block).deposit_count >= state.eth1_data.deposit_count
+//         )
+//     ]
+//     # Valid votes already cast during this period
+//     valid_votes = [vote for vote in state.eth1_data_votes if vote in votes_to_consider]
+//     # Default vote on latest eth1 block data in the period range unless eth1 chain is not live
+//     default_vote = votes_to_consider[len(votes_to_consider) - 1] if any(votes_to_consider) else state.eth1_data
+//     return max(
+//         valid_votes,
+//         key=lambda v: (valid_votes.count(v), -valid_votes.index(v)),  # Tiebreak by smallest distance
+//         default=default_vote
+//     )
+func (vs *Server) eth1DataMajorityVote(
+	ctx context.Context,
+	beaconState *stateTrie.BeaconState,
+	firstCandidate *big.Int,
+	lastCandidate *big.Int,
+) (*ethpb.Eth1Data, error) {
+	currentEth1Data := beaconState.Eth1Data()
+	if firstCandidate == nil || lastCandidate == nil {
+		return currentEth1Data, nil
+	}
+
+	// Distinct votes in the order they were first cast, with their number of votes.
+	var distinctVotes []*ethpb.Eth1Data
+	var voteCounts []uint64
+	for _, vote := range beaconState.Eth1DataVotes() {
+		seen := false
+		for i, v := range distinctVotes {
+			if proto.Equal(v, vote) {
+				voteCounts[i]++
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			distinctVotes = append(distinctVotes, vote)
+			voteCounts = append(voteCounts, 1)
+		}
+	}
+
+	var bestVote *ethpb.Eth1Data
+	bestCount := uint64(0)
+	for i, vote := range distinctVotes {
+		if voteCounts[i] <= bestCount || vote.DepositCount < currentEth1Data.DepositCount {
+			continue
+		}
+		valid, err := vs.isCandidateEth1DataVote(ctx, vote, firstCandidate, lastCandidate)
+		if err != nil {
+			return nil, err
+		}
+		if valid {
+			bestVote = vote
+			bestCount = voteCounts[i]
+		}
+	}
+	if bestVote != nil {
+		return bestVote, nil
+	}
+
+	defaultVote, err := vs.eth1DataAtHeight(ctx, lastCandidate)
+	if err != nil {
+		return nil, err
+	}
+	if defaultVote.DepositCount < currentEth1Data.DepositCount {
+		return currentEth1Data, nil
+	}
+	return defaultVote, nil
+}
+
+// isCandidateEth1DataVote checks whether the vote is the eth1data of a candidate block.
+func (vs *Server) isCandidateEth1DataVote(ctx context.Context, vote *ethpb.Eth1Data, firstCandidate *big.Int, lastCandidate *big.Int) (bool, error) {
+	exists, height, err := vs.Eth1BlockFetcher.BlockExists(ctx, bytesutil.ToBytes32(vote.BlockHash))
+	if err != nil || !exists {
+		// Votes for unknown eth1 blocks are not valid.
+		return false, nil
+	}
+	if height.Cmp(firstCandidate) < 0 || height.Cmp(lastCandidate) > 0 {
+		return false, nil
+	}
+	blockEth1Data, err := vs.eth1DataAtHeight(ctx, height)
+	if err != nil {
+		return false, err
+	}
+	return proto.Equal(blockEth1Data, vote), nil
+}
+
+// eth1DataAtHeight returns the eth1data of the eth1 block at the given height.
+func (vs *Server) eth1DataAtHeight(ctx context.Context, height *big.Int) (*ethpb.Eth1Data, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	blockHash, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not fetch eth1 block hash at height %d", height)
+	}
+	depositCount, depositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, height)
+	return &ethpb.Eth1Data{
+		DepositRoot:  depositRoot[:],
+		DepositCount: depositCount,
+		BlockHash:    blockHash[:],
+	}, nil
+}
+
+func (vs *Server) mockETH1DataVote(beaconState *stateTrie.BeaconState, slot uint64) *ethpb.Eth1Data {
 	if !eth1DataNotification {
 		log.Warn("Beacon Node is no longer connected to an ETH1 chain, so ETH1 data votes are now mocked.")
 		eth1DataNotification = true
@@ -198,39 +393,31 @@ func (vs *Server) mockETH1DataVote(ctx context.Context, slot uint64) (*ethpb.Eth
 	//   BlockHash = hash(hash(current_epoch + slot_in_voting_period)),
 	// )
 	slotInVotingPeriod := slot % (params.BeaconConfig().EpochsPerEth1VotingPeriod * params.BeaconConfig().SlotsPerEpoch)
-	headState, err := vs.HeadFetcher.HeadState(ctx)
-	if err != nil {
-		return nil, err
-	}
 	var enc []byte
 	enc = fastssz.MarshalUint64(enc, helpers.SlotToEpoch(slot)+slotInVotingPeriod)
 	depRoot := hashutil.Hash(enc)
 	blockHash := hashutil.Hash(depRoot[:])
 	return &ethpb.Eth1Data{
 		DepositRoot:  depRoot[:],
-		DepositCount: headState.Eth1DepositIndex(),
+		DepositCount: beaconState.Eth1DepositIndex(),
 		BlockHash:    blockHash[:],
-	}, nil
+	}
 }
 
-func (vs *Server) randomETH1DataVote(ctx context.Context) (*ethpb.Eth1Data, error) {
+func (vs *Server) randomETH1DataVote(beaconState *stateTrie.BeaconState) *ethpb.Eth1Data {
 	if !eth1DataNotification {
 		log.Warn("Beacon Node is no longer connected to an ETH1 chain, so ETH1 data votes are now random.")
 		eth1DataNotification = true
 	}
-	headState, err := vs.HeadFetcher.HeadState(ctx)
-	if err != nil {
-		return nil, err
-	}
 	// set random roots and block hashes to prevent a majority from being
 	// built if the eth1 node is offline
 	depRoot := hashutil.Hash(bytesutil.Bytes32(rand.Uint64()))
 	blockHash := hashutil.Hash(bytesutil.Bytes32(rand.Uint64()))
 	return &ethpb.Eth1Data{
 		DepositRoot:  depRoot[:],
-		DepositCount: headState.Eth1DepositIndex(),
+		DepositCount: beaconState.Eth1DepositIndex(),
 		BlockHash:    blockHash[:],
-	}, nil
+	}
 }
 
 // computeStateRoot computes the state root after a block has been processed through a state transition and
@@ -268,17 +455,14 @@ func (vs *Server) computeStateRoot(ctx context.Context, block *ethpb.SignedBeaco
 // this eth1data has enough support to be considered for deposits inclusion. If current vote has
 // enough support, then use that vote for basis of determining deposits, otherwise use current state
 // eth1data.
-func (vs *Server) deposits(ctx context.Context, currentVote *ethpb.Eth1Data) ([]*ethpb.Deposit, error) {
+func (vs *Server) deposits(ctx context.Context, beaconState *stateTrie.BeaconState, currentVote *ethpb.Eth1Data) ([]*ethpb.Deposit, error) {
 	if vs.MockEth1Votes || !vs.Eth1InfoFetcher.IsConnectedToETH1() {
 		return []*ethpb.Deposit{}, nil
 	}
 	// Need to fetch if the deposits up to the state's latest eth 1 data matches
 	// the number of all deposits in this RPC call. If not, then we return nil.
-	headState, err := vs.HeadFetcher.HeadState(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "Could not get head state")
-	}
-	canonicalEth1Data, latestEth1DataHeight, err := vs.canonicalEth1Data(ctx, headState, currentVote)
+	// The current vote is tallied on a copy, so as not to mutate the given state.
+	canonicalEth1Data, latestEth1DataHeight, err := vs.canonicalEth1Data(ctx, beaconState.Copy(), currentVote)
 	if err != nil {
 		return nil, err
 	}
@@ -294,17 +478,7 @@ func (vs *Server) deposits(ctx context.Context, currentVote *ethpb.Eth1Data) ([]
 		return []*ethpb.Deposit{}, nil
 	}
 
-	upToEth1DataDeposits := vs.DepositFetcher.AllDeposits(ctx, latestEth1DataHeight)
-	depositData := [][]byte{}
-	for _, dep := range upToEth1DataDeposits {
-		depHash, err := ssz.HashTreeRoot(dep.Data)
-		if err != nil {
-			return nil, errors.Wrap(err, "could not hash deposit data")
-		}
-		depositData = append(depositData, depHash[:])
-	}
-
-	depositTrie, err := trieutil.GenerateTrieFromItems(depositData, int(params.BeaconConfig().DepositContractTreeDepth))
+	depositTrie, err := vs.depositTrie(ctx, latestEth1DataHeight)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not generate historical deposit trie from deposits")
 	}
@@ -313,7 +487,7 @@ func (vs *Server) deposits(ctx context.Context, currentVote *ethpb.Eth1Data) ([]
 	// deposits are sorted from lowest to highest.
 	var pendingDeps []*dbpb.DepositContainer
 	for _, dep := range allPendingContainers {
-		if uint64(dep.Index) >= headState.Eth1DepositIndex() && uint64(dep.Index) < canonicalEth1Data.DepositCount {
+		if uint64(dep.Index) >= beaconState.Eth1DepositIndex() && uint64(dep.Index) < canonicalEth1Data.DepositCount {
 			pendingDeps = append(pendingDeps, dep)
 		}
 	}
@@ -336,6 +510,31 @@ func (vs *Server) deposits(ctx context.Context, currentVote *ethpb.Eth1Data) ([]
 	return pendingDeposits, nil
 }
 
+// depositTrie builds the deposit trie of all the deposits made up to the eth1 block height. When the
+// deposit cache was seeded from a deposit snapshot, the deposits following the finalized deposits
+// are inserted into a copy of the finalized deposit trie.
+func (vs *Server) depositTrie(ctx context.Context, beforeBlk *big.Int) (*trieutil.SparseMerkleTrie, error) {
+	upToEth1DataDeposits := vs.DepositFetcher.AllDeposits(ctx, beforeBlk)
+	depositData := [][]byte{}
+	for _, dep := range upToEth1DataDeposits {
+		depHash, err := ssz.HashTreeRoot(dep.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash deposit data")
+		}
+		depositData = append(depositData, depHash[:])
+	}
+
+	finalized := vs.DepositFetcher.FinalizedDeposits(ctx)
+	if finalized == nil {
+		return trieutil.GenerateTrieFromItems(depositData, int(params.BeaconConfig().DepositContractTreeDepth))
+	}
+	depositTrie := finalized.Deposits.Copy()
+	for i, depHash := range depositData {
+		depositTrie.Insert(depHash, int(finalized.MerkleTrieIndex)+1+i)
+	}
+	return depositTrie, nil
+}
+
 // canonicalEth1Data determines the canonical eth1data and eth1 block height to use for determining deposits.
 func (vs *Server) canonicalEth1Data(ctx context.Context, beaconState *stateTrie.BeaconState, currentVote *ethpb.Eth1Data) (*ethpb.Eth1Data, *big.Int, error) {
 	var eth1BlockHash [32]byte
@@ -363,37 +562,6 @@ func (vs *Server) canonicalEth1Data(ctx context.Context, beaconState *stateTrie.
 	return canonicalEth1Data, latestEth1DataHeight, nil
 }
 
-// in case no vote for new eth1data vote considered best vote we
-// default into returning the latest deposit root and the block
-// hash of eth1 block hash that is FOLLOW_DISTANCE back from its
-// latest block.
-func (vs *Server) defaultEth1DataResponse(ctx context.Context, currentHeight *big.Int) (*ethpb.Eth1Data, error) {
-	if ctx.Err() != nil {
-		return nil, ctx.Err()
-	}
-	eth1FollowDistance := int64(params.BeaconConfig().Eth1FollowDistance)
-	ancestorHeight := big.NewInt(0).Sub(currentHeight, big.NewInt(eth1FollowDistance))
-	blockHash, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, ancestorHeight)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not fetch ETH1_FOLLOW_DISTANCE ancestor")
-	}
-	// Fetch all historical deposits up to an ancestor height.
-	depositsTillHeight, depositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, ancestorHeight)
-	if depositsTillHeight == 0 {
-		return vs.ChainStartFetcher.ChainStartEth1Data(), nil
-	}
-	// Check for the validity of deposit count.
-	currentETH1Data := vs.HeadFetcher.HeadETH1Data()
-	if depositsTillHeight < currentETH1Data.DepositCount {
-		return currentETH1Data, nil
-	}
-	return &ethpb.Eth1Data{
-		DepositRoot:  depositRoot[:],
-		BlockHash:    blockHash[:],
-		DepositCount: depositsTillHeight,
-	}, nil
-}
-
 // This filters the input attestations to return a list of valid attestations to be packaged inside a beacon block.
 func (vs *Server) filterAttestationsForBlockInclusion(ctx context.Context, state *stateTrie.BeaconState, atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.filterAttestationsForBlockInclusion")
@@ -3,6 +3,7 @@ package validator
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
@@ -157,6 +158,91 @@ func TestGetBlock_OK(t *testing.T) {
 	}
 }
 
+func TestGetBlock_PrunesPoolsOnHeadState(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+
+	testutil.ResetCache()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatalf("Could not hash genesis state: %v", err)
+	}
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	if err := db.SaveBlock(ctx, genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	parentRoot, err := stateutil.BlockRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	if err := db.SaveState(ctx, beaconState, parentRoot); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, parentRoot); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+
+	proposerServer := &Server{
+		BeaconDB:          db,
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:     &mock.ChainService{},
+		ChainStartFetcher: &mockPOW.POWChain{},
+		Eth1InfoFetcher:   &mockPOW.POWChain{},
+		Eth1BlockFetcher:  &mockPOW.POWChain{},
+		MockEth1Votes:     true,
+		AttPool:           attestations.NewPool(),
+		SlashingsPool:     slashings.NewPool(),
+		ExitPool:          voluntaryexits.NewPool(),
+		StateGen:          stategen.New(db, cache.NewStateSummaryCache()),
+	}
+	proposerSlashings := make([]*ethpb.ProposerSlashing, 2)
+	for i := uint64(0); i < 2; i++ {
+		proposerSlashing, err := testutil.GenerateProposerSlashingForValidator(beaconState, privKeys[i], i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proposerSlashings[i] = proposerSlashing
+		if err := proposerServer.SlashingsPool.InsertProposerSlashing(ctx, beaconState, proposerSlashing); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Validator 0 was slashed on the canonical chain since its slashing was pooled.
+	headState := beaconState.Copy()
+	val, err := headState.ValidatorAtIndex(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val.Slashed = true
+	if err := headState.UpdateValidatorAtIndex(0, val); err != nil {
+		t.Fatal(err)
+	}
+	proposerServer.HeadFetcher = &mock.ChainService{State: headState, Root: parentRoot[:]}
+
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 0, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := proposerServer.GetBlock(ctx, &ethpb.BlockRequest{
+		Slot:         1,
+		RandaoReveal: randaoReveal,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*ethpb.ProposerSlashing{proposerSlashings[1]}
+	if !reflect.DeepEqual(block.Body.ProposerSlashings, want) {
+		t.Errorf("Wanted proposer slashings %v, got %v", want, block.Body.ProposerSlashings)
+	}
+	if pooled := proposerServer.SlashingsPool.AllProposerSlashings(); !reflect.DeepEqual(pooled, want) {
+		t.Errorf("Wanted pooled proposer slashings %v, got %v", want, pooled)
+	}
+}
+
 func TestGetBlock_AddsUnaggregatedAtts(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
@@ -617,7 +703,7 @@ func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 		HeadFetcher:            &mock.ChainService{State: beaconState, Root: blkRoot[:]},
 	}
 
-	deposits, err := bs.deposits(ctx, &ethpb.Eth1Data{})
+	deposits, err := bs.deposits(ctx, beaconState, &ethpb.Eth1Data{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -628,7 +714,7 @@ func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 	// It should not return the recent deposits after their follow window.
 	// as latest block number makes no difference in retrieval of deposits
 	p.LatestBlockNumber = big.NewInt(0).Add(p.LatestBlockNumber, big.NewInt(10000))
-	deposits, err = bs.deposits(ctx, &ethpb.Eth1Data{})
+	deposits, err = bs.deposits(ctx, beaconState, &ethpb.Eth1Data{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -762,7 +848,7 @@ func TestPendingDeposits_FollowsCorrectEth1Block(t *testing.T) {
 		HeadFetcher:            &mock.ChainService{State: beaconState, Root: blkRoot[:]},
 	}
 
-	deposits, err := bs.deposits(ctx, &ethpb.Eth1Data{})
+	deposits, err := bs.deposits(ctx, beaconState, &ethpb.Eth1Data{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -774,7 +860,7 @@ func TestPendingDeposits_FollowsCorrectEth1Block(t *testing.T) {
 	p.LatestBlockNumber = big.NewInt(0).Add(p.LatestBlockNumber, big.NewInt(10000))
 	// we should get our pending deposits once this vote pushes the vote tally to include
 	// the updated eth1 data.
-	deposits, err = bs.deposits(ctx, vote)
+	deposits, err = bs.deposits(ctx, beaconState, vote)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -881,7 +967,7 @@ func TestPendingDeposits_CantReturnBelowStateEth1DepositIndex(t *testing.T) {
 
 	// It should also return the recent deposits after their follow window.
 	p.LatestBlockNumber = big.NewInt(0).Add(p.LatestBlockNumber, big.NewInt(10000))
-	deposits, err := bs.deposits(ctx, &ethpb.Eth1Data{})
+	deposits, err := bs.deposits(ctx, beaconState, &ethpb.Eth1Data{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -990,7 +1076,7 @@ func TestPendingDeposits_CantReturnMoreThanMax(t *testing.T) {
 
 	// It should also return the recent deposits after their follow window.
 	p.LatestBlockNumber = big.NewInt(0).Add(p.LatestBlockNumber, big.NewInt(10000))
-	deposits, err := bs.deposits(ctx, &ethpb.Eth1Data{})
+	deposits, err := bs.deposits(ctx, beaconState, &ethpb.Eth1Data{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1003,6 +1089,111 @@ func TestPendingDeposits_CantReturnMoreThanMax(t *testing.T) {
 	}
 }
 
+func TestPendingDeposits_FromFinalizedDeposits(t *testing.T) {
+	ctx := context.Background()
+
+	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
+	p := &mockPOW.POWChain{
+		LatestBlockNumber: height,
+		HashesByHeight: map[int][]byte{
+			int(height.Int64()): []byte("0x0"),
+		},
+	}
+
+	beaconState, err := beaconstate.InitializeFromProto(&pbp2p.BeaconState{
+		Eth1Data: &ethpb.Eth1Data{
+			BlockHash:    []byte("0x0"),
+			DepositCount: 10,
+		},
+		Eth1DepositIndex: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := &ethpb.BeaconBlock{
+		Slot: beaconState.Slot(),
+	}
+	blkRoot, err := ssz.HashTreeRoot(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mockSig [96]byte
+	var mockCreds [32]byte
+
+	var allDeposits []*dbpb.DepositContainer
+	for i := int64(0); i < 10; i++ {
+		allDeposits = append(allDeposits, &dbpb.DepositContainer{
+			Index: i,
+			Deposit: &ethpb.Deposit{
+				Data: &ethpb.Deposit_Data{
+					PublicKey:             []byte{byte(i)},
+					Signature:             mockSig[:],
+					WithdrawalCredentials: mockCreds[:],
+				}},
+		})
+	}
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatalf("could not setup deposit trie: %v", err)
+	}
+	for _, dp := range allDeposits {
+		depositHash, err := ssz.HashTreeRoot(dp.Deposit.Data)
+		if err != nil {
+			t.Fatalf("Unable to determine hashed value of deposit %v", err)
+		}
+		depositTrie.Insert(depositHash[:], int(dp.Index))
+	}
+
+	// The first 3 deposits are seeded from a deposit snapshot.
+	finalized, err := depositTrie.FinalizedBranches(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finalizedTrie, err := trieutil.CreateTrieFromFinalized(finalized, 3, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositCache := depositcache.NewDepositCache()
+	depositCache.InsertFinalizedDeposits(ctx, &depositcache.FinalizedDeposits{
+		Deposits:        finalizedTrie,
+		MerkleTrieIndex: 2,
+		Eth1BlockHeight: height.Uint64(),
+	})
+	for _, dp := range allDeposits[3:] {
+		depositCache.InsertDeposit(ctx, dp.Deposit, height.Uint64(), dp.Index, depositTrie.Root())
+		depositCache.InsertPendingDeposit(ctx, dp.Deposit, height.Uint64(), dp.Index, depositTrie.Root())
+	}
+
+	bs := &Server{
+		ChainStartFetcher:      p,
+		Eth1InfoFetcher:        p,
+		Eth1BlockFetcher:       p,
+		DepositFetcher:         depositCache,
+		PendingDepositsFetcher: depositCache,
+		BlockReceiver:          &mock.ChainService{State: beaconState, Root: blkRoot[:]},
+		HeadFetcher:            &mock.ChainService{State: beaconState, Root: blkRoot[:]},
+	}
+
+	p.LatestBlockNumber = big.NewInt(0).Add(p.LatestBlockNumber, big.NewInt(10000))
+	deposits, err := bs.deposits(ctx, beaconState, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != 6 {
+		t.Fatalf("Received unexpected number of pending deposits: %d, wanted: %d", len(deposits), 6)
+	}
+	root := depositTrie.Root()
+	for i, dep := range deposits {
+		depositHash, err := ssz.HashTreeRoot(dep.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !trieutil.VerifyMerkleBranch(root[:], depositHash[:], i+4, dep.Proof) {
+			t.Errorf("Could not verify the Merkle proof of deposit %d", i+4)
+		}
+	}
+}
+
 func TestPendingDeposits_CantReturnMoreDepositCount(t *testing.T) {
 	ctx := context.Background()
 
@@ -1097,7 +1288,7 @@ func TestPendingDeposits_CantReturnMoreDepositCount(t *testing.T) {
 
 	// It should also return the recent deposits after their follow window.
 	p.LatestBlockNumber = big.NewInt(0).Add(p.LatestBlockNumber, big.NewInt(10000))
-	deposits, err := bs.deposits(ctx, &ethpb.Eth1Data{})
+	deposits, err := bs.deposits(ctx, beaconState, &ethpb.Eth1Data{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1130,79 +1321,11 @@ func TestEth1Data_EmptyVotesFetchBlockHashFailure(t *testing.T) {
 		BlockReceiver:     &mock.ChainService{State: beaconState},
 		HeadFetcher:       &mock.ChainService{State: beaconState},
 	}
-	if _, err := proposerServer.eth1Data(context.Background(), beaconState.Slot()+1); err != nil {
+	if _, err := proposerServer.eth1Data(context.Background(), beaconState, beaconState.Slot()+1); err != nil {
 		t.Errorf("A failed request should not have returned an error, got %v", err)
 	}
 }
 
-func TestDefaultEth1Data_NoBlockExists(t *testing.T) {
-	ctx := context.Background()
-
-	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
-	deps := []*dbpb.DepositContainer{
-		{
-			Index:           0,
-			Eth1BlockHeight: 8,
-			Deposit: &ethpb.Deposit{
-				Data: &ethpb.Deposit_Data{
-					PublicKey:             []byte("a"),
-					Signature:             make([]byte, 96),
-					WithdrawalCredentials: make([]byte, 32),
-				}},
-		},
-		{
-			Index:           1,
-			Eth1BlockHeight: 14,
-			Deposit: &ethpb.Deposit{
-				Data: &ethpb.Deposit_Data{
-					PublicKey:             []byte("b"),
-					Signature:             make([]byte, 96),
-					WithdrawalCredentials: make([]byte, 32),
-				}},
-		},
-	}
-	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
-	if err != nil {
-		t.Fatalf("could not setup deposit trie: %v", err)
-	}
-	depositCache := depositcache.NewDepositCache()
-	for _, dp := range deps {
-		depositCache.InsertDeposit(context.Background(), dp.Deposit, dp.Eth1BlockHeight, dp.Index, depositTrie.Root())
-	}
-
-	p := &mockPOW.POWChain{
-		LatestBlockNumber: height,
-		HashesByHeight: map[int][]byte{
-			0:  []byte("hash0"),
-			12: []byte("hash12"),
-		},
-	}
-	proposerServer := &Server{
-		ChainStartFetcher:      p,
-		Eth1InfoFetcher:        p,
-		Eth1BlockFetcher:       p,
-		DepositFetcher:         depositCache,
-		PendingDepositsFetcher: depositCache,
-	}
-
-	defEth1Data := &ethpb.Eth1Data{
-		DepositCount: 10,
-		BlockHash:    []byte{'t', 'e', 's', 't'},
-		DepositRoot:  []byte{'r', 'o', 'o', 't'},
-	}
-
-	p.Eth1Data = defEth1Data
-
-	result, err := proposerServer.defaultEth1DataResponse(ctx, big.NewInt(16))
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if !proto.Equal(result, defEth1Data) {
-		t.Errorf("Did not receive default eth1data. Wanted %v but Got %v", defEth1Data, result)
-	}
-}
-
 // TODO(2312): Add more tests for edge cases and better coverage.
 func TestEth1Data(t *testing.T) {
 	slot := uint64(20000)
@@ -1232,7 +1355,7 @@ func TestEth1Data(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	eth1Data, err := ps.eth1Data(ctx, slot)
+	eth1Data, err := ps.eth1Data(ctx, headState, slot)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1243,67 +1366,196 @@ func TestEth1Data(t *testing.T) {
 }
 
 func TestEth1Data_SmallerDepositCount(t *testing.T) {
-	slot := uint64(20000)
-	deps := []*dbpb.DepositContainer{
-		{
-			Index:           0,
-			Eth1BlockHeight: 8,
-			Deposit: &ethpb.Deposit{
-				Data: &ethpb.Deposit_Data{
-					PublicKey:             []byte("a"),
-					Signature:             make([]byte, 96),
-					WithdrawalCredentials: make([]byte, 32),
-				}},
-		},
-		{
-			Index:           1,
-			Eth1BlockHeight: 14,
-			Deposit: &ethpb.Deposit{
-				Data: &ethpb.Deposit_Data{
-					PublicKey:             []byte("b"),
-					Signature:             make([]byte, 96),
-					WithdrawalCredentials: make([]byte, 32),
-				}},
-		},
+	p, depositCache, slot, _, last := eth1ChainWithDeposits(t)
+
+	// The deposit count of the state is ahead of the candidate blocks.
+	headState := testutil.NewBeaconState()
+	if err := headState.SetEth1Data(&ethpb.Eth1Data{DepositCount: last + 10}); err != nil {
+		t.Fatal(err)
 	}
-	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	ps := &Server{
+		ChainStartFetcher: p,
+		Eth1InfoFetcher:   p,
+		Eth1BlockFetcher:  p,
+		HeadFetcher:       &mock.ChainService{State: headState},
+		DepositFetcher:    depositCache,
+	}
+
+	eth1Data, err := ps.eth1Data(context.Background(), headState, slot)
 	if err != nil {
-		t.Fatalf("could not setup deposit trie: %v", err)
+		t.Fatal(err)
 	}
-	depositCache := depositcache.NewDepositCache()
-	for _, dp := range deps {
-		depositCache.InsertDeposit(context.Background(), dp.Deposit, dp.Eth1BlockHeight, dp.Index, depositTrie.Root())
+	// Will default to the state's eth1data as the candidate blocks would move the deposit count back.
+	if eth1Data.DepositCount != last+10 {
+		t.Errorf("Expected deposit count to be %d but got %d", last+10, eth1Data.DepositCount)
 	}
+}
 
-	p := &mockPOW.POWChain{
-		BlockNumberByHeight: map[uint64]*big.Int{
-			slot * params.BeaconConfig().SecondsPerSlot: big.NewInt(4096),
+func TestEth1Data_DefaultsToLatestCandidateBlock(t *testing.T) {
+	p, depositCache, slot, _, last := eth1ChainWithDeposits(t)
+
+	headState := testutil.NewBeaconState()
+	if err := headState.SetEth1Data(&ethpb.Eth1Data{DepositCount: 1}); err != nil {
+		t.Fatal(err)
+	}
+	// A vote for a block which is not a candidate is ignored.
+	if err := headState.SetEth1DataVotes([]*ethpb.Eth1Data{
+		eth1DataOfBlock(p, depositCache, last+1),
+		eth1DataOfBlock(p, depositCache, last+1),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	ps := &Server{
+		ChainStartFetcher: p,
+		Eth1InfoFetcher:   p,
+		Eth1BlockFetcher:  p,
+		HeadFetcher:       &mock.ChainService{State: headState},
+		DepositFetcher:    depositCache,
+	}
+
+	eth1Data, err := ps.eth1Data(context.Background(), headState, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := eth1DataOfBlock(p, depositCache, last); !proto.Equal(eth1Data, want) {
+		t.Errorf("Wanted vote for latest candidate block %v, received %v", want, eth1Data)
+	}
+}
+
+func TestEth1Data_MajorityVote(t *testing.T) {
+	p, depositCache, slot, first, last := eth1ChainWithDeposits(t)
+	a := eth1DataOfBlock(p, depositCache, first)
+	b := eth1DataOfBlock(p, depositCache, first+1)
+	c := eth1DataOfBlock(p, depositCache, last)
+	beforeRange := eth1DataOfBlock(p, depositCache, first-1)
+	unknownBlock := &ethpb.Eth1Data{
+		DepositRoot:  c.DepositRoot,
+		DepositCount: c.DepositCount,
+		BlockHash:    []byte("unknown"),
+	}
+	wrongDepositCount := &ethpb.Eth1Data{
+		DepositRoot:  c.DepositRoot,
+		DepositCount: c.DepositCount + 1,
+		BlockHash:    c.BlockHash,
+	}
+
+	tests := []struct {
+		name  string
+		votes []*ethpb.Eth1Data
+		want  *ethpb.Eth1Data
+	}{
+		{
+			name:  "most votes",
+			votes: []*ethpb.Eth1Data{a, b, b, c, b, a},
+			want:  b,
 		},
-		HashesByHeight: map[int][]byte{
-			4080: []byte("4080"),
+		{
+			name:  "tie broken by earliest vote",
+			votes: []*ethpb.Eth1Data{c, a, a, c},
+			want:  c,
 		},
-		Eth1Data: &ethpb.Eth1Data{
-			DepositCount: 55,
+		{
+			name:  "invalid votes ignored",
+			votes: []*ethpb.Eth1Data{beforeRange, beforeRange, unknownBlock, unknownBlock, wrongDepositCount, wrongDepositCount, a},
+			want:  a,
 		},
+		{
+			name:  "no valid votes",
+			votes: []*ethpb.Eth1Data{beforeRange, unknownBlock},
+			want:  c,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headState := testutil.NewBeaconState()
+			if err := headState.SetEth1Data(&ethpb.Eth1Data{DepositCount: 1}); err != nil {
+				t.Fatal(err)
+			}
+			if err := headState.SetEth1DataVotes(tt.votes); err != nil {
+				t.Fatal(err)
+			}
+			ps := &Server{
+				ChainStartFetcher: p,
+				Eth1InfoFetcher:   p,
+				Eth1BlockFetcher:  p,
+				HeadFetcher:       &mock.ChainService{State: headState},
+				DepositFetcher:    depositCache,
+			}
+
+			eth1Data, err := ps.eth1Data(context.Background(), headState, slot)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(eth1Data, tt.want) {
+				t.Errorf("Wanted vote %v, received %v", tt.want, eth1Data)
+			}
+		})
+	}
+}
+
+func TestEth1Data_IgnoresVotesBelowStateDepositCount(t *testing.T) {
+	p, depositCache, slot, first, last := eth1ChainWithDeposits(t)
+	a := eth1DataOfBlock(p, depositCache, first)
+	b := eth1DataOfBlock(p, depositCache, last)
+
+	headState := testutil.NewBeaconState()
+	if err := headState.SetEth1Data(&ethpb.Eth1Data{DepositCount: a.DepositCount + 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := headState.SetEth1DataVotes([]*ethpb.Eth1Data{a, a, a, b}); err != nil {
+		t.Fatal(err)
 	}
 	ps := &Server{
 		ChainStartFetcher: p,
 		Eth1InfoFetcher:   p,
 		Eth1BlockFetcher:  p,
-		HeadFetcher:       &mock.ChainService{ETH1Data: &ethpb.Eth1Data{DepositCount: 10}},
+		HeadFetcher:       &mock.ChainService{State: headState},
 		DepositFetcher:    depositCache,
 	}
 
-	ctx := context.Background()
-	eth1Data, err := ps.eth1Data(ctx, slot)
+	eth1Data, err := ps.eth1Data(context.Background(), headState, slot)
 	if err != nil {
 		t.Fatal(err)
 	}
+	if !proto.Equal(eth1Data, b) {
+		t.Errorf("Wanted vote %v, received %v", b, eth1Data)
+	}
+}
 
-	// Will default to 10 as the current deposit count in the
-	// cache is only 2.
-	if eth1Data.DepositCount != 10 {
-		t.Errorf("Expected deposit count to be 10 but got %d", eth1Data.DepositCount)
+func TestBuildBlock_IgnoresEth1DataVotesOfPreviousPeriod(t *testing.T) {
+	p, depositCache, slot, first, last := eth1ChainWithDeposits(t)
+	a := eth1DataOfBlock(p, depositCache, first)
+	periodSlots := params.BeaconConfig().EpochsPerEth1VotingPeriod * params.BeaconConfig().SlotsPerEpoch
+	periodStartSlot := slot - slot%periodSlots
+
+	// The parent state is at the last slot of the previous voting period, with a majority for a.
+	parentState, _ := testutil.DeterministicGenesisState(t, 64)
+	if err := parentState.SetSlot(periodStartSlot - 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := parentState.SetEth1Data(eth1DataOfBlock(p, depositCache, 0)); err != nil {
+		t.Fatal(err)
+	}
+	if err := parentState.SetEth1DataVotes([]*ethpb.Eth1Data{a, a, a}); err != nil {
+		t.Fatal(err)
+	}
+	ps := &Server{
+		ChainStartFetcher:      p,
+		Eth1InfoFetcher:        p,
+		Eth1BlockFetcher:       p,
+		DepositFetcher:         depositCache,
+		PendingDepositsFetcher: depositCache,
+		AttPool:                attestations.NewPool(),
+		SlashingsPool:          slashings.NewPool(),
+		ExitPool:               voluntaryexits.NewPool(),
+	}
+
+	blk, err := ps.BuildBlock(context.Background(), periodStartSlot, [32]byte{}, parentState, make([]byte, 96), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := eth1DataOfBlock(p, depositCache, last); !proto.Equal(blk.Body.Eth1Data, want) {
+		t.Errorf("Wanted vote for latest candidate block %v, received %v", want, blk.Body.Eth1Data)
 	}
 }
 
@@ -1336,7 +1588,7 @@ func TestEth1Data_MockEnabled(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	eth1Data, err := ps.eth1Data(ctx, 100)
+	eth1Data, err := ps.eth1Data(ctx, headState, 100)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1536,7 +1788,7 @@ func Benchmark_Eth1Data(b *testing.B) {
 	}
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, err := proposerServer.eth1Data(context.Background(), beaconState.Slot()+1)
+		_, err := proposerServer.eth1Data(context.Background(), beaconState, beaconState.Slot()+1)
 		if err != nil {
 			b.Fatal(err)
 		}
@@ -1638,7 +1890,7 @@ func TestDeposits_ReturnsEmptyList_IfLatestEth1DataEqGenesisEth1Block(t *testing
 
 	// It should also return the recent deposits after their follow window.
 	p.LatestBlockNumber = big.NewInt(0).Add(p.LatestBlockNumber, big.NewInt(10000))
-	deposits, err := bs.deposits(ctx, &ethpb.Eth1Data{})
+	deposits, err := bs.deposits(ctx, beaconState, &ethpb.Eth1Data{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1680,3 +1932,47 @@ func TestDeleteAttsInPool_Aggregated(t *testing.T) {
 		t.Error("Did not delete unaggregated attestation")
 	}
 }
+
+// eth1ChainWithDeposits builds a mock eth1 chain with a block every SECONDS_PER_ETH1_BLOCK from
+// timestamp 0 and a deposit in every block. It returns a proposal slot with the first and last
+// candidate block heights of its eth1 voting period.
+func eth1ChainWithDeposits(t *testing.T) (*mockPOW.POWChain, *depositcache.DepositCache, uint64, uint64, uint64) {
+	cfg := params.BeaconConfig()
+	periodSlots := cfg.EpochsPerEth1VotingPeriod * cfg.SlotsPerEpoch
+	followTime := cfg.SecondsPerETH1Block * cfg.Eth1FollowDistance
+	periodStartSlot := (2*followTime/(periodSlots*cfg.SecondsPerSlot) + 1) * periodSlots
+	periodStart := periodStartSlot * cfg.SecondsPerSlot
+	first := (periodStart - 2*followTime + cfg.SecondsPerETH1Block - 1) / cfg.SecondsPerETH1Block
+	last := (periodStart - followTime) / cfg.SecondsPerETH1Block
+
+	p := &mockPOW.POWChain{
+		HashesByHeight: make(map[int][]byte),
+		TimesByHeight:  make(map[int]uint64),
+	}
+	depositCache := depositcache.NewDepositCache()
+	for h := uint64(0); h <= last+cfg.Eth1FollowDistance; h++ {
+		p.HashesByHeight[int(h)] = []byte(fmt.Sprintf("block%d", h))
+		p.TimesByHeight[int(h)] = h * cfg.SecondsPerETH1Block
+		deposit := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             []byte(fmt.Sprintf("key%d", h)),
+				Signature:             make([]byte, 96),
+				WithdrawalCredentials: make([]byte, 32),
+			},
+		}
+		depositCache.InsertDeposit(context.Background(), deposit, h, int64(h), bytesutil.ToBytes32([]byte(fmt.Sprintf("root%d", h))))
+	}
+	// Propose in the middle of the voting period.
+	return p, depositCache, periodStartSlot + periodSlots/2, first, last
+}
+
+// eth1DataOfBlock returns the eth1data of the mock eth1 chain block at the given height.
+func eth1DataOfBlock(p *mockPOW.POWChain, depositCache *depositcache.DepositCache, height uint64) *ethpb.Eth1Data {
+	count, root := depositCache.DepositsNumberAndRootAtHeight(context.Background(), big.NewInt(int64(height)))
+	hash := bytesutil.ToBytes32(p.HashesByHeight[int(height)])
+	return &ethpb.Eth1Data{
+		DepositRoot:  root[:],
+		DepositCount: count,
+		BlockHash:    hash[:],
+	}
+}
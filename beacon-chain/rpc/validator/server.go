@@ -71,6 +71,7 @@ type Server struct {
 	PendingDepositsFetcher depositcache.PendingDepositsFetcher
 	OperationNotifier      opfeed.Notifier
 	StateGen               *stategen.State
+	DutiesCache            *cache.DutiesCache
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
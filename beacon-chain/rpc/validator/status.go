@@ -8,6 +8,7 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -214,28 +215,10 @@ func assignmentStatus(beaconState *stateTrie.BeaconState, validatorIdx uint64) e
 	if err != nil {
 		return ethpb.ValidatorStatus_UNKNOWN_STATUS
 	}
-	currentEpoch := helpers.CurrentEpoch(beaconState)
-	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
-
 	if validator == nil {
 		return ethpb.ValidatorStatus_UNKNOWN_STATUS
 	}
-	if currentEpoch < validator.ActivationEligibilityEpoch() {
-		return ethpb.ValidatorStatus_DEPOSITED
-	}
-	if currentEpoch < validator.ActivationEpoch() {
-		return ethpb.ValidatorStatus_PENDING
-	}
-	if validator.ExitEpoch() == farFutureEpoch {
-		return ethpb.ValidatorStatus_ACTIVE
-	}
-	if currentEpoch < validator.ExitEpoch() {
-		if validator.Slashed() {
-			return ethpb.ValidatorStatus_SLASHING
-		}
-		return ethpb.ValidatorStatus_EXITING
-	}
-	return ethpb.ValidatorStatus_EXITED
+	return validators.AssignmentStatus(validator, helpers.CurrentEpoch(beaconState))
 }
 
 func (vs *Server) depositBlockSlot(ctx context.Context, beaconState *stateTrie.BeaconState, eth1BlockNumBigInt *big.Int) (uint64, error) {
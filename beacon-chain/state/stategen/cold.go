@@ -28,6 +28,11 @@ func (s *State) saveColdState(ctx context.Context, blockRoot [32]byte, state *st
 	if err := s.beaconDB.SaveArchivedPointRoot(ctx, blockRoot, archivedIndex); err != nil {
 		return err
 	}
+	if archivedIndex > 0 {
+		if err := s.saveArchivedPointDiff(ctx, archivedIndex-1); err != nil {
+			return err
+		}
+	}
 
 	log.WithFields(logrus.Fields{
 		"slot":      state.Slot(),
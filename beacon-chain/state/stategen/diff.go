@@ -0,0 +1,242 @@
+package stategen
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// This replaces the full state of the input archived point with a diff against the state of the
+// last archived point saved in full. Every `archivedPointsPerFullState`th archived point keeps its
+// full state, as does an archived point whose base state is not saved in full.
+func (s *State) saveArchivedPointDiff(ctx context.Context, archivedIndex uint64) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.saveArchivedPointDiff")
+	defer span.End()
+
+	if s.archivedPointsPerFullState <= 1 || archivedIndex%s.archivedPointsPerFullState == 0 {
+		return nil
+	}
+	if !s.beaconDB.HasArchivedPoint(ctx, archivedIndex) {
+		return nil
+	}
+	root := s.beaconDB.ArchivedPointRoot(ctx, archivedIndex)
+	archivedState, err := s.beaconDB.State(ctx, root)
+	if err != nil {
+		return err
+	}
+	// The archived point is already saved as a diff.
+	if archivedState == nil {
+		return nil
+	}
+
+	baseIndex := archivedIndex - archivedIndex%s.archivedPointsPerFullState
+	var baseRoot [32]byte
+	if s.beaconDB.HasArchivedPoint(ctx, baseIndex) {
+		baseRoot = s.beaconDB.ArchivedPointRoot(ctx, baseIndex)
+	} else if baseIndex == 0 {
+		baseRoot, err = s.genesisRoot(ctx)
+		if err != nil {
+			return err
+		}
+	} else {
+		return nil
+	}
+	baseState, err := s.beaconDB.State(ctx, baseRoot)
+	if err != nil {
+		return err
+	}
+	if baseState == nil {
+		return nil
+	}
+
+	diff, err := computeStateDiff(baseState.InnerStateUnsafe(), archivedState.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	diff.BaseRoot = baseRoot[:]
+	if err := s.beaconDB.SaveStateDiff(ctx, diff, root); err != nil {
+		return err
+	}
+	if err := s.beaconDB.DeleteState(ctx, root); err != nil {
+		return err
+	}
+
+	log.WithFields(logrus.Fields{
+		"slot":         archivedState.Slot(),
+		"archiveIndex": archivedIndex,
+		"baseSlot":     baseState.Slot(),
+		"root":         hex.EncodeToString(bytesutil.Trunc(root[:])),
+	}).Info("Replaced archived state with diff")
+
+	return nil
+}
+
+// This reconstructs the state of the input block root from its diff against a full state.
+// It returns nil if the state of the block root is not saved as a diff.
+func (s *State) stateFromDiff(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.stateFromDiff")
+	defer span.End()
+
+	diff, err := s.beaconDB.StateDiff(ctx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if diff == nil {
+		return nil, nil
+	}
+	baseState, err := s.beaconDB.State(ctx, bytesutil.ToBytes32(diff.BaseRoot))
+	if err != nil {
+		return nil, err
+	}
+	if baseState == nil {
+		return nil, errors.Wrapf(errUnknownState, "could not get base state of diff at slot %d", diff.PartialState.Slot)
+	}
+	// The base state is freshly read from the DB, so it can be reused as is.
+	diffedState, err := applyStateDiff(baseState.InnerStateUnsafe(), diff)
+	if err != nil {
+		return nil, err
+	}
+	return state.InitializeFromProtoUnsafe(diffedState)
+}
+
+// computeStateDiff returns the changes of the input state against the base state. The validator
+// registry and historical roots only grow, so the base state's are a prefix of the state's.
+func computeStateDiff(base *pb.BeaconState, st *pb.BeaconState) (*dbpb.StateDiff, error) {
+	if len(st.Validators) < len(base.Validators) || len(st.HistoricalRoots) < len(base.HistoricalRoots) {
+		return nil, errors.New("state is not a descendant of base state")
+	}
+	if len(st.BlockRoots) != len(base.BlockRoots) || len(st.StateRoots) != len(base.StateRoots) ||
+		len(st.RandaoMixes) != len(base.RandaoMixes) || len(st.Slashings) != len(base.Slashings) {
+		return nil, errors.New("state and base state vectors differ in length")
+	}
+
+	partial := *st
+	partial.Validators = nil
+	partial.Balances = nil
+	partial.BlockRoots = nil
+	partial.StateRoots = nil
+	partial.RandaoMixes = nil
+	partial.Slashings = nil
+	partial.HistoricalRoots = nil
+
+	diff := &dbpb.StateDiff{
+		BaseSlot:        base.Slot,
+		PartialState:    &partial,
+		Validators:      make([]*dbpb.ValidatorDiff, 0),
+		BalanceDeltas:   make([]int64, len(st.Balances)),
+		BlockRoots:      diffRoots(base.BlockRoots, st.BlockRoots),
+		StateRoots:      diffRoots(base.StateRoots, st.StateRoots),
+		RandaoMixes:     diffRoots(base.RandaoMixes, st.RandaoMixes),
+		Slashings:       make([]*dbpb.SlashingDiff, 0),
+		HistoricalRoots: st.HistoricalRoots[len(base.HistoricalRoots):],
+	}
+	for i, v := range st.Validators {
+		if i < len(base.Validators) && proto.Equal(v, base.Validators[i]) {
+			continue
+		}
+		diff.Validators = append(diff.Validators, &dbpb.ValidatorDiff{Index: uint64(i), Validator: v})
+	}
+	for i, b := range st.Balances {
+		var baseBalance uint64
+		if i < len(base.Balances) {
+			baseBalance = base.Balances[i]
+		}
+		diff.BalanceDeltas[i] = int64(b - baseBalance)
+	}
+	for i, slashing := range st.Slashings {
+		if slashing != base.Slashings[i] {
+			diff.Slashings = append(diff.Slashings, &dbpb.SlashingDiff{Index: uint64(i), Slashing: slashing})
+		}
+	}
+	return diff, nil
+}
+
+// applyStateDiff returns the state the diff was computed for from the base state. The returned
+// state shares the validators and the fixed size vectors of the base state, which are updated in place.
+func applyStateDiff(base *pb.BeaconState, diff *dbpb.StateDiff) (*pb.BeaconState, error) {
+	if diff.PartialState == nil {
+		return nil, errors.New("state diff has no partial state")
+	}
+	st := proto.Clone(diff.PartialState).(*pb.BeaconState)
+
+	validators := base.Validators
+	for _, v := range diff.Validators {
+		switch {
+		case v.Index < uint64(len(validators)):
+			validators[v.Index] = v.Validator
+		case v.Index == uint64(len(validators)):
+			validators = append(validators, v.Validator)
+		default:
+			return nil, errors.Errorf("validator index %d out of range", v.Index)
+		}
+	}
+	if len(validators) != len(diff.BalanceDeltas) {
+		return nil, errors.Errorf("state diff has %d balances for %d validators", len(diff.BalanceDeltas), len(validators))
+	}
+	balances := make([]uint64, len(diff.BalanceDeltas))
+	for i, delta := range diff.BalanceDeltas {
+		var baseBalance uint64
+		if i < len(base.Balances) {
+			baseBalance = base.Balances[i]
+		}
+		balances[i] = baseBalance + uint64(delta)
+	}
+	for _, slashing := range diff.Slashings {
+		if slashing.Index >= uint64(len(base.Slashings)) {
+			return nil, errors.Errorf("slashings index %d out of range", slashing.Index)
+		}
+		base.Slashings[slashing.Index] = slashing.Slashing
+	}
+	for _, roots := range []struct {
+		vector [][]byte
+		diffs  []*dbpb.RootDiff
+	}{
+		{vector: base.BlockRoots, diffs: diff.BlockRoots},
+		{vector: base.StateRoots, diffs: diff.StateRoots},
+		{vector: base.RandaoMixes, diffs: diff.RandaoMixes},
+	} {
+		if err := applyRoots(roots.vector, roots.diffs); err != nil {
+			return nil, err
+		}
+	}
+
+	st.Validators = validators
+	st.Balances = balances
+	st.BlockRoots = base.BlockRoots
+	st.StateRoots = base.StateRoots
+	st.RandaoMixes = base.RandaoMixes
+	st.Slashings = base.Slashings
+	st.HistoricalRoots = append(base.HistoricalRoots, diff.HistoricalRoots...)
+	return st, nil
+}
+
+// This returns the entries of a fixed size root vector which changed since the base vector.
+func diffRoots(base [][]byte, roots [][]byte) []*dbpb.RootDiff {
+	diffs := make([]*dbpb.RootDiff, 0)
+	for i, r := range roots {
+		if !bytes.Equal(r, base[i]) {
+			diffs = append(diffs, &dbpb.RootDiff{Index: uint64(i), Root: r})
+		}
+	}
+	return diffs
+}
+
+// This updates the entries of a fixed size root vector in place.
+func applyRoots(vector [][]byte, diffs []*dbpb.RootDiff) error {
+	for _, d := range diffs {
+		if d.Index >= uint64(len(vector)) {
+			return errors.Errorf("root index %d out of range", d.Index)
+		}
+		vector[d.Index] = d.Root
+	}
+	return nil
+}
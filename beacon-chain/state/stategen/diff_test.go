@@ -0,0 +1,146 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func diffedTestState(t *testing.T, base *stateTrie.BeaconState, slot uint64) *stateTrie.BeaconState {
+	st := base.Copy()
+	if err := st.SetSlot(slot); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateBalancesAtIndex(0, base.Balances()[0]-100); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateBalancesAtIndex(1, base.Balances()[1]+100); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateValidatorAtIndex(2, &ethpb.Validator{PublicKey: []byte{'a'}, ExitEpoch: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.AppendValidator(&ethpb.Validator{PublicKey: []byte{'b'}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.AppendBalance(32); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateBlockRootAtIndex(5, [32]byte{'c'}); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateStateRootAtIndex(6, [32]byte{'d'}); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateRandaoMixesAtIndex(7, []byte{'e'}); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateSlashingsAtIndex(8, 1000); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.AppendHistoricalRoots([32]byte{'f'}); err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+func TestStateDiff_RoundTrip(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	st := diffedTestState(t, base, 100)
+
+	diff, err := computeStateDiff(base.InnerStateUnsafe(), st.InnerStateUnsafe())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Validators) != 2 || diff.Validators[0].Index != 2 || diff.Validators[1].Index != 32 {
+		t.Errorf("Wanted changed validator 2 and appended validator 32, got %v", diff.Validators)
+	}
+	if len(diff.BalanceDeltas) != 33 || diff.BalanceDeltas[0] != -100 || diff.BalanceDeltas[1] != 100 || diff.BalanceDeltas[32] != 32 {
+		t.Errorf("Unexpected balance deltas %v", diff.BalanceDeltas)
+	}
+	if len(diff.BlockRoots) != 1 || len(diff.StateRoots) != 1 || len(diff.RandaoMixes) != 1 || len(diff.Slashings) != 1 || len(diff.HistoricalRoots) != 1 {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+	if diff.PartialState.Slot != 100 || diff.PartialState.Validators != nil || diff.PartialState.BlockRoots != nil {
+		t.Errorf("Unexpected partial state %v", diff.PartialState)
+	}
+
+	applied, err := applyStateDiff(base.CloneInnerState(), diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(applied, st.InnerStateUnsafe()) {
+		t.Error("Applying state diff did not reconstruct the state")
+	}
+}
+
+func TestApplyStateDiff_MismatchedBalances(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	st := diffedTestState(t, base, 100)
+	diff, err := computeStateDiff(base.InnerStateUnsafe(), st.InnerStateUnsafe())
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff.BalanceDeltas = diff.BalanceDeltas[1:]
+	if _, err := applyStateDiff(base.CloneInnerState(), diff); err == nil {
+		t.Error("Wanted error applying diff with too few balances")
+	}
+}
+
+func TestSaveArchivedPointDiff_CanLoad(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	service := New(db, cache.NewStateSummaryCache())
+	service.slotsPerArchivedPoint = 32
+	service.archivedPointsPerFullState = 4
+
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	if err := base.SetSlot(128); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.saveColdState(ctx, [32]byte{'a'}, base); err != nil {
+		t.Fatal(err)
+	}
+	st := diffedTestState(t, base, 160)
+	if err := service.saveColdState(ctx, [32]byte{'b'}, st); err != nil {
+		t.Fatal(err)
+	}
+	// The last archived point stays full.
+	if !db.HasState(ctx, [32]byte{'b'}) {
+		t.Fatal("Wanted full state of last archived point")
+	}
+	next := diffedTestState(t, st, 192)
+	if err := service.saveColdState(ctx, [32]byte{'c'}, next); err != nil {
+		t.Fatal(err)
+	}
+
+	if !db.HasState(ctx, [32]byte{'a'}) {
+		t.Error("Wanted full state of archived point 4")
+	}
+	if db.HasState(ctx, [32]byte{'b'}) {
+		t.Error("Wanted archived point 5 saved as diff")
+	}
+	diff, err := db.StateDiff(ctx, [32]byte{'b'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.BaseSlot != 128 {
+		t.Fatalf("Wanted diff against archived point 4, got %v", diff)
+	}
+
+	// Archived point 5 is the highest one below the queried slot.
+	loaded, err := service.archivedState(ctx, params.BeaconConfig().SlotsPerArchivedPoint*6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(loaded.InnerStateUnsafe(), st.InnerStateUnsafe()) {
+		t.Error("Did not reconstruct archived state from diff")
+	}
+}
@@ -83,12 +83,23 @@ func (s *State) StateByRootInitialSync(ctx context.Context, blockRoot [32]byte)
 // StateBySlot retrieves the state from DB using input slot.
 // It retrieves state from the cold section if the input slot
 // is below the split point cut off.
+// Concurrent queries of the same slot share the regeneration of the state,
+// and recently regenerated finalized states are cached.
 // Note: `StateByRoot` is preferred over this. Retrieving state
 // by root `StateByRoot` is more performant than retrieving by slot.
 func (s *State) StateBySlot(ctx context.Context, slot uint64) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.StateBySlot")
 	defer span.End()
 
+	return s.historicalStates.get(ctx, slot, slot < s.splitInfo.slot, s.stateBySlot)
+}
+
+// This retrieves the state from DB using input slot, regenerating it from the
+// cold or the hot section.
+func (s *State) stateBySlot(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.stateBySlot")
+	defer span.End()
+
 	if slot < s.splitInfo.slot {
 		return s.loadColdStateBySlot(ctx, slot)
 	}
@@ -0,0 +1,131 @@
+package stategen
+
+import (
+	"context"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// historicalStateCacheSize defines the max number of regenerated historical states to cache.
+	historicalStateCacheSize = 16
+	// historicalStateWorkers defines the max number of historical states regenerated at once.
+	historicalStateWorkers = 4
+)
+
+// historicalStates serves states by slot to the historical queries. Identical queries share a single
+// regeneration, finalized states regenerated recently are cached and the number of regenerations
+// running at once is bounded. A regeneration is canceled once every query waiting on it is canceled.
+type historicalStates struct {
+	cache   *lru.Cache
+	workers chan struct{}
+	calls   map[uint64]*historicalCall
+	lock    sync.Mutex
+}
+
+// historicalCall is a regeneration of the state at a slot, shared by the queries waiting on it.
+type historicalCall struct {
+	done    chan struct{}
+	state   *state.BeaconState
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+func newHistoricalStates() *historicalStates {
+	cache, err := lru.New(historicalStateCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &historicalStates{
+		cache:   cache,
+		workers: make(chan struct{}, historicalStateWorkers),
+		calls:   make(map[uint64]*historicalCall),
+	}
+}
+
+// get returns a copy of the state at the input slot, using regen to regenerate it unless it is cached or
+// already being regenerated. Only finalized states are cached, as they can no longer change.
+func (h *historicalStates) get(
+	ctx context.Context,
+	slot uint64,
+	finalized bool,
+	regen func(context.Context, uint64) (*state.BeaconState, error),
+) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.historicalStates.get")
+	defer span.End()
+
+	h.lock.Lock()
+	if item, ok := h.cache.Get(slot); ok {
+		h.lock.Unlock()
+		historicalStateCacheHit.Inc()
+		return item.(*state.BeaconState).Copy(), nil
+	}
+	historicalStateCacheMiss.Inc()
+	call, ok := h.calls[slot]
+	if ok {
+		historicalStateDeduplicated.Inc()
+	} else {
+		// The regeneration outlives the query which started it, if other queries wait on it.
+		callCtx, cancel := context.WithCancel(context.Background())
+		call = &historicalCall{done: make(chan struct{}), cancel: cancel}
+		h.calls[slot] = call
+		go h.regenerate(callCtx, call, slot, finalized, regen)
+	}
+	call.waiters++
+	h.lock.Unlock()
+
+	select {
+	case <-call.done:
+		if call.err != nil {
+			return nil, call.err
+		}
+		return call.state.Copy(), nil
+	case <-ctx.Done():
+		h.lock.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// Later queries start a new regeneration rather than wait on the canceled one.
+			if h.calls[slot] == call {
+				delete(h.calls, slot)
+			}
+			call.cancel()
+		}
+		h.lock.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// This regenerates the state of a call once a worker is available, and caches it if finalized.
+func (h *historicalStates) regenerate(
+	ctx context.Context,
+	call *historicalCall,
+	slot uint64,
+	finalized bool,
+	regen func(context.Context, uint64) (*state.BeaconState, error),
+) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.historicalStates.regenerate")
+	defer span.End()
+	defer call.cancel()
+
+	select {
+	case h.workers <- struct{}{}:
+		call.state, call.err = regen(ctx, slot)
+		<-h.workers
+	case <-ctx.Done():
+		call.err = ctx.Err()
+	}
+
+	h.lock.Lock()
+	if h.calls[slot] == call {
+		delete(h.calls, slot)
+	}
+	if call.err == nil && call.state != nil && finalized {
+		h.cache.Add(slot, call.state)
+	}
+	h.lock.Unlock()
+	close(call.done)
+}
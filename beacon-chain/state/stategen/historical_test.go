@@ -0,0 +1,178 @@
+package stategen
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func testRegen(t *testing.T, calls *int32) func(context.Context, uint64) (*stateTrie.BeaconState, error) {
+	return func(_ context.Context, slot uint64) (*stateTrie.BeaconState, error) {
+		atomic.AddInt32(calls, 1)
+		st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: slot})
+		if err != nil {
+			t.Error(err)
+		}
+		return st, err
+	}
+}
+
+func TestHistoricalStates_DeduplicatesQueries(t *testing.T) {
+	h := newHistoricalStates()
+	var calls int32
+	release := make(chan struct{})
+	regen := testRegen(t, &calls)
+	blockingRegen := func(ctx context.Context, slot uint64) (*stateTrie.BeaconState, error) {
+		<-release
+		return regen(ctx, slot)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			st, err := h.get(context.Background(), 5, false, blockingRegen)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if st.Slot() != 5 {
+				t.Errorf("Wanted slot 5, got %d", st.Slot())
+			}
+		}()
+	}
+	// Wait for every query to wait on the regeneration before releasing it.
+	for {
+		h.lock.Lock()
+		call, ok := h.calls[5]
+		waiters := 0
+		if ok {
+			waiters = call.waiters
+		}
+		h.lock.Unlock()
+		if waiters == 10 {
+			break
+		}
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("Wanted 1 regeneration, got %d", calls)
+	}
+}
+
+func TestHistoricalStates_CachesFinalizedStates(t *testing.T) {
+	h := newHistoricalStates()
+	var calls int32
+	regen := testRegen(t, &calls)
+
+	for i := 0; i < 2; i++ {
+		if _, err := h.get(context.Background(), 1, true, regen); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Wanted finalized state regenerated once, got %d", calls)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := h.get(context.Background(), 2, false, regen); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("Wanted non finalized state regenerated twice, got %d", calls-1)
+	}
+
+	// Mutating a returned state does not affect the cached one.
+	st, err := h.get(context.Background(), 1, true, regen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := st.SetSlot(100); err != nil {
+		t.Fatal(err)
+	}
+	st, err = h.get(context.Background(), 1, true, regen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Slot() != 1 {
+		t.Errorf("Wanted cached state at slot 1, got %d", st.Slot())
+	}
+}
+
+func TestHistoricalStates_CancelsRegeneration(t *testing.T) {
+	h := newHistoricalStates()
+	canceled := make(chan struct{})
+	started := make(chan struct{})
+	regen := func(ctx context.Context, slot uint64) (*stateTrie.BeaconState, error) {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+		return nil, ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	if _, err := h.get(ctx, 1, true, regen); err != context.Canceled {
+		t.Errorf("Wanted context canceled error, got %v", err)
+	}
+	<-canceled
+
+	// A later query regenerates the state again.
+	var calls int32
+	if _, err := h.get(context.Background(), 1, true, testRegen(t, &calls)); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("Wanted new regeneration, got %d", calls)
+	}
+}
+
+func TestHistoricalStates_BoundsWorkers(t *testing.T) {
+	h := newHistoricalStates()
+	var running, maxRunning int32
+	var calls int32
+	regen := testRegen(t, &calls)
+	boundedRegen := func(ctx context.Context, slot uint64) (*stateTrie.BeaconState, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		defer atomic.AddInt32(&running, -1)
+		return regen(ctx, slot)
+	}
+
+	var wg sync.WaitGroup
+	for i := uint64(0); i < 4*historicalStateWorkers; i++ {
+		wg.Add(1)
+		go func(slot uint64) {
+			defer wg.Done()
+			if _, err := h.get(context.Background(), slot, false, boundedRegen); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if maxRunning > historicalStateWorkers {
+		t.Errorf("Wanted at most %d regenerations at once, got %d", historicalStateWorkers, maxRunning)
+	}
+	if calls != 4*historicalStateWorkers {
+		t.Errorf("Wanted %d regenerations, got %d", 4*historicalStateWorkers, calls)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/hex"
 
 	"github.com/pkg/errors"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -22,9 +21,9 @@ func (s *State) HasState(ctx context.Context, blockRoot [32]byte) bool {
 	return s.beaconDB.HasState(ctx, blockRoot)
 }
 
-// This saves a post finalized beacon state in the hot section of the DB. On the epoch boundary,
-// it saves a full state. On an intermediate slot, it saves a back pointer to the
-// nearest epoch boundary state.
+// This saves a post finalized beacon state in the hot section of the DB. When the snapshot policy
+// calls for it, such as on the epoch boundary, it saves a full state. Otherwise, it saves a back
+// pointer to the nearest saved state.
 func (s *State) saveHotState(ctx context.Context, blockRoot [32]byte, state *state.BeaconState) error {
 	ctx, span := trace.StartSpan(ctx, "stateGen.saveHotState")
 	defer span.End()
@@ -34,14 +33,28 @@ func (s *State) saveHotState(ctx context.Context, blockRoot [32]byte, state *sta
 		return nil
 	}
 
-	// Only on an epoch boundary slot, saves the whole state.
-	if helpers.IsEpochStart(state.Slot()) {
+	// Only when the snapshot policy calls for it, saves the whole state.
+	reason, err := s.snapshotReason(ctx, state)
+	if err != nil {
+		return errors.Wrap(err, "could not apply hot state snapshot policy")
+	}
+	if reason != "" {
 		if err := s.beaconDB.SaveState(ctx, state, blockRoot); err != nil {
 			return err
 		}
+		hotStateSnapshots.WithLabelValues(reason).Inc()
+		s.resizeHotStateCache(state)
+		msg := "Saved full state on epoch boundary"
+		switch reason {
+		case snapshotArchivedPoint:
+			msg = "Saved full state on archived point"
+		case snapshotReplayCost:
+			msg = "Saved full state to bound replay cost"
+		}
 		log.WithFields(logrus.Fields{
 			"slot":      state.Slot(),
-			"blockRoot": hex.EncodeToString(bytesutil.Trunc(blockRoot[:]))}).Info("Saved full state on epoch boundary")
+			"reason":    reason,
+			"blockRoot": hex.EncodeToString(bytesutil.Trunc(blockRoot[:]))}).Info(msg)
 	}
 
 	// On an intermediate slots, save the hot state summary.
@@ -0,0 +1,33 @@
+package stategen
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	hotStateSnapshots = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "hot_state_snapshots_total",
+		Help: "The number of hot states saved in full to the DB, by reason.",
+	}, []string{"reason"})
+	blockReplayCost = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "hot_state_block_replay_seconds",
+		Help: "The moving average of the time it takes to replay a block to regenerate a state.",
+	})
+	hotStateCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "hot_state_cache_capacity",
+		Help: "The number of hot states that fit in the hot state cache memory budget.",
+	})
+	historicalStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_state_cache_hit",
+		Help: "The total number of cache hits on the historical state cache.",
+	})
+	historicalStateCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_state_cache_miss",
+		Help: "The total number of cache misses on the historical state cache.",
+	})
+	historicalStateDeduplicated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_state_deduplicated_total",
+		Help: "The total number of historical state queries which waited on an identical query's regeneration.",
+	})
+)
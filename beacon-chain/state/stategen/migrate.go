@@ -75,6 +75,12 @@ func (s *State) MigrateToCold(ctx context.Context, finalizedSlot uint64, finaliz
 				return err
 			}
 			lastArchivedIndex++
+			// The previous archived point is no longer the last one, which is kept full to resume from.
+			if archivedPointIndex > 0 {
+				if err := s.saveArchivedPointDiff(ctx, archivedPointIndex-1); err != nil {
+					log.Warnf("Unable to save archived state as diff during migration: %v", err)
+				}
+			}
 			log.WithFields(logrus.Fields{
 				"slot":         stateSummary.Slot,
 				"archiveIndex": archivedPointIndex,
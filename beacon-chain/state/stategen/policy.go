@@ -0,0 +1,193 @@
+package stategen
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	// Used when the corresponding flag is not set.
+	defaultHotStateReplayBudget      = 3 * time.Second
+	defaultHotStateCacheMemory       = 2048 << 20
+	defaultHotStateNonFinalityEpochs = 16
+	// The replay cost of a block assumed until one has been measured.
+	defaultBlockReplayCost = 50 * time.Millisecond
+	// A new replay measurement contributes 1/replayCostWeight to the moving average.
+	replayCostWeight = 5
+	// The in memory state trie takes roughly this many times its encoded size.
+	stateMemoryFactor = 2
+	// The hot state cache always holds at least this many states, whatever the memory budget.
+	minHotStateCacheSize = 2
+)
+
+// Reasons a hot state snapshot is saved, used as metric label.
+const (
+	snapshotArchivedPoint = "archived_point"
+	snapshotEpochBoundary = "epoch_boundary"
+	snapshotReplayCost    = "replay_cost"
+)
+
+// snapshotPolicy decides when a hot state gets saved in full to the DB, and how many
+// hot states fit in the cache. It keeps the time to regenerate a hot state within the
+// replay budget using the measured cost of replaying blocks, thins out epoch boundary
+// snapshots the longer the chain goes without finality, and bounds the cache by memory
+// rather than by number of states.
+type snapshotPolicy struct {
+	replayBudget      time.Duration
+	memoryBudget      uint64
+	nonFinalityEpochs uint64
+	blockReplayCost   time.Duration
+	lock              sync.RWMutex
+}
+
+// newSnapshotPolicy creates a snapshot policy from the global flags, using defaults for the unset ones.
+func newSnapshotPolicy(cfg *flags.GlobalFlags) *snapshotPolicy {
+	p := &snapshotPolicy{
+		replayBudget:      cfg.HotStateReplayBudget,
+		memoryBudget:      cfg.HotStateCacheMemory,
+		nonFinalityEpochs: cfg.HotStateNonFinalityEpochs,
+		blockReplayCost:   defaultBlockReplayCost,
+	}
+	if p.replayBudget == 0 {
+		p.replayBudget = defaultHotStateReplayBudget
+	}
+	if p.memoryBudget == 0 {
+		p.memoryBudget = defaultHotStateCacheMemory
+	}
+	if p.nonFinalityEpochs == 0 {
+		p.nonFinalityEpochs = defaultHotStateNonFinalityEpochs
+	}
+	return p
+}
+
+// recordReplay updates the moving average of the block replay cost with a replay of
+// the given number of blocks that took the given time.
+func (p *snapshotPolicy) recordReplay(blocks int, elapsed time.Duration) {
+	if p == nil || blocks == 0 {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	sample := elapsed / time.Duration(blocks)
+	p.blockReplayCost += (sample - p.blockReplayCost) / replayCostWeight
+	blockReplayCost.Set(p.blockReplayCost.Seconds())
+}
+
+// replayWindow returns the number of blocks which can be replayed within the replay budget.
+func (p *snapshotPolicy) replayWindow() uint64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if p.blockReplayCost <= 0 {
+		return uint64(p.replayBudget / defaultBlockReplayCost)
+	}
+	window := uint64(p.replayBudget / p.blockReplayCost)
+	if window == 0 {
+		return 1
+	}
+	return window
+}
+
+// epochBoundaryInterval returns every how many epochs an epoch boundary state is saved, given
+// the number of epochs since finality. Up to the non finality threshold every epoch boundary
+// state is saved, past it the interval grows by one epoch per threshold epochs, up to the
+// archived point interval.
+func (p *snapshotPolicy) epochBoundaryInterval(epochsSinceFinality uint64, maxInterval uint64) uint64 {
+	if epochsSinceFinality <= p.nonFinalityEpochs {
+		return 1
+	}
+	interval := epochsSinceFinality/p.nonFinalityEpochs + 1
+	if maxInterval > 0 && interval > maxInterval {
+		return maxInterval
+	}
+	return interval
+}
+
+// cacheSize returns the number of hot states of the given encoded size that fit in the memory budget.
+func (p *snapshotPolicy) cacheSize(stateSize uint64) int {
+	if stateSize == 0 {
+		return minHotStateCacheSize
+	}
+	size := p.memoryBudget / (stateSize * stateMemoryFactor)
+	if size < minHotStateCacheSize {
+		return minHotStateCacheSize
+	}
+	return int(size)
+}
+
+// This returns the reason to save the input hot state in full to the DB, or an empty string
+// if the hot state should only be saved as a summary.
+func (s *State) snapshotReason(ctx context.Context, state *state.BeaconState) (string, error) {
+	slot := state.Slot()
+	if s.slotsPerArchivedPoint > 0 && slot%s.slotsPerArchivedPoint == 0 {
+		return snapshotArchivedPoint, nil
+	}
+
+	if helpers.IsEpochStart(slot) {
+		epoch := helpers.SlotToEpoch(slot)
+		var epochsSinceFinality uint64
+		if splitEpoch := helpers.SlotToEpoch(s.splitInfo.slot); epoch > splitEpoch {
+			epochsSinceFinality = epoch - splitEpoch
+		}
+		maxInterval := s.slotsPerArchivedPoint / params.BeaconConfig().SlotsPerEpoch
+		if epoch%s.snapshotPolicy.epochBoundaryInterval(epochsSinceFinality, maxInterval) == 0 {
+			return snapshotEpochBoundary, nil
+		}
+	}
+
+	window := s.snapshotPolicy.replayWindow()
+	blocks, err := s.blocksSinceSnapshot(ctx, state, window)
+	if err != nil {
+		return "", err
+	}
+	if blocks >= window {
+		return snapshotReplayCost, nil
+	}
+	return "", nil
+}
+
+// This returns the number of blocks to replay to regenerate the input state from the last
+// ancestor state saved in the DB. It stops counting once the limit is reached.
+func (s *State) blocksSinceSnapshot(ctx context.Context, state *state.BeaconState, limit uint64) (uint64, error) {
+	historicalRoots := params.BeaconConfig().SlotsPerHistoricalRoot
+	// The block of the input state itself has to be replayed.
+	blocks := uint64(1)
+	var lastRoot [32]byte
+	for slot := state.Slot(); slot > s.splitInfo.slot && slot+historicalRoots > state.Slot() && blocks < limit; slot-- {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		r, err := state.BlockRootAtIndex((slot - 1) % historicalRoots)
+		if err != nil {
+			return 0, err
+		}
+		root := bytesutil.ToBytes32(r)
+		// Skipped slots repeat the root of the last block.
+		if root == lastRoot {
+			continue
+		}
+		lastRoot = root
+		if s.beaconDB.HasState(ctx, root) {
+			return blocks, nil
+		}
+		blocks++
+	}
+	return blocks, nil
+}
+
+// This resizes the hot state cache to fit the memory budget, based on the size of the input state.
+func (s *State) resizeHotStateCache(state *state.BeaconState) {
+	size := s.snapshotPolicy.cacheSize(uint64(state.InnerStateUnsafe().Size()))
+	if evicted := s.hotStateCache.Resize(size); evicted > 0 {
+		log.WithField("evicted", evicted).Debug("Evicted hot states to fit memory budget")
+	}
+	hotStateCacheSize.Set(float64(size))
+}
@@ -0,0 +1,143 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestSnapshotPolicy_Defaults(t *testing.T) {
+	p := newSnapshotPolicy(&flags.GlobalFlags{})
+	if p.replayBudget != defaultHotStateReplayBudget {
+		t.Errorf("Wanted replay budget %v, got %v", defaultHotStateReplayBudget, p.replayBudget)
+	}
+	if p.memoryBudget != defaultHotStateCacheMemory {
+		t.Errorf("Wanted memory budget %d, got %d", defaultHotStateCacheMemory, p.memoryBudget)
+	}
+	if p.nonFinalityEpochs != defaultHotStateNonFinalityEpochs {
+		t.Errorf("Wanted non finality epochs %d, got %d", defaultHotStateNonFinalityEpochs, p.nonFinalityEpochs)
+	}
+}
+
+func TestSnapshotPolicy_ReplayWindow(t *testing.T) {
+	p := newSnapshotPolicy(&flags.GlobalFlags{HotStateReplayBudget: time.Second})
+	if w := p.replayWindow(); w != 20 {
+		t.Errorf("Wanted default replay window 20, got %d", w)
+	}
+
+	// Replays slower than assumed shrink the window.
+	for i := 0; i < 50; i++ {
+		p.recordReplay(10, 2*time.Second)
+	}
+	if w := p.replayWindow(); w != 5 {
+		t.Errorf("Wanted replay window 5, got %d", w)
+	}
+
+	// Blocks slower than the whole budget still leave room for one block.
+	for i := 0; i < 50; i++ {
+		p.recordReplay(1, 10*time.Second)
+	}
+	if w := p.replayWindow(); w != 1 {
+		t.Errorf("Wanted replay window 1, got %d", w)
+	}
+}
+
+func TestSnapshotPolicy_EpochBoundaryInterval(t *testing.T) {
+	p := newSnapshotPolicy(&flags.GlobalFlags{HotStateNonFinalityEpochs: 4})
+	tests := []struct {
+		epochsSinceFinality uint64
+		interval            uint64
+	}{
+		{epochsSinceFinality: 0, interval: 1},
+		{epochsSinceFinality: 4, interval: 1},
+		{epochsSinceFinality: 5, interval: 2},
+		{epochsSinceFinality: 12, interval: 4},
+		{epochsSinceFinality: 100, interval: 8},
+	}
+	for _, tt := range tests {
+		if got := p.epochBoundaryInterval(tt.epochsSinceFinality, 8); got != tt.interval {
+			t.Errorf("epochBoundaryInterval(%d) = %d, wanted %d", tt.epochsSinceFinality, got, tt.interval)
+		}
+	}
+}
+
+func TestSnapshotPolicy_CacheSize(t *testing.T) {
+	p := newSnapshotPolicy(&flags.GlobalFlags{HotStateCacheMemory: 1000})
+	if size := p.cacheSize(50); size != 10 {
+		t.Errorf("Wanted cache size 10, got %d", size)
+	}
+	if size := p.cacheSize(1000); size != minHotStateCacheSize {
+		t.Errorf("Wanted minimum cache size %d, got %d", minHotStateCacheSize, size)
+	}
+}
+
+func TestSaveHotState_ThinsEpochBoundaryDuringNonFinality(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	service := New(db, cache.NewStateSummaryCache())
+	service.snapshotPolicy = newSnapshotPolicy(&flags.GlobalFlags{HotStateNonFinalityEpochs: 1})
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	// Two epochs since finality makes every third epoch boundary state saved.
+	if err := beaconState.SetSlot(4 * params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatal(err)
+	}
+	service.splitInfo.slot = 2 * params.BeaconConfig().SlotsPerEpoch
+	r := [32]byte{'A'}
+
+	if err := service.saveHotState(ctx, r, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	if service.beaconDB.HasState(ctx, r) {
+		t.Error("Should not have saved the state")
+	}
+	testutil.AssertLogsDoNotContain(t, hook, "Saved full state")
+}
+
+func TestSaveHotState_CanSaveOnReplayCost(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	service := New(db, cache.NewStateSummaryCache())
+	// Allows replaying 4 blocks.
+	service.snapshotPolicy = newSnapshotPolicy(&flags.GlobalFlags{HotStateReplayBudget: 4 * defaultBlockReplayCost})
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	if err := beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch + 10); err != nil {
+		t.Fatal(err)
+	}
+	// Blocks at slots 33 to 41, of which only the one at slot 33 has a saved state.
+	for slot := params.BeaconConfig().SlotsPerEpoch + 1; slot < beaconState.Slot(); slot++ {
+		if err := beaconState.UpdateBlockRootAtIndex(slot, [32]byte{byte(slot)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.SaveState(ctx, beaconState, [32]byte{byte(params.BeaconConfig().SlotsPerEpoch + 1)}); err != nil {
+		t.Fatal(err)
+	}
+
+	blocks, err := service.blocksSinceSnapshot(ctx, beaconState, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blocks != 9 {
+		t.Errorf("Wanted 9 blocks since snapshot, got %d", blocks)
+	}
+
+	r := [32]byte{'A'}
+	if err := service.saveHotState(ctx, r, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	if !service.beaconDB.HasState(ctx, r) {
+		t.Error("Should have saved the state")
+	}
+	testutil.AssertLogsContain(t, hook, "Saved full state to bound replay cost")
+}
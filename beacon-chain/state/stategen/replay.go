@@ -3,6 +3,7 @@ package stategen
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -69,6 +70,7 @@ func (s *State) ReplayBlocks(ctx context.Context, state *state.BeaconState, sign
 	ctx, span := trace.StartSpan(ctx, "stateGen.ReplayBlocks")
 	defer span.End()
 
+	start := time.Now()
 	var err error
 	// The input block list is sorted in decreasing slots order.
 	if len(signed) > 0 {
@@ -105,6 +107,7 @@ func (s *State) ReplayBlocks(ctx context.Context, state *state.BeaconState, sign
 			}
 		}
 	}
+	s.snapshotPolicy.recordReplay(len(signed), time.Since(start))
 
 	return state, nil
 }
@@ -334,13 +337,20 @@ func (s *State) archivedRoot(ctx context.Context, slot uint64) ([32]byte, error)
 	return [32]byte{}, errUnknownArchivedState
 }
 
-// This retrieves the archived state in the DB.
+// This retrieves the archived state in the DB, reconstructing it if it is saved as a diff.
 func (s *State) archivedState(ctx context.Context, slot uint64) (*state.BeaconState, error) {
 	archivedRoot, err := s.archivedRoot(ctx, slot)
 	if err != nil {
 		return nil, err
 	}
-	return s.beaconDB.State(ctx, archivedRoot)
+	archivedState, err := s.beaconDB.State(ctx, archivedRoot)
+	if err != nil {
+		return nil, err
+	}
+	if archivedState != nil {
+		return archivedState, nil
+	}
+	return s.stateFromDiff(ctx, archivedRoot)
 }
 
 // This recomputes a state given the block root.
@@ -9,6 +9,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -19,13 +20,16 @@ import (
 // State represents a management object that handles the internal
 // logic of maintaining both hot and cold states in DB.
 type State struct {
-	beaconDB                db.NoHeadAccessDatabase
-	slotsPerArchivedPoint   uint64
-	epochBoundarySlotToRoot map[uint64][32]byte
-	epochBoundaryLock       sync.RWMutex
-	hotStateCache           *cache.HotStateCache
-	splitInfo               *splitSlotAndRoot
-	stateSummaryCache       *cache.StateSummaryCache
+	beaconDB                   db.NoHeadAccessDatabase
+	slotsPerArchivedPoint      uint64
+	epochBoundarySlotToRoot    map[uint64][32]byte
+	epochBoundaryLock          sync.RWMutex
+	hotStateCache              *cache.HotStateCache
+	splitInfo                  *splitSlotAndRoot
+	stateSummaryCache          *cache.StateSummaryCache
+	snapshotPolicy             *snapshotPolicy
+	archivedPointsPerFullState uint64
+	historicalStates           *historicalStates
 }
 
 // This tracks the split point. The point where slot and the block root of
@@ -38,12 +42,15 @@ type splitSlotAndRoot struct {
 // New returns a new state management object.
 func New(db db.NoHeadAccessDatabase, stateSummaryCache *cache.StateSummaryCache) *State {
 	return &State{
-		beaconDB:                db,
-		epochBoundarySlotToRoot: make(map[uint64][32]byte),
-		hotStateCache:           cache.NewHotStateCache(),
-		splitInfo:               &splitSlotAndRoot{slot: 0, root: params.BeaconConfig().ZeroHash},
-		slotsPerArchivedPoint:   params.BeaconConfig().SlotsPerArchivedPoint,
-		stateSummaryCache:       stateSummaryCache,
+		beaconDB:                   db,
+		epochBoundarySlotToRoot:    make(map[uint64][32]byte),
+		hotStateCache:              cache.NewHotStateCache(),
+		splitInfo:                  &splitSlotAndRoot{slot: 0, root: params.BeaconConfig().ZeroHash},
+		slotsPerArchivedPoint:      params.BeaconConfig().SlotsPerArchivedPoint,
+		stateSummaryCache:          stateSummaryCache,
+		snapshotPolicy:             newSnapshotPolicy(flags.Get()),
+		archivedPointsPerFullState: flags.Get().ArchivedPointsPerFullState,
+		historicalStates:           newHistoricalStates(),
 	}
 }
 
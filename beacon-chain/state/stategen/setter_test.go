@@ -52,7 +52,7 @@ func TestSaveState_HotStateCanBeSaved(t *testing.T) {
 	service := New(db, cache.NewStateSummaryCache())
 	service.slotsPerArchivedPoint = 1
 	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
-	// This goes to hot section, verify it can save on epoch boundary.
+	// This goes to hot section, verify it can save on the archived point.
 	if err := beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch); err != nil {
 		t.Fatal(err)
 	}
@@ -69,7 +69,7 @@ func TestSaveState_HotStateCanBeSaved(t *testing.T) {
 	if !service.stateSummaryCache.Has(r) {
 		t.Error("Should have saved the state summary")
 	}
-	testutil.AssertLogsContain(t, hook, "Saved full state on epoch boundary")
+	testutil.AssertLogsContain(t, hook, "Saved full state on archived point")
 }
 
 func TestSaveState_HotStateCached(t *testing.T) {
@@ -21,6 +21,7 @@ var errGeneric = errors.New(genericError)
 var responseCodeSuccess = byte(0x00)
 var responseCodeInvalidRequest = byte(0x01)
 var responseCodeServerError = byte(0x02)
+var responseCodeResourceUnavailable = byte(0x03)
 
 func (r *Service) generateErrorResponse(code byte, reason string) ([]byte, error) {
 	buf := bytes.NewBuffer([]byte{code})
@@ -43,6 +43,8 @@ const (
 	// nonSkippedSlotsFullSearchEpochs how many epochs to check in full, before resorting to random
 	// sampling of slots once per epoch
 	nonSkippedSlotsFullSearchEpochs = 10
+	// peerBackOffPeriod is how long a peer which served blocks not linking to our chain is excluded from requests.
+	peerBackOffPeriod = 2 * time.Minute
 )
 
 var (
@@ -69,6 +71,7 @@ type blocksFetcher struct {
 	blocksPerSecond uint64
 	rateLimiter     *leakybucket.Collector
 	peerLocks       map[peer.ID]*peerLock
+	peerBackOffs    map[peer.ID]time.Time
 	fetchRequests   chan *fetchRequestParams
 	fetchResponses  chan *fetchRequestResponse
 	quit            chan struct{} // termination notifier
@@ -92,6 +95,7 @@ type fetchRequestParams struct {
 type fetchRequestResponse struct {
 	start, count uint64
 	blocks       []*eth.SignedBeaconBlock
+	origins      map[uint64]peer.ID // block slot -> peer which served the block
 	err          error
 }
 
@@ -113,6 +117,7 @@ func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetc
 		blocksPerSecond: uint64(blocksPerSecond),
 		rateLimiter:     rateLimiter,
 		peerLocks:       make(map[peer.ID]*peerLock),
+		peerBackOffs:    make(map[peer.ID]time.Time),
 		fetchRequests:   make(chan *fetchRequestParams, maxPendingRequests),
 		fetchResponses:  make(chan *fetchRequestResponse, maxPendingRequests),
 		quit:            make(chan struct{}),
@@ -215,10 +220,11 @@ func (f *blocksFetcher) handleRequest(ctx context.Context, start, count uint64)
 	defer span.End()
 
 	response := &fetchRequestResponse{
-		start:  start,
-		count:  count,
-		blocks: []*eth.SignedBeaconBlock{},
-		err:    nil,
+		start:   start,
+		count:   count,
+		blocks:  []*eth.SignedBeaconBlock{},
+		origins: make(map[uint64]peer.ID),
+		err:     nil,
 	}
 
 	if ctx.Err() != nil {
@@ -243,9 +249,9 @@ func (f *blocksFetcher) handleRequest(ctx context.Context, start, count uint64)
 	}
 
 	if featureconfig.Get().EnableInitSyncWeightedRoundRobin {
-		response.blocks, response.err = f.fetchBlocksFromSinglePeer(ctx, start, count, peers)
+		response.blocks, response.origins, response.err = f.fetchBlocksFromSinglePeer(ctx, start, count, peers)
 	} else {
-		response.blocks, response.err = f.fetchBlocksFromPeers(ctx, root, finalizedEpoch, start, 1, count, peers)
+		response.blocks, response.origins, response.err = f.fetchBlocksFromPeers(ctx, root, finalizedEpoch, start, 1, count, peers)
 	}
 	return response
 }
@@ -255,14 +261,15 @@ func (f *blocksFetcher) fetchBlocksFromSinglePeer(
 	ctx context.Context,
 	start, count uint64,
 	peers []peer.ID,
-) (blocks []*eth.SignedBeaconBlock, err error) {
+) (blocks []*eth.SignedBeaconBlock, origins map[uint64]peer.ID, err error) {
 	ctx, span := trace.StartSpan(ctx, "initialsync.fetchBlocksFromSinglePeer")
 	defer span.End()
 
 	blocks = []*eth.SignedBeaconBlock{}
+	origins = make(map[uint64]peer.ID)
 	peers = f.filterPeers(peers, peersPercentagePerRequest)
 	if len(peers) == 0 {
-		return blocks, errNoPeersAvailable
+		return blocks, origins, errNoPeersAvailable
 	}
 	req := &p2ppb.BeaconBlocksByRangeRequest{
 		StartSlot: start,
@@ -271,6 +278,9 @@ func (f *blocksFetcher) fetchBlocksFromSinglePeer(
 	}
 	for i := 0; i < len(peers); i++ {
 		if blocks, err = f.requestBlocks(ctx, req, peers[i]); err == nil {
+			for _, blk := range blocks {
+				origins[blk.Block.Slot] = peers[i]
+			}
 			return
 		}
 	}
@@ -290,22 +300,28 @@ func (f *blocksFetcher) fetchBlocksFromPeers(
 	root []byte,
 	finalizedEpoch, start, step, count uint64,
 	peers []peer.ID,
-) ([]*eth.SignedBeaconBlock, error) {
+) ([]*eth.SignedBeaconBlock, map[uint64]peer.ID, error) {
 	ctx, span := trace.StartSpan(ctx, "initialsync.fetchBlocksFromPeers")
 	defer span.End()
 
 	if ctx.Err() != nil {
-		return []*eth.SignedBeaconBlock{}, ctx.Err()
+		return []*eth.SignedBeaconBlock{}, nil, ctx.Err()
 	}
 
 	peers = f.filterPeers(peers, peersPercentagePerRequest)
 	if len(peers) == 0 {
-		return []*eth.SignedBeaconBlock{}, errNoPeersAvailable
+		return []*eth.SignedBeaconBlock{}, nil, errNoPeersAvailable
+	}
+
+	// peerBlocks holds blocks along with the peer which actually served them.
+	type peerBlocks struct {
+		pid    peer.ID
+		blocks []*eth.SignedBeaconBlock
 	}
 
 	p2pRequests := new(sync.WaitGroup)
 	errChan := make(chan error)
-	blocksChan := make(chan []*eth.SignedBeaconBlock)
+	blocksChan := make(chan *peerBlocks)
 
 	p2pRequests.Add(len(peers))
 	go func() {
@@ -316,7 +332,7 @@ func (f *blocksFetcher) fetchBlocksFromPeers(
 	// Short circuit start far exceeding the highest finalized epoch in some infinite loop.
 	highestFinalizedSlot := helpers.StartSlot(finalizedEpoch + 1)
 	if start > highestFinalizedSlot {
-		return []*eth.SignedBeaconBlock{}, errSlotIsTooHigh
+		return []*eth.SignedBeaconBlock{}, nil, errSlotIsTooHigh
 	}
 
 	// Spread load evenly among available peers.
@@ -340,7 +356,7 @@ func (f *blocksFetcher) fetchBlocksFromPeers(
 		go func(ctx context.Context, pid peer.ID) {
 			defer p2pRequests.Done()
 
-			blocks, err := f.requestBeaconBlocksByRange(ctx, pid, root, start, step, count)
+			blocks, servedBy, err := f.requestBeaconBlocksByRange(ctx, pid, root, start, step, count)
 			if err != nil {
 				select {
 				case <-ctx.Done():
@@ -350,41 +366,45 @@ func (f *blocksFetcher) fetchBlocksFromPeers(
 			}
 			select {
 			case <-ctx.Done():
-			case blocksChan <- blocks:
+			case blocksChan <- &peerBlocks{pid: servedBy, blocks: blocks}:
 			}
 		}(ctx, pid)
 	}
 
 	var unionRespBlocks []*eth.SignedBeaconBlock
+	origins := make(map[uint64]peer.ID)
 	for {
 		select {
 		case <-ctx.Done():
-			return []*eth.SignedBeaconBlock{}, ctx.Err()
+			return []*eth.SignedBeaconBlock{}, nil, ctx.Err()
 		case err := <-errChan:
-			return []*eth.SignedBeaconBlock{}, err
+			return []*eth.SignedBeaconBlock{}, nil, err
 		case resp, ok := <-blocksChan:
 			if ok {
-				unionRespBlocks = append(unionRespBlocks, resp...)
+				unionRespBlocks = append(unionRespBlocks, resp.blocks...)
+				for _, blk := range resp.blocks {
+					origins[blk.Block.Slot] = resp.pid
+				}
 			} else {
 				sort.Slice(unionRespBlocks, func(i, j int) bool {
 					return unionRespBlocks[i].Block.Slot < unionRespBlocks[j].Block.Slot
 				})
-				return unionRespBlocks, nil
+				return unionRespBlocks, origins, nil
 			}
 		}
 	}
 }
 
 // requestBeaconBlocksByRange prepares BeaconBlocksByRange request, and handles possible stale peers
-// (by resending the request).
+// (by resending the request). Returns received blocks, along with the peer which has served them.
 func (f *blocksFetcher) requestBeaconBlocksByRange(
 	ctx context.Context,
 	pid peer.ID,
 	root []byte,
 	start, step, count uint64,
-) ([]*eth.SignedBeaconBlock, error) {
+) ([]*eth.SignedBeaconBlock, peer.ID, error) {
 	if ctx.Err() != nil {
-		return nil, ctx.Err()
+		return nil, pid, ctx.Err()
 	}
 
 	req := &p2ppb.BeaconBlocksByRangeRequest{
@@ -394,7 +414,8 @@ func (f *blocksFetcher) requestBeaconBlocksByRange(
 	}
 
 	if featureconfig.Get().EnableInitSyncWeightedRoundRobin {
-		return f.requestBlocks(ctx, req, pid)
+		resp, err := f.requestBlocks(ctx, req, pid)
+		return resp, pid, err
 	}
 
 	resp, respErr := f.requestBlocks(ctx, req, pid)
@@ -403,11 +424,11 @@ func (f *blocksFetcher) requestBeaconBlocksByRange(
 		headEpoch := helpers.SlotToEpoch(f.headFetcher.HeadSlot())
 		root1, _, peers := f.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, headEpoch)
 		if bytes.Compare(root, root1) != 0 {
-			return nil, errors.Errorf("can not resend, root mismatch: %x:%x", root, root1)
+			return nil, pid, errors.Errorf("can not resend, root mismatch: %x:%x", root, root1)
 		}
-		newPID, _, err := f.selectFailOverPeer(pid, peers)
+		newPID, _, err := f.selectFailOverPeer(pid, f.excludeBackedOffPeers(peers))
 		if err != nil {
-			return nil, err
+			return nil, pid, err
 		}
 
 		log.WithError(respErr).WithFields(logrus.Fields{
@@ -419,7 +440,7 @@ func (f *blocksFetcher) requestBeaconBlocksByRange(
 		return f.requestBeaconBlocksByRange(ctx, newPID, root, start, step, count)
 	}
 
-	return resp, nil
+	return resp, pid, nil
 }
 
 // requestBlocks is a wrapper for handling BeaconBlocksByRangeRequest requests/streams.
@@ -510,6 +531,31 @@ func (f *blocksFetcher) removeStalePeerLocks(age time.Duration) {
 	}
 }
 
+// backOffPeer excludes a given peer from subsequent requests, for the duration of peerBackOffPeriod.
+func (f *blocksFetcher) backOffPeer(pid peer.ID) {
+	f.Lock()
+	defer f.Unlock()
+	f.peerBackOffs[pid] = roughtime.Now().Add(peerBackOffPeriod)
+}
+
+// excludeBackedOffPeers returns a list of peers, with peers we are currently backing off from removed.
+func (f *blocksFetcher) excludeBackedOffPeers(peers []peer.ID) []peer.ID {
+	f.Lock()
+	defer f.Unlock()
+	now := roughtime.Now()
+	filtered := make([]peer.ID, 0, len(peers))
+	for _, pid := range peers {
+		if until, ok := f.peerBackOffs[pid]; ok {
+			if now.Before(until) {
+				continue
+			}
+			delete(f.peerBackOffs, pid)
+		}
+		filtered = append(filtered, pid)
+	}
+	return filtered
+}
+
 // selectFailOverPeer randomly selects fail over peer from the list of available peers.
 func (f *blocksFetcher) selectFailOverPeer(excludedPID peer.ID, peers []peer.ID) (peer.ID, []peer.ID, error) {
 	for i, pid := range peers {
@@ -556,6 +602,7 @@ func (f *blocksFetcher) waitForMinimumPeers(ctx context.Context) ([]peer.ID, err
 // filterPeers returns transformed list of peers,
 // weight ordered or randomized, constrained if necessary.
 func (f *blocksFetcher) filterPeers(peers []peer.ID, peersPercentage float64) []peer.ID {
+	peers = f.excludeBackedOffPeers(peers)
 	if len(peers) == 0 {
 		return peers
 	}
@@ -526,7 +526,7 @@ func TestBlocksFetcher_requestBeaconBlocksByRange(t *testing.T) {
 
 	root, _, peers := p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, helpers.SlotToEpoch(mc.HeadSlot()))
 
-	blocks, err := fetcher.requestBeaconBlocksByRange(context.Background(), peers[0], root, 1, 1, blockBatchLimit)
+	blocks, _, err := fetcher.requestBeaconBlocksByRange(context.Background(), peers[0], root, 1, 1, blockBatchLimit)
 	if err != nil {
 		t.Errorf("error: %v", err)
 	}
@@ -540,7 +540,7 @@ func TestBlocksFetcher_requestBeaconBlocksByRange(t *testing.T) {
 		if err != nil {
 			t.Error(err)
 		}
-		blocks, err = fetcher.requestBeaconBlocksByRange(context.Background(), peers[0], root, 1, 1, blockBatchLimit)
+		blocks, _, err = fetcher.requestBeaconBlocksByRange(context.Background(), peers[0], root, 1, 1, blockBatchLimit)
 		if err != nil {
 			t.Errorf("error: %v", err)
 		}
@@ -549,7 +549,7 @@ func TestBlocksFetcher_requestBeaconBlocksByRange(t *testing.T) {
 		err = fetcher.p2p.Disconnect(peers[1])
 		ctx, cancel = context.WithTimeout(context.Background(), time.Second*1)
 		defer cancel()
-		blocks, err = fetcher.requestBeaconBlocksByRange(ctx, peers[1], root, 1, 1, blockBatchLimit)
+		blocks, _, err = fetcher.requestBeaconBlocksByRange(ctx, peers[1], root, 1, 1, blockBatchLimit)
 		testutil.AssertLogsContain(t, hook, "Request failed, trying to forward request to another peer")
 		if err == nil || err.Error() != "context deadline exceeded" {
 			t.Errorf("expected context closed error, got: %v", err)
@@ -559,7 +559,7 @@ func TestBlocksFetcher_requestBeaconBlocksByRange(t *testing.T) {
 	// Test context cancellation.
 	ctx, cancel = context.WithCancel(context.Background())
 	cancel()
-	blocks, err = fetcher.requestBeaconBlocksByRange(ctx, peers[0], root, 1, 1, blockBatchLimit)
+	blocks, _, err = fetcher.requestBeaconBlocksByRange(ctx, peers[0], root, 1, 1, blockBatchLimit)
 	if err == nil || err.Error() != "context canceled" {
 		t.Errorf("expected context closed error, got: %v", err)
 	}
@@ -730,6 +730,28 @@ func TestBlocksFetcher_nonSkippedSlotAfter(t *testing.T) {
 	})
 }
 
+func TestBlocksFetcher_backOffPeer(t *testing.T) {
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{})
+	peers := []peer.ID{"abc", "def", "xyz"}
+
+	fetcher.backOffPeer("def")
+	got := fetcher.excludeBackedOffPeers(peers)
+	want := []peer.ID{"abc", "xyz"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("excludeBackedOffPeers() got = %#v, want %#v", got, want)
+	}
+
+	// Expired back off periods are removed.
+	fetcher.peerBackOffs["def"] = roughtime.Now().Add(-time.Second)
+	got = fetcher.excludeBackedOffPeers(peers)
+	if !reflect.DeepEqual(got, peers) {
+		t.Errorf("excludeBackedOffPeers() got = %#v, want %#v", got, peers)
+	}
+	if _, ok := fetcher.peerBackOffs["def"]; ok {
+		t.Error("Expired back off period is not removed")
+	}
+}
+
 func TestBlocksFetcher_filterPeers(t *testing.T) {
 	if !featureconfig.Get().EnableInitSyncWeightedRoundRobin {
 		t.Skip("Test is run only when EnableInitSyncWeightedRoundRobin = true")
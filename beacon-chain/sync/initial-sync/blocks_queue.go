@@ -3,8 +3,10 @@ package initialsync
 import (
 	"context"
 	"errors"
+	"sync"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -51,6 +53,15 @@ type blocksQueue struct {
 	highestExpectedSlot uint64
 	fetchedBlocks       chan *eth.SignedBeaconBlock // output channel for ready blocks
 	quit                chan struct{}               // termination notifier
+	originsLock         sync.Mutex
+	origins             map[uint64]*blockOrigin // block slot -> origin, for blocks handed to the queue
+}
+
+// blockOrigin identifies the peer which served a block, and whether that peer has served
+// the whole batch the block was received in.
+type blockOrigin struct {
+	pid          peer.ID
+	soleProvider bool
 }
 
 // newBlocksQueue creates initialized priority queue.
@@ -77,6 +88,7 @@ func newBlocksQueue(ctx context.Context, cfg *blocksQueueConfig) *blocksQueue {
 		headFetcher:         cfg.headFetcher,
 		fetchedBlocks:       make(chan *eth.SignedBeaconBlock, blocksFetcher.blocksPerSecond),
 		quit:                make(chan struct{}),
+		origins:             make(map[uint64]*blockOrigin),
 	}
 
 	// Configure state machines.
@@ -144,6 +156,7 @@ func (q *blocksQueue) loop() {
 			log.WithField("slot", q.highestExpectedSlot).Debug("Highest expected slot reached")
 			q.cancel()
 		}
+		q.pruneBlockOrigins(q.headFetcher.HeadSlot())
 
 		select {
 		case <-ticker.C:
@@ -241,6 +254,7 @@ func (q *blocksQueue) onDataReceivedEvent(ctx context.Context) eventHandlerFn {
 			return m.state, response.err
 		}
 		m.blocks = response.blocks
+		q.setBlockOrigins(response)
 		return stateDataParsed, nil
 	}
 }
@@ -364,3 +378,62 @@ func (q *blocksQueue) onCheckStaleEvent(ctx context.Context) eventHandlerFn {
 		return stateSkipped, nil
 	}
 }
+
+// setBlockOrigins records peers which served the blocks of a given response.
+func (q *blocksQueue) setBlockOrigins(response *fetchRequestResponse) {
+	providers := make(map[peer.ID]bool)
+	for _, pid := range response.origins {
+		providers[pid] = true
+	}
+	q.originsLock.Lock()
+	defer q.originsLock.Unlock()
+	for slot, pid := range response.origins {
+		q.origins[slot] = &blockOrigin{
+			pid:          pid,
+			soleProvider: len(providers) == 1,
+		}
+	}
+}
+
+// pruneBlockOrigins removes origins of blocks at or below a given slot.
+func (q *blocksQueue) pruneBlockOrigins(slot uint64) {
+	q.originsLock.Lock()
+	defer q.originsLock.Unlock()
+	for s := range q.origins {
+		if s <= slot {
+			delete(q.origins, s)
+		}
+	}
+}
+
+// onNonLinkingBlock handles a block, previously output by the queue, that does not link to our chain.
+// The peer which served the block is backed off from. Blocks are striped across several peers, so when
+// the batch has not been served by that peer alone, a missing parent may be another peer's fault, and
+// the peer is not penalized any further. Otherwise, the peer has served a batch that is either on a
+// different chain or is incomplete, and the bad response is recorded. Remaining blocks of the peer are
+// not accounted for, so that a single batch is penalized at most once.
+func (q *blocksQueue) onNonLinkingBlock(slot uint64) {
+	q.originsLock.Lock()
+	origin, ok := q.origins[slot]
+	if ok {
+		for s, o := range q.origins {
+			if o.pid == origin.pid {
+				delete(q.origins, s)
+			}
+		}
+	}
+	q.originsLock.Unlock()
+	if !ok {
+		return
+	}
+
+	q.blocksFetcher.backOffPeer(origin.pid)
+	if origin.soleProvider {
+		q.blocksFetcher.p2p.Peers().IncrementBadResponses(origin.pid)
+	}
+	log.WithFields(logrus.Fields{
+		"peer":         origin.pid.Pretty(),
+		"slot":         slot,
+		"soleProvider": origin.soleProvider,
+	}).Debug("Peer served a block that does not link to our chain, backing off")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
@@ -326,3 +327,60 @@ func TestBlocksQueueLoop(t *testing.T) {
 		})
 	}
 }
+
+func TestBlocksQueue_onNonLinkingBlock(t *testing.T) {
+	mc, p2p, _ := initializeTestServices(t, []uint64{}, []*peerData{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{
+		headFetcher: mc,
+		p2p:         p2p,
+	})
+	queue := newBlocksQueue(ctx, &blocksQueueConfig{
+		blocksFetcher:       fetcher,
+		headFetcher:         mc,
+		highestExpectedSlot: 256,
+	})
+
+	t.Run("single provider", func(t *testing.T) {
+		pid := peer.ID("abc")
+		queue.setBlockOrigins(&fetchRequestResponse{
+			origins: map[uint64]peer.ID{1: pid, 2: pid, 3: pid},
+		})
+		queue.onNonLinkingBlock(2)
+		queue.onNonLinkingBlock(3)
+
+		if badResponses, err := p2p.Peers().BadResponses(pid); err != nil || badResponses != 1 {
+			t.Errorf("Unexpected bad responses, want: %d, got: %d (%v)", 1, badResponses, err)
+		}
+		if pids := fetcher.excludeBackedOffPeers([]peer.ID{pid}); len(pids) != 0 {
+			t.Errorf("Expected peer to be backed off from, got: %v", pids)
+		}
+		if len(queue.origins) != 0 {
+			t.Errorf("Expected origins of the peer to be removed, got: %v", queue.origins)
+		}
+	})
+
+	t.Run("multiple providers", func(t *testing.T) {
+		pid1, pid2 := peer.ID("def"), peer.ID("xyz")
+		queue.setBlockOrigins(&fetchRequestResponse{
+			origins: map[uint64]peer.ID{5: pid1, 6: pid2},
+		})
+		queue.onNonLinkingBlock(6)
+
+		if badResponses, err := p2p.Peers().BadResponses(pid2); err == nil && badResponses != 0 {
+			t.Errorf("Unexpected bad responses, want: %d, got: %d", 0, badResponses)
+		}
+		if pids := fetcher.excludeBackedOffPeers([]peer.ID{pid1, pid2}); len(pids) != 1 || pids[0] != pid1 {
+			t.Errorf("Expected only %v to be backed off from, got: %v", pid2, pids)
+		}
+		if _, ok := queue.origins[5]; !ok {
+			t.Error("Expected origin of the other peer to be retained")
+		}
+		queue.pruneBlockOrigins(5)
+		if len(queue.origins) != 0 {
+			t.Errorf("Expected origins to be pruned, got: %v", queue.origins)
+		}
+	})
+}
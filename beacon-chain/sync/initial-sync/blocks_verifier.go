@@ -0,0 +1,194 @@
+package initialsync
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// verificationStatePollPeriod is how often the verifier checks whether the chain head has
+// advanced far enough to verify the next batch.
+const verificationStatePollPeriod = 50 * time.Millisecond
+
+// verifiedBlock is a fetched block annotated with whether all of its attestation
+// signatures were verified ahead of the state transition.
+type verifiedBlock struct {
+	block    *eth.SignedBeaconBlock
+	verified bool
+}
+
+// blocksVerifierConfig is a config to setup the blocks verifier.
+type blocksVerifierConfig struct {
+	headFetcher blockchain.HeadFetcher
+	workers     int
+}
+
+// blocksVerifier verifies attestation signatures of fetched blocks concurrently, one epoch
+// worth of blocks at a time, so that the (sequential) state transition may skip them.
+//
+// Committees of an epoch are fully determined by a state at most one epoch behind it,
+// as their seed and active validator set are fixed MIN_SEED_LOOKAHEAD epochs in advance.
+// The verifier therefore uses the chain head as its verification state, and runs at most
+// one epoch ahead of block processing. Blocks it is unable to verify are passed on
+// unverified, and are expected to be processed with full signature verification.
+type blocksVerifier struct {
+	ctx         context.Context
+	headFetcher blockchain.HeadFetcher
+	workers     int
+}
+
+// attestationJob is a single attestation signature to verify, along with the index of the
+// block it belongs to in the batch.
+type attestationJob struct {
+	blockIndex int
+	att        *eth.Attestation
+}
+
+// newBlocksVerifier creates ready to use verifier.
+func newBlocksVerifier(ctx context.Context, cfg *blocksVerifierConfig) *blocksVerifier {
+	workers := cfg.workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return &blocksVerifier{
+		ctx:         ctx,
+		headFetcher: cfg.headFetcher,
+		workers:     workers,
+	}
+}
+
+// verify consumes blocks from the input channel and returns a channel on which the same
+// blocks are emitted, in order, once their batch has been verified. The returned channel
+// is closed once the input channel is closed or the verifier's context is done.
+func (v *blocksVerifier) verify(in <-chan *eth.SignedBeaconBlock) <-chan *verifiedBlock {
+	out := make(chan *verifiedBlock, params.BeaconConfig().SlotsPerEpoch)
+	go func() {
+		defer close(out)
+		batch := make([]*eth.SignedBeaconBlock, 0, params.BeaconConfig().SlotsPerEpoch)
+		flush := func() bool {
+			if len(batch) == 0 {
+				return true
+			}
+			for _, vb := range v.verifyBatch(batch, out) {
+				select {
+				case <-v.ctx.Done():
+					return false
+				case out <- vb:
+				}
+			}
+			batch = make([]*eth.SignedBeaconBlock, 0, params.BeaconConfig().SlotsPerEpoch)
+			return true
+		}
+		for blk := range in {
+			if blk == nil || blk.Block == nil {
+				continue
+			}
+			if len(batch) > 0 && helpers.SlotToEpoch(blk.Block.Slot) != helpers.SlotToEpoch(batch[0].Block.Slot) {
+				if !flush() {
+					return
+				}
+			}
+			batch = append(batch, blk)
+			// Do not hold on to blocks while no more are immediately available, the processor
+			// would otherwise be starved waiting for the rest of the epoch to be fetched.
+			if len(in) == 0 && !flush() {
+				return
+			}
+		}
+		flush()
+	}()
+	return out
+}
+
+// verifyBatch verifies the attestation signatures of a batch of blocks from the same epoch.
+func (v *blocksVerifier) verifyBatch(batch []*eth.SignedBeaconBlock, out chan *verifiedBlock) []*verifiedBlock {
+	ctx, span := trace.StartSpan(v.ctx, "initialsync.verifyBatch")
+	defer span.End()
+
+	result := make([]*verifiedBlock, len(batch))
+	for i, blk := range batch {
+		result[i] = &verifiedBlock{block: blk}
+	}
+	epoch := helpers.SlotToEpoch(batch[0].Block.Slot)
+	st := v.verificationState(ctx, epoch, out)
+	if st == nil {
+		return result
+	}
+
+	failed := make([]bool, len(batch))
+	var failedLock sync.Mutex
+	jobs := make(chan *attestationJob, v.workers)
+	var wg sync.WaitGroup
+	for i := 0; i < v.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				failedLock.Lock()
+				skip := failed[job.blockIndex]
+				failedLock.Unlock()
+				if skip {
+					continue
+				}
+				if err := blocks.VerifyAttestation(ctx, st, job.att); err != nil {
+					log.WithError(err).WithField("slot", batch[job.blockIndex].Block.Slot).Debug("Could not pre-verify attestation")
+					failedLock.Lock()
+					failed[job.blockIndex] = true
+					failedLock.Unlock()
+				}
+			}
+		}()
+	}
+	for i, blk := range batch {
+		if blk.Block.Body == nil {
+			failed[i] = true
+			continue
+		}
+		for _, att := range blk.Block.Body.Attestations {
+			jobs <- &attestationJob{blockIndex: i, att: att}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i := range result {
+		result[i].verified = !failed[i]
+	}
+	return result
+}
+
+// verificationState returns a state from which the committees of the given epoch can be
+// computed, or nil if none is available. While the processor still has blocks to work on,
+// this waits for the chain head to advance far enough rather than giving up on the batch.
+func (v *blocksVerifier) verificationState(ctx context.Context, epoch uint64, out chan *verifiedBlock) *stateTrie.BeaconState {
+	ticker := time.NewTicker(verificationStatePollPeriod)
+	defer ticker.Stop()
+	for helpers.SlotToEpoch(v.headFetcher.HeadSlot())+1 < epoch && len(out) > 0 {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+	if helpers.SlotToEpoch(v.headFetcher.HeadSlot())+1 < epoch {
+		return nil
+	}
+	st, err := v.headFetcher.HeadState(ctx)
+	if err != nil || st == nil {
+		log.WithError(err).Debug("Could not retrieve verification state")
+		return nil
+	}
+	if helpers.CurrentEpoch(st)+1 < epoch {
+		return nil
+	}
+	return st
+}
@@ -0,0 +1,81 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestBlocksVerifier_VerifiesAttestationSignatures(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	st, privKeys := testutil.DeterministicGenesisState(t, 64)
+	if err := st.SetSlot(1); err != nil {
+		t.Fatal(err)
+	}
+	atts, err := testutil.GenerateAttestations(st, privKeys, 1, 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tamperedAtts, err := testutil.GenerateAttestations(st, privKeys, 1, 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tamperedAtts[0].Signature = atts[0].Signature
+	tamperedAtts[0].Data.BeaconBlockRoot = []byte("tampered")
+
+	farSlot := 3 * params.BeaconConfig().SlotsPerEpoch
+	in := make(chan *eth.SignedBeaconBlock, 3)
+	in <- &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: 2, Body: &eth.BeaconBlockBody{Attestations: atts}}}
+	in <- &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: 3, Body: &eth.BeaconBlockBody{Attestations: tamperedAtts}}}
+	in <- &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: farSlot, Body: &eth.BeaconBlockBody{}}}
+	close(in)
+
+	verifier := newBlocksVerifier(ctx, &blocksVerifierConfig{
+		headFetcher: &mock.ChainService{State: st},
+		workers:     2,
+	})
+	var results []*verifiedBlock
+	for vb := range verifier.verify(in) {
+		results = append(results, vb)
+	}
+
+	wanted := []struct {
+		slot     uint64
+		verified bool
+	}{
+		{slot: 2, verified: true},
+		{slot: 3, verified: false},
+		{slot: farSlot, verified: false},
+	}
+	if len(results) != len(wanted) {
+		t.Fatalf("Unexpected number of verified blocks, want: %d, got: %d", len(wanted), len(results))
+	}
+	for i, w := range wanted {
+		if results[i].block.Block.Slot != w.slot {
+			t.Errorf("Unexpected block order, want slot: %d, got: %d", w.slot, results[i].block.Block.Slot)
+		}
+		if results[i].verified != w.verified {
+			t.Errorf("Unexpected verification result for slot %d, want: %v, got: %v", w.slot, w.verified, results[i].verified)
+		}
+	}
+}
+
+func TestBlocksVerifier_ClosesOnContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	verifier := newBlocksVerifier(ctx, &blocksVerifierConfig{
+		headFetcher: &mock.ChainService{State: st},
+	})
+	in := make(chan *eth.SignedBeaconBlock)
+	out := verifier.verify(in)
+	cancel()
+	close(in)
+	for range out {
+	}
+}
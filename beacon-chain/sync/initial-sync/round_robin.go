@@ -1,13 +1,16 @@
 package initialsync
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
 	"time"
 
 	"github.com/paulbellamy/ratecounter"
+	"github.com/pkg/errors"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
@@ -25,6 +28,9 @@ const (
 	refreshTime = 6 * time.Second
 )
 
+// errParentDoesNotExist is returned when a block does not link to any block known to the node.
+var errParentDoesNotExist = errors.New("beacon node doesn't have a parent in db with root")
+
 // blockReceiverFn defines block receiving function.
 type blockReceiverFn func(ctx context.Context, block *eth.SignedBeaconBlock, blockRoot [32]byte) error
 
@@ -48,26 +54,57 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 	s.counter = ratecounter.NewRateCounter(counterSeconds * time.Second)
 	s.lastProcessedSlot = s.chain.HeadSlot()
 	highestFinalizedSlot := helpers.StartSlot(s.highestFinalizedEpoch() + 1)
+	var headFetcher blockchain.HeadFetcher = s.chain
+	target, abandoned := s.selectSyncTarget(ctx)
+	if target != nil {
+		highestFinalizedSlot = helpers.StartSlot(target.Epoch + 1)
+	}
+	if abandoned {
+		// The blocks synced past our finalized checkpoint are on a chain peers no longer support,
+		// so resume from our finalized checkpoint rather than from the head of that chain.
+		headRoot, err := s.chain.HeadRoot(ctx)
+		if err != nil {
+			return err
+		}
+		resumeSlot := helpers.StartSlot(s.finalizedEpoch())
+		headFetcher = &resumedHeadFetcher{
+			HeadFetcher:   s.chain,
+			abandonedRoot: headRoot,
+			resumeSlot:    resumeSlot,
+		}
+		s.lastProcessedSlot = resumeSlot
+	}
 	queue := newBlocksQueue(ctx, &blocksQueueConfig{
 		p2p:                 s.p2p,
-		headFetcher:         s.chain,
+		headFetcher:         headFetcher,
 		highestExpectedSlot: highestFinalizedSlot,
 	})
 	if err := queue.start(); err != nil {
 		return err
 	}
-	var blockReceiver blockReceiverFn
+	// Step 1 - Sync to end of finalized epoch.
 	if featureconfig.Get().InitSyncNoVerify {
-		blockReceiver = s.chain.ReceiveBlockNoVerify
+		for blk := range queue.fetchedBlocks {
+			if err := s.processBlock(ctx, genesis, blk, s.chain.ReceiveBlockNoVerify); err != nil {
+				s.handleBlockProcessingError(queue, blk, err)
+				continue
+			}
+		}
 	} else {
-		blockReceiver = s.chain.ReceiveBlockNoPubsubForkchoice
-	}
-
-	// Step 1 - Sync to end of finalized epoch.
-	for blk := range queue.fetchedBlocks {
-		if err := s.processBlock(ctx, genesis, blk, blockReceiver); err != nil {
-			log.WithError(err).Info("Block is not processed")
-			continue
+		// Attestation signatures of fetched blocks are verified concurrently ahead of the state
+		// transition, which then skips them for every block that passed pre-verification.
+		verifier := newBlocksVerifier(ctx, &blocksVerifierConfig{
+			headFetcher: headFetcher,
+		})
+		for vb := range verifier.verify(queue.fetchedBlocks) {
+			var blockReceiver blockReceiverFn = s.chain.ReceiveBlockNoPubsubForkchoice
+			if vb.verified {
+				blockReceiver = s.chain.ReceiveBlockNoVerify
+			}
+			if err := s.processBlock(ctx, genesis, vb.block, blockReceiver); err != nil {
+				s.handleBlockProcessingError(queue, vb.block, err)
+				continue
+			}
 		}
 	}
 
@@ -114,6 +151,9 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 		for _, blk := range resp {
 			err := s.processBlock(ctx, genesis, blk, s.chain.ReceiveBlockNoPubsubForkchoice)
 			if err != nil {
+				if errors.Is(err, errParentDoesNotExist) {
+					s.p2p.Peers().IncrementBadResponses(best)
+				}
 				log.WithError(err).Error("Failed to process block, exiting init sync")
 				return nil
 			}
@@ -126,6 +166,94 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 	return nil
 }
 
+// handleBlockProcessingError logs the failure to process a block, received from the blocks queue.
+// Peers that serve blocks which do not link to our chain are reported back to the queue.
+func (s *Service) handleBlockProcessingError(queue *blocksQueue, blk *eth.SignedBeaconBlock, err error) {
+	if errors.Is(err, errParentDoesNotExist) {
+		queue.onNonLinkingBlock(blk.Block.Slot)
+	}
+	log.WithError(err).Info("Block is not processed")
+}
+
+// selectSyncTarget picks the finalized checkpoint supported by the majority of peers as the sync target,
+// and persists it, so that a restarted node resumes syncing against the same chain. It reports whether
+// the target persisted by a previous run is no longer supported by the majority of peers, in which case
+// the blocks synced towards it are abandoned.
+func (s *Service) selectSyncTarget(ctx context.Context) (*eth.Checkpoint, bool) {
+	finalizedEpoch := s.finalizedEpoch()
+	chains := s.p2p.Peers().FinalizedChains(finalizedEpoch)
+	if len(chains) == 0 {
+		return nil, false
+	}
+	majority := chains[0]
+	for _, chain := range chains[1:] {
+		if chain.Epoch != majority.Epoch {
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"epoch":            majority.Epoch,
+			"majorityRoot":     fmt.Sprintf("%#x", bytesutil.Trunc(majority.Root[:])),
+			"majorityPeers":    len(majority.Peers),
+			"conflictingRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(chain.Root[:])),
+			"conflictingPeers": len(chain.Peers),
+		}).Warn("Peers disagree on the finalized checkpoint, syncing to the one supported by the majority")
+	}
+	target := &eth.Checkpoint{
+		Epoch: majority.Epoch,
+		Root:  majority.Root[:],
+	}
+
+	abandoned := false
+	previous, err := s.db.InitialSyncTarget(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve previous initial sync target")
+	}
+	if previous != nil && previous.Epoch > finalizedEpoch {
+		fields := logrus.Fields{
+			"headSlot":      s.chain.HeadSlot(),
+			"previousEpoch": previous.Epoch,
+			"previousRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(previous.Root)),
+		}
+		if previous.Epoch == target.Epoch && !bytes.Equal(previous.Root, target.Root) {
+			abandoned = true
+			log.WithFields(fields).Warn("Previous initial sync target is no longer supported by the majority of peers")
+		} else {
+			log.WithFields(fields).Info("Resuming initial sync")
+		}
+	}
+
+	if err := s.db.SaveInitialSyncTarget(ctx, target); err != nil {
+		log.WithError(err).Error("Could not save initial sync target")
+	}
+	return target, abandoned
+}
+
+// resumedHeadFetcher reports the resume slot of initial sync as the head slot, for as long as the head of
+// the chain is the head of an abandoned chain, so that blocks of the new target chain are fetched from
+// the resume slot on.
+type resumedHeadFetcher struct {
+	blockchain.HeadFetcher
+	abandonedRoot []byte
+	resumeSlot    uint64
+}
+
+// HeadSlot returns the resume slot until the head moves off the abandoned chain.
+func (f *resumedHeadFetcher) HeadSlot() uint64 {
+	root, err := f.HeadFetcher.HeadRoot(context.Background())
+	if err == nil && bytes.Equal(root, f.abandonedRoot) {
+		return f.resumeSlot
+	}
+	return f.HeadFetcher.HeadSlot()
+}
+
+// finalizedEpoch returns the epoch of the finalized checkpoint of our chain.
+func (s *Service) finalizedEpoch() uint64 {
+	if cp := s.chain.FinalizedCheckpt(); cp != nil {
+		return cp.Epoch
+	}
+	return 0
+}
+
 // highestFinalizedEpoch returns the absolute highest finalized epoch of all connected peers.
 // Note this can be lower than our finalized epoch if we have no peers or peers that are all behind us.
 func (s *Service) highestFinalizedEpoch() uint64 {
@@ -175,7 +303,7 @@ func (s *Service) processBlock(
 	s.logSyncStatus(genesis, blk.Block, blkRoot)
 	parentRoot := bytesutil.ToBytes32(blk.Block.ParentRoot)
 	if !s.db.HasBlock(ctx, parentRoot) && !s.chain.HasInitSyncBlock(parentRoot) {
-		return fmt.Errorf("beacon node doesn't have a block in db with root %#x", blk.Block.ParentRoot)
+		return fmt.Errorf("%w %#x", errParentDoesNotExist, blk.Block.ParentRoot)
 	}
 	if err := blockReceiver(ctx, blk, blkRoot); err != nil {
 		return err
@@ -5,16 +5,24 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
 func TestConstants(t *testing.T) {
@@ -368,3 +376,140 @@ func TestService_processBlock(t *testing.T) {
 		}
 	})
 }
+
+func TestService_selectSyncTarget(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := dbtest.SetupDB(t)
+	p := p2pt.NewTestP2P(t)
+	majorityRoot := [32]byte{'m', 'a', 'j'}
+	minorityRoot := [32]byte{'m', 'i', 'n'}
+	addPeer := func(pid peer.ID, root [32]byte) {
+		p.Peers().Add(new(enr.Record), pid, nil, network.DirOutbound)
+		p.Peers().SetConnectionState(pid, peers.PeerConnected)
+		p.Peers().SetChainState(pid, &p2ppb.Status{
+			FinalizedEpoch: 8,
+			FinalizedRoot:  root[:],
+		})
+	}
+	addPeer("a", majorityRoot)
+	addPeer("b", majorityRoot)
+	addPeer("c", minorityRoot)
+
+	previous := &eth.Checkpoint{Epoch: 8, Root: minorityRoot[:]}
+	if err := beaconDB.SaveInitialSyncTarget(context.Background(), previous); err != nil {
+		t.Fatal(err)
+	}
+	s := &Service{
+		chain: &mock.ChainService{FinalizedCheckPoint: &eth.Checkpoint{Epoch: 2}},
+		p2p:   p,
+		db:    beaconDB,
+	}
+	target, abandoned := s.selectSyncTarget(context.Background())
+	if !abandoned {
+		t.Error("Expected the previous initial sync target to be abandoned")
+	}
+	if target == nil || target.Epoch != 8 || bytesutil.ToBytes32(target.Root) != majorityRoot {
+		t.Errorf("Unexpected sync target: %v", target)
+	}
+
+	testutil.AssertLogsContain(t, hook, "Peers disagree on the finalized checkpoint")
+	testutil.AssertLogsContain(t, hook, "Previous initial sync target is no longer supported by the majority of peers")
+	saved, err := beaconDB.InitialSyncTarget(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved == nil || saved.Epoch != target.Epoch || bytesutil.ToBytes32(saved.Root) != majorityRoot {
+		t.Errorf("Wanted saved sync target %v, received %v", target, saved)
+	}
+
+	// Restarting against the same majority resumes.
+	hook.Reset()
+	if _, abandoned := s.selectSyncTarget(context.Background()); abandoned {
+		t.Error("Expected to resume the previous initial sync target")
+	}
+	testutil.AssertLogsContain(t, hook, "Resuming initial sync")
+}
+
+func TestService_roundRobinSync_ResumesFromFinalizedWhenTargetAbandoned(t *testing.T) {
+	currentSlot := uint64(131)
+	data := []*peerData{
+		{
+			blocks:         makeSequence(1, 131),
+			finalizedEpoch: 1,
+			headSlot:       131,
+		},
+		{
+			blocks:         makeSequence(1, 131),
+			finalizedEpoch: 1,
+			headSlot:       131,
+		},
+	}
+	cache.initializeRootCache(makeSequence(1, 131), t)
+
+	p := p2pt.NewTestP2P(t)
+	beaconDB := dbtest.SetupDB(t)
+	connectPeers(t, p, data, p.Peers())
+	cache.RLock()
+	genesisRoot := cache.rootCache[0]
+	cache.RUnlock()
+
+	if err := beaconDB.SaveBlock(context.Background(), &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{}}); err != nil {
+		t.Fatal(err)
+	}
+	// A previous run synced towards a checkpoint which peers no longer advertise, up to slot 40.
+	abandonedRoot := [32]byte{'a', 'b', 'a', 'n', 'd', 'o', 'n', 'e', 'd'}
+	if err := beaconDB.SaveInitialSyncTarget(context.Background(), &eth.Checkpoint{Epoch: 1, Root: abandonedRoot[:]}); err != nil {
+		t.Fatal(err)
+	}
+	st, err := stateTrie.InitializeFromProto(&p2ppb.BeaconState{Slot: 40})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mc := &mock.ChainService{
+		State:               st,
+		Root:                genesisRoot[:],
+		DB:                  beaconDB,
+		FinalizedCheckPoint: &eth.Checkpoint{Epoch: 0},
+	}
+	s := &Service{
+		chain:        mc,
+		p2p:          p,
+		db:           beaconDB,
+		synced:       false,
+		chainStarted: true,
+	}
+	if err := s.roundRobinSync(makeGenesisTime(currentSlot)); err != nil {
+		t.Fatal(err)
+	}
+	if s.chain.HeadSlot() != currentSlot {
+		t.Errorf("Head slot (%d) is not currentSlot (%d)", s.chain.HeadSlot(), currentSlot)
+	}
+	if len(mc.BlocksReceived) == 0 || mc.BlocksReceived[0].Block.Slot != 1 {
+		t.Fatalf("Expected to resume syncing from slot 1, received %d blocks", len(mc.BlocksReceived))
+	}
+	if len(mc.BlocksReceived) != int(currentSlot) {
+		t.Errorf("Processes wrong number of blocks. Wanted %d got %d", currentSlot, len(mc.BlocksReceived))
+	}
+}
+
+func TestService_processBlock_nonLinking(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	s := NewInitialSync(&Config{
+		P2P:   p2pt.NewTestP2P(t),
+		DB:    beaconDB,
+		Chain: &mock.ChainService{DB: beaconDB},
+	})
+	blk := &eth.SignedBeaconBlock{
+		Block: &eth.BeaconBlock{
+			Slot:       1,
+			ParentRoot: bytesutil.PadTo([]byte("unknown"), 32),
+		},
+	}
+	err := s.processBlock(context.Background(), makeGenesisTime(32), blk, func(
+		ctx context.Context, block *eth.SignedBeaconBlock, blockRoot [32]byte) error {
+		return nil
+	})
+	if !errors.Is(err, errParentDoesNotExist) {
+		t.Errorf("Expected error: %v, got: %v", errParentDoesNotExist, err)
+	}
+}
@@ -36,7 +36,7 @@ type blockchainService interface {
 // Config to set up the initial sync service.
 type Config struct {
 	P2P           p2p.P2P
-	DB            db.ReadOnlyDatabase
+	DB            db.NoHeadAccessDatabase
 	Chain         blockchainService
 	StateNotifier statefeed.Notifier
 	BlockNotifier blockfeed.Notifier
@@ -48,7 +48,7 @@ type Service struct {
 	cancel            context.CancelFunc
 	chain             blockchainService
 	p2p               p2p.P2P
-	db                db.ReadOnlyDatabase
+	db                db.NoHeadAccessDatabase
 	synced            bool
 	chainStarted      bool
 	stateNotifier     statefeed.Notifier
@@ -39,12 +39,25 @@ var (
 		},
 		[]string{"topic"},
 	)
+	rpcRateLimitedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_rpc_rate_limited_total",
+			Help: "Count of rpc requests rejected due to the requesting peer exceeding its rate limit.",
+		},
+		[]string{"topic"},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
 			Help: "Count the number of times a node resyncs.",
 		},
 	)
+	pooledOperationsRebroadcast = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "pooled_operations_rebroadcast_total",
+			Help: "Count the number of times a pooled voluntary exit or slashing was re-gossiped.",
+		},
+	)
 	numberOfBlocksRecoveredFromAtt = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "beacon_blocks_recovered_from_attestation_total",
@@ -69,6 +82,25 @@ var (
 			Help: "Count the number of times attestation not recovered and pruned because of missing block",
 		},
 	)
+	pendingBlocksQueueDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "beacon_pending_blocks_queue_depth",
+			Help: "The number of orphaned blocks waiting on a missing ancestor.",
+		},
+	)
+	pendingBlocksExpiredCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "beacon_pending_blocks_expired_total",
+			Help: "Count the number of pending blocks pruned because they fell behind the finalized checkpoint.",
+		},
+	)
+	pendingBlockResolutionLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "beacon_pending_block_resolution_latency_seconds",
+			Help:    "Captures the time from a pending block being queued to its missing ancestors being resolved.",
+			Buckets: []float64{1, 2, 4, 8, 16, 32, 64, 128},
+		},
+	)
 	arrivalBlockPropagationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "block_arrival_latency_milliseconds",
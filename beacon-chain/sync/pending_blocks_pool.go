@@ -0,0 +1,209 @@
+package sync
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+// pendingBlocksMemoryLimit is the maximum amount of memory, in bytes, the encoded
+// pending blocks may occupy before the pool starts evicting blocks.
+const pendingBlocksMemoryLimit = 1 << 25 // 32 MiB.
+
+// pendingBlock defines an orphaned block which is waiting on one or more of
+// its ancestors before it can be processed.
+type pendingBlock struct {
+	block      *ethpb.SignedBeaconBlock
+	root       [32]byte
+	size       int
+	receivedAt time.Time
+}
+
+// pendingBlocksPool keeps track of orphaned blocks keyed by their block root, as
+// well as the parent roots which have recently been requested from peers.
+type pendingBlocksPool struct {
+	lock           sync.RWMutex
+	blocks         map[[32]byte]*pendingBlock
+	requestedRoots map[[32]byte]time.Time
+	size           int
+	maxSize        int
+}
+
+// newPendingBlocksPool initializes a pending blocks pool which holds at most
+// maxSize bytes worth of blocks.
+func newPendingBlocksPool(maxSize int) *pendingBlocksPool {
+	return &pendingBlocksPool{
+		blocks:         make(map[[32]byte]*pendingBlock),
+		requestedRoots: make(map[[32]byte]time.Time),
+		maxSize:        maxSize,
+	}
+}
+
+// insert a block into the pool. If the pool is over its memory limit, blocks with
+// the highest slots are evicted first as they are the furthest from being
+// processable. Returns false if the block was not retained.
+func (p *pendingBlocksPool) insert(blk *ethpb.SignedBeaconBlock, root [32]byte) bool {
+	if blk == nil || blk.Block == nil {
+		return false
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.blocks[root]; ok {
+		return true
+	}
+	size := proto.Size(blk)
+	if size > p.maxSize {
+		return false
+	}
+	for p.size+size > p.maxSize {
+		highest := p.highestSlotBlock()
+		if highest == nil || highest.block.Block.Slot <= blk.Block.Slot {
+			return false
+		}
+		p.remove(highest.root)
+	}
+	p.blocks[root] = &pendingBlock{
+		block:      blk,
+		root:       root,
+		size:       size,
+		receivedAt: roughtime.Now(),
+	}
+	p.size += size
+	delete(p.requestedRoots, root)
+	return true
+}
+
+// has returns true if a block with the given root is in the pool.
+func (p *pendingBlocksPool) has(root [32]byte) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	_, ok := p.blocks[root]
+	return ok
+}
+
+// delete removes the block with the given root from the pool.
+func (p *pendingBlocksPool) delete(root [32]byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.remove(root)
+}
+
+// len returns the number of blocks in the pool.
+func (p *pendingBlocksPool) len() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return len(p.blocks)
+}
+
+// sorted returns the pending blocks in the pool sorted by ascending slot, so that
+// ancestors are always visited before their descendants.
+func (p *pendingBlocksPool) sorted() []*pendingBlock {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.sortedUnsafe()
+}
+
+// missingParents returns the parent roots of pending blocks which are neither in the
+// pool nor have been requested within the provided timeout, mapped to the lowest slot
+// of their pending children.
+func (p *pendingBlocksPool) missingParents(timeout time.Duration) map[[32]byte]uint64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	now := roughtime.Now()
+	missing := make(map[[32]byte]uint64)
+	for _, b := range p.blocks {
+		parentRoot := bytesutil.ToBytes32(b.block.Block.ParentRoot)
+		if _, ok := p.blocks[parentRoot]; ok {
+			continue
+		}
+		if requestedAt, ok := p.requestedRoots[parentRoot]; ok && now.Before(requestedAt.Add(timeout)) {
+			continue
+		}
+		if slot, ok := missing[parentRoot]; !ok || b.block.Block.Slot < slot {
+			missing[parentRoot] = b.block.Block.Slot
+		}
+	}
+	return missing
+}
+
+// markRequested records the given roots as having been requested from peers.
+func (p *pendingBlocksPool) markRequested(roots [][32]byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := roughtime.Now()
+	for _, r := range roots {
+		p.requestedRoots[r] = now
+	}
+}
+
+// prune removes every block at or below the provided slot, along with all of
+// their pending descendants, as well as request records older than the timeout.
+// Returns the number of pruned blocks.
+func (p *pendingBlocksPool) prune(slot uint64, timeout time.Duration) int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	pruned := make(map[[32]byte]bool)
+	for _, b := range p.sortedUnsafe() {
+		if b.block.Block.Slot <= slot || pruned[bytesutil.ToBytes32(b.block.Block.ParentRoot)] {
+			pruned[b.root] = true
+			p.remove(b.root)
+		}
+	}
+	now := roughtime.Now()
+	for r, requestedAt := range p.requestedRoots {
+		if now.After(requestedAt.Add(timeout)) {
+			delete(p.requestedRoots, r)
+		}
+	}
+	return len(pruned)
+}
+
+// clear removes all the blocks and request records from the pool.
+func (p *pendingBlocksPool) clear() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.blocks = make(map[[32]byte]*pendingBlock)
+	p.requestedRoots = make(map[[32]byte]time.Time)
+	p.size = 0
+}
+
+// The following methods assume the caller holds the lock.
+
+func (p *pendingBlocksPool) remove(root [32]byte) {
+	b, ok := p.blocks[root]
+	if !ok {
+		return
+	}
+	p.size -= b.size
+	delete(p.blocks, root)
+}
+
+func (p *pendingBlocksPool) highestSlotBlock() *pendingBlock {
+	var highest *pendingBlock
+	for _, b := range p.blocks {
+		if highest == nil || b.block.Block.Slot > highest.block.Block.Slot {
+			highest = b
+		}
+	}
+	return highest
+}
+
+func (p *pendingBlocksPool) sortedUnsafe() []*pendingBlock {
+	blks := make([]*pendingBlock, 0, len(p.blocks))
+	for _, b := range p.blocks {
+		blks = append(blks, b)
+	}
+	sort.Slice(blks, func(i, j int) bool {
+		return blks[i].block.Block.Slot < blks[j].block.Block.Slot
+	})
+	return blks
+}
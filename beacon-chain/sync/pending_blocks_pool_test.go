@@ -0,0 +1,125 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+)
+
+func pendingTestBlock(t *testing.T, slot uint64, parentRoot [32]byte) (*ethpb.SignedBeaconBlock, [32]byte) {
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}}
+	root, err := stateutil.BlockRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return blk, root
+}
+
+func TestPendingBlocksPool_InsertAndDelete(t *testing.T) {
+	pool := newPendingBlocksPool(pendingBlocksMemoryLimit)
+	b1, r1 := pendingTestBlock(t, 1, [32]byte{'a'})
+	if !pool.insert(b1, r1) {
+		t.Fatal("Expected block to be inserted")
+	}
+	if !pool.insert(b1, r1) {
+		t.Fatal("Expected duplicate insert to be accepted")
+	}
+	if pool.len() != 1 {
+		t.Errorf("Wanted pool length 1, got %d", pool.len())
+	}
+	if !pool.has(r1) {
+		t.Error("Expected pool to have block root")
+	}
+	pool.delete(r1)
+	if pool.has(r1) || pool.len() != 0 || pool.size != 0 {
+		t.Error("Expected pool to be empty after delete")
+	}
+}
+
+func TestPendingBlocksPool_EvictsHighestSlotsOverMemoryLimit(t *testing.T) {
+	b1, r1 := pendingTestBlock(t, 1, [32]byte{'a'})
+	b2, r2 := pendingTestBlock(t, 2, [32]byte{'b'})
+	b3, r3 := pendingTestBlock(t, 3, [32]byte{'c'})
+	pool := newPendingBlocksPool(2 * proto.Size(b1))
+
+	if !pool.insert(b2, r2) || !pool.insert(b3, r3) {
+		t.Fatal("Expected blocks to be inserted")
+	}
+	// Inserting a lower slot block evicts the highest slot block.
+	if !pool.insert(b1, r1) {
+		t.Fatal("Expected lower slot block to be inserted")
+	}
+	if pool.has(r3) {
+		t.Error("Expected highest slot block to be evicted")
+	}
+	if !pool.has(r1) || !pool.has(r2) {
+		t.Error("Expected lower slot blocks to be retained")
+	}
+	// Inserting a higher slot block into a full pool is rejected.
+	if pool.insert(b3, r3) {
+		t.Error("Expected higher slot block to be rejected")
+	}
+}
+
+func TestPendingBlocksPool_MissingParentsDeduplicatesRequests(t *testing.T) {
+	pool := newPendingBlocksPool(pendingBlocksMemoryLimit)
+	missingRoot := [32]byte{'a'}
+	b1, r1 := pendingTestBlock(t, 5, missingRoot)
+	b2, r2 := pendingTestBlock(t, 3, missingRoot)
+	b3, r3 := pendingTestBlock(t, 6, r1)
+	pool.insert(b1, r1)
+	pool.insert(b2, r2)
+	pool.insert(b3, r3)
+
+	missing := pool.missingParents(time.Minute)
+	if len(missing) != 1 {
+		t.Fatalf("Wanted 1 missing parent, got %d", len(missing))
+	}
+	if missing[missingRoot] != 3 {
+		t.Errorf("Wanted lowest child slot 3, got %d", missing[missingRoot])
+	}
+
+	pool.markRequested([][32]byte{missingRoot})
+	if len(pool.missingParents(time.Minute)) != 0 {
+		t.Error("Expected recently requested root to be skipped")
+	}
+	if len(pool.missingParents(0)) != 1 {
+		t.Error("Expected expired request to be retried")
+	}
+}
+
+func TestPendingBlocksPool_PruneRemovesDescendants(t *testing.T) {
+	pool := newPendingBlocksPool(pendingBlocksMemoryLimit)
+	b1, r1 := pendingTestBlock(t, 10, [32]byte{'a'})
+	b2, r2 := pendingTestBlock(t, 40, r1)
+	b3, r3 := pendingTestBlock(t, 41, r2)
+	b4, r4 := pendingTestBlock(t, 40, [32]byte{'b'})
+	pool.insert(b1, r1)
+	pool.insert(b2, r2)
+	pool.insert(b3, r3)
+	pool.insert(b4, r4)
+
+	if pruned := pool.prune(31, time.Minute); pruned != 3 {
+		t.Errorf("Wanted 3 pruned blocks, got %d", pruned)
+	}
+	if pool.len() != 1 || !pool.has(r4) {
+		t.Error("Expected only the unrelated block to remain")
+	}
+}
+
+func TestPendingBlocksPool_Sorted(t *testing.T) {
+	pool := newPendingBlocksPool(pendingBlocksMemoryLimit)
+	for _, slot := range []uint64{9, 3, 7, 1} {
+		b, r := pendingTestBlock(t, slot, [32]byte{byte(slot)})
+		pool.insert(b, r)
+	}
+	sorted := pool.sorted()
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].block.Block.Slot > sorted[i].block.Block.Slot {
+			t.Fatalf("Pending blocks not sorted by slot: %d > %d", sorted[i-1].block.Block.Slot, sorted[i].block.Block.Slot)
+		}
+	}
+}
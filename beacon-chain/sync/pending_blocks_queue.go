@@ -5,12 +5,14 @@ import (
 	"encoding/hex"
 	"sort"
 	"sync"
+	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
-	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -21,6 +23,18 @@ import (
 
 var processPendingBlocksPeriod = slotutil.DivideSlotBy(3 /* times per slot */)
 
+// maxPendingBlockLookupDepth is the maximum number of missing ancestors walked back
+// in a single processing round.
+const maxPendingBlockLookupDepth = 8
+
+// maxPendingBlockLookupPeers is the maximum number of peers queried for the same set
+// of missing parent roots.
+const maxPendingBlockLookupPeers = 3
+
+// pendingBlockRequestTimeout is the duration after which a parent root that has not
+// been received may be requested again.
+var pendingBlockRequestTimeout = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
 // processes pending blocks queue on every processPendingBlocksPeriod
 func (r *Service) processPendingBlocksQueue() {
 	ctx := context.Background()
@@ -40,78 +54,27 @@ func (r *Service) processPendingBlocks(ctx context.Context) error {
 	defer span.End()
 
 	pids := r.p2p.Peers().Connected()
-	if err := r.validatePendingSlots(); err != nil {
-		return errors.Wrap(err, "could not validate pending slots")
-	}
-	slots := r.sortedPendingSlots()
+	r.validatePendingBlocks()
+	pending := r.pendingBlocks.sorted()
 
 	span.AddAttributes(
-		trace.Int64Attribute("numSlots", int64(len(slots))),
+		trace.Int64Attribute("numBlocks", int64(len(pending))),
 		trace.Int64Attribute("numPeers", int64(len(pids))),
 	)
 
-	for _, s := range slots {
+	for _, p := range pending {
 		ctx, span := trace.StartSpan(ctx, "processPendingBlocks.InnerLoop")
-		span.AddAttributes(trace.Int64Attribute("slot", int64(s)))
-
-		r.pendingQueueLock.RLock()
-		b := r.slotToPendingBlocks[s]
-		// Skip if block does not exist.
-		if b == nil || b.Block == nil {
-			r.pendingQueueLock.RUnlock()
-			span.End()
-			continue
-		}
-		r.pendingQueueLock.RUnlock()
-		inPendingQueue := r.seenPendingBlocks[bytesutil.ToBytes32(b.Block.ParentRoot)]
-
-		inDB := r.db.HasBlock(ctx, bytesutil.ToBytes32(b.Block.ParentRoot))
-		hasPeer := len(pids) != 0
-
-		// Only request for missing parent block if it's not in DB, not in pending cache
-		// and has peer in the peer list.
-		if !inPendingQueue && !inDB && hasPeer {
-			log.WithFields(logrus.Fields{
-				"currentSlot": b.Block.Slot,
-				"parentRoot":  hex.EncodeToString(bytesutil.Trunc(b.Block.ParentRoot)),
-			}).Info("Requesting parent block")
-			req := [][]byte{b.Block.ParentRoot}
-
-			// Start with a random peer to query, but choose the first peer in our unsorted list that claims to
-			// have a head slot newer than the block slot we are requesting.
-			pid := pids[rand.Int()%len(pids)]
-			for _, p := range pids {
-				cs, err := r.p2p.Peers().ChainState(p)
-				if err != nil {
-					return errors.Wrap(err, "failed to read chain state for peer")
-				}
-				if cs != nil && cs.HeadSlot >= s {
-					pid = p
-					break
-				}
-			}
-
-			if err := r.sendRecentBeaconBlocksRequest(ctx, req, pid); err != nil {
-				traceutil.AnnotateError(span, err)
-				log.Errorf("Could not send recent block request: %v", err)
-			}
-			span.End()
-			continue
-		}
+		b := p.block
+		span.AddAttributes(trace.Int64Attribute("slot", int64(b.Block.Slot)))
 
-		if !inDB {
+		// Blocks are visited in ascending slot order, so a parent processed in this
+		// round is already in the DB by the time its children are visited.
+		if !r.db.HasBlock(ctx, bytesutil.ToBytes32(b.Block.ParentRoot)) {
 			span.End()
 			continue
 		}
 
-		blkRoot, err := stateutil.BlockRoot(b.Block)
-		if err != nil {
-			traceutil.AnnotateError(span, err)
-			span.End()
-			return err
-		}
-
-		if err := r.chain.ReceiveBlockNoPubsub(ctx, b, blkRoot); err != nil {
+		if err := r.chain.ReceiveBlockNoPubsub(ctx, b, p.root); err != nil {
 			log.Errorf("Could not process block from slot %d: %v", b.Block.Slot, err)
 			traceutil.AnnotateError(span, err)
 		}
@@ -121,75 +84,131 @@ func (r *Service) processPendingBlocks(ctx context.Context) error {
 			log.WithError(err).Error("Failed to broadcast block")
 		}
 
-		r.pendingQueueLock.Lock()
-		delete(r.slotToPendingBlocks, s)
-		delete(r.seenPendingBlocks, blkRoot)
-		r.pendingQueueLock.Unlock()
+		r.pendingBlocks.delete(p.root)
+		pendingBlockResolutionLatency.Observe(roughtime.Since(p.receivedAt).Seconds())
 
 		log.WithFields(logrus.Fields{
-			"slot":      s,
-			"blockRoot": hex.EncodeToString(bytesutil.Trunc(blkRoot[:])),
+			"slot":      b.Block.Slot,
+			"blockRoot": hex.EncodeToString(bytesutil.Trunc(p.root[:])),
 		}).Debug("Processed pending block and cleared it in cache")
 
 		span.End()
 	}
 
+	if len(pids) != 0 {
+		if err := r.requestMissingAncestors(ctx, pids); err != nil {
+			traceutil.AnnotateError(span, err)
+			return err
+		}
+	}
+	pendingBlocksQueueDepth.Set(float64(r.pendingBlocks.len()))
+
 	return nil
 }
 
-func (r *Service) sortedPendingSlots() []uint64 {
-	r.pendingQueueLock.RLock()
-	defer r.pendingQueueLock.RUnlock()
+// requestMissingAncestors requests the unknown parents of all pending blocks from peers.
+// Parents received this way which are themselves orphaned are followed up in the same
+// round, walking back up to maxPendingBlockLookupDepth missing ancestors.
+func (r *Service) requestMissingAncestors(ctx context.Context, pids []peer.ID) error {
+	ctx, span := trace.StartSpan(ctx, "requestMissingAncestors")
+	defer span.End()
 
-	slots := make([]uint64, 0, len(r.slotToPendingBlocks))
-	for s := range r.slotToPendingBlocks {
-		slots = append(slots, s)
-	}
-	sort.Slice(slots, func(i, j int) bool {
-		return slots[i] < slots[j]
-	})
-	return slots
-}
+	for depth := 0; depth < maxPendingBlockLookupDepth; depth++ {
+		missing := r.pendingBlocks.missingParents(pendingBlockRequestTimeout)
+		roots := make([][32]byte, 0, len(missing))
+		lowestSlot := uint64(0)
+		for root, slot := range missing {
+			if r.db.HasBlock(ctx, root) {
+				continue
+			}
+			if len(roots) == 0 || slot < lowestSlot {
+				lowestSlot = slot
+			}
+			roots = append(roots, root)
+		}
+		if len(roots) == 0 {
+			return nil
+		}
+		sort.Slice(roots, func(i, j int) bool {
+			return missing[roots[i]] < missing[roots[j]]
+		})
+		if uint64(len(roots)) > params.BeaconNetworkConfig().MaxRequestBlocks {
+			roots = roots[:params.BeaconNetworkConfig().MaxRequestBlocks]
+		}
+		r.pendingBlocks.markRequested(roots)
 
-// validatePendingSlots validates the pending blocks
-// by their slot. If they are before the current finalized
-// checkpoint, these blocks are removed from the queue.
-func (r *Service) validatePendingSlots() error {
-	r.pendingQueueLock.Lock()
-	defer r.pendingQueueLock.Unlock()
-	oldBlockRoots := make(map[[32]byte]bool)
+		log.WithFields(logrus.Fields{
+			"lowestSlot": lowestSlot,
+			"numRoots":   len(roots),
+			"depth":      depth,
+		}).Info("Requesting missing parent blocks")
 
-	finalizedEpoch := r.chain.FinalizedCheckpt().Epoch
-	for s, b := range r.slotToPendingBlocks {
-		epoch := helpers.SlotToEpoch(s)
-		// remove all descendant blocks of old blocks
-		if oldBlockRoots[bytesutil.ToBytes32(b.Block.ParentRoot)] {
-			root, err := stateutil.BlockRoot(b.Block)
-			if err != nil {
-				return err
-			}
-			oldBlockRoots[root] = true
-			delete(r.slotToPendingBlocks, s)
-			delete(r.seenPendingBlocks, root)
-			continue
+		peers, err := r.pendingBlockLookupPeers(pids, lowestSlot)
+		if err != nil {
+			return err
 		}
-		// don't process old blocks
-		if finalizedEpoch > 0 && epoch <= finalizedEpoch {
-			blkRoot, err := stateutil.BlockRoot(b.Block)
-			if err != nil {
-				return err
+		for _, pid := range peers {
+			req := make([][]byte, 0, len(roots))
+			for _, root := range roots {
+				if !r.pendingBlocks.has(root) && !r.db.HasBlock(ctx, root) {
+					req = append(req, root[:])
+				}
+			}
+			if len(req) == 0 {
+				break
+			}
+			if err := r.sendRecentBeaconBlocksRequest(ctx, req, pid); err != nil {
+				traceutil.AnnotateError(span, err)
+				log.WithField("peer", pid).Errorf("Could not send recent block request: %v", err)
 			}
-			oldBlockRoots[blkRoot] = true
-			delete(r.slotToPendingBlocks, s)
-			delete(r.seenPendingBlocks, blkRoot)
 		}
 	}
 	return nil
 }
 
-func (r *Service) clearPendingSlots() {
-	r.pendingQueueLock.Lock()
-	defer r.pendingQueueLock.Unlock()
-	r.slotToPendingBlocks = make(map[uint64]*ethpb.SignedBeaconBlock)
-	r.seenPendingBlocks = make(map[[32]byte]bool)
+// pendingBlockLookupPeers selects up to maxPendingBlockLookupPeers peers, in random order,
+// which claim to have a head slot at or above the given slot. If no peer qualifies, a
+// single random peer is returned.
+func (r *Service) pendingBlockLookupPeers(pids []peer.ID, slot uint64) ([]peer.ID, error) {
+	shuffled := make([]peer.ID, len(pids))
+	copy(shuffled, pids)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	selected := make([]peer.ID, 0, maxPendingBlockLookupPeers)
+	for _, p := range shuffled {
+		cs, err := r.p2p.Peers().ChainState(p)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read chain state for peer")
+		}
+		if cs != nil && cs.HeadSlot >= slot {
+			selected = append(selected, p)
+		}
+		if len(selected) == maxPendingBlockLookupPeers {
+			break
+		}
+	}
+	if len(selected) == 0 && len(shuffled) > 0 {
+		selected = append(selected, shuffled[0])
+	}
+	return selected, nil
+}
+
+// validatePendingBlocks validates the pending blocks by their slot. If they are
+// before the current finalized checkpoint, these blocks and all their descendants
+// are removed from the queue.
+func (r *Service) validatePendingBlocks() {
+	finalizedEpoch := r.chain.FinalizedCheckpt().Epoch
+	pruneSlot := uint64(0)
+	if finalizedEpoch > 0 {
+		pruneSlot = helpers.StartSlot(finalizedEpoch+1) - 1
+	}
+	if pruned := r.pendingBlocks.prune(pruneSlot, pendingBlockRequestTimeout); pruned > 0 {
+		pendingBlocksExpiredCounter.Add(float64(pruned))
+	}
+}
+
+func (r *Service) clearPendingBlocks() {
+	r.pendingBlocks.clear()
 }
@@ -2,10 +2,9 @@ package sync
 
 import (
 	"context"
-	"math"
-	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/network"
@@ -14,10 +13,13 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 //    /- b1 - b2
@@ -36,8 +38,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks1(t *testing.T) {
 				Epoch: 0,
 			},
 		},
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlocksPool(pendingBlocksMemoryLimit),
 	}
 
 	b0 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
@@ -59,39 +60,31 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks1(t *testing.T) {
 		t.Fatal(err)
 	}
 	b2 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 2, ParentRoot: b1Root[:]}}
-	b2Root, err := stateutil.BlockRoot(b1.Block)
+	b2Root, err := stateutil.BlockRoot(b2.Block)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Add b2 to the cache
-	r.slotToPendingBlocks[b2.Block.Slot] = b2
-	r.seenPendingBlocks[b2Root] = true
+	r.pendingBlocks.insert(b2, b2Root)
 
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 1 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	if len(r.seenPendingBlocks) != 1 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 1 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 
 	// Add b1 to the cache
-	r.slotToPendingBlocks[b1.Block.Slot] = b1
-	r.seenPendingBlocks[b1Root] = true
+	r.pendingBlocks.insert(b1, b1Root)
 	if err := r.db.SaveBlock(context.Background(), b1); err != nil {
 		t.Fatal(err)
 	}
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	if len(r.seenPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 0 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 }
 
@@ -133,8 +126,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks2(t *testing.T) {
 				Epoch: 0,
 			},
 		},
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlocksPool(pendingBlocksMemoryLimit),
 	}
 	p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirOutbound)
 	p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
@@ -179,40 +171,30 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks2(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	r.slotToPendingBlocks[b4.Slot] = &ethpb.SignedBeaconBlock{Block: b4}
-	r.seenPendingBlocks[b4Root] = true
-	r.slotToPendingBlocks[b5.Slot] = &ethpb.SignedBeaconBlock{Block: b5}
-	r.seenPendingBlocks[b5Root] = true
+	r.pendingBlocks.insert(&ethpb.SignedBeaconBlock{Block: b4}, b4Root)
+	r.pendingBlocks.insert(&ethpb.SignedBeaconBlock{Block: b5}, b5Root)
 
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 2 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	if len(r.seenPendingBlocks) != 2 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 2 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 
 	// Add b3 to the cache
-	r.slotToPendingBlocks[b3.Slot] = &ethpb.SignedBeaconBlock{Block: b3}
-	r.seenPendingBlocks[b3Root] = true
+	r.pendingBlocks.insert(&ethpb.SignedBeaconBlock{Block: b3}, b3Root)
 	if err := r.db.SaveBlock(context.Background(), &ethpb.SignedBeaconBlock{Block: b3}); err != nil {
 		t.Fatal(err)
 	}
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 1 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	if len(r.seenPendingBlocks) != 1 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 1 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 
 	// Add b2 to the cache
-	r.slotToPendingBlocks[b2.Slot] = &ethpb.SignedBeaconBlock{Block: b2}
-	r.seenPendingBlocks[b2Root] = true
+	r.pendingBlocks.insert(&ethpb.SignedBeaconBlock{Block: b2}, b2Root)
 
 	if err := r.db.SaveBlock(context.Background(), &ethpb.SignedBeaconBlock{Block: b2}); err != nil {
 		t.Fatal(err)
@@ -220,12 +202,8 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks2(t *testing.T) {
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	t.Log(r.seenPendingBlocks)
-	if len(r.seenPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 0 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 }
 
@@ -246,8 +224,7 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 				Epoch: 1,
 			},
 		},
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlocksPool(pendingBlocksMemoryLimit),
 	}
 	p1.Peers().Add(new(enr.Record), p1.PeerID(), nil, network.DirOutbound)
 	p1.Peers().SetConnectionState(p1.PeerID(), peers.PeerConnected)
@@ -292,40 +269,110 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	r.slotToPendingBlocks[b2.Slot] = &ethpb.SignedBeaconBlock{Block: b2}
-	r.seenPendingBlocks[b2Root] = true
-	r.slotToPendingBlocks[b3.Slot] = &ethpb.SignedBeaconBlock{Block: b3}
-	r.seenPendingBlocks[b3Root] = true
-	r.slotToPendingBlocks[b4.Slot] = &ethpb.SignedBeaconBlock{Block: b4}
-	r.seenPendingBlocks[b4Root] = true
-	r.slotToPendingBlocks[b5.Slot] = &ethpb.SignedBeaconBlock{Block: b5}
-	r.seenPendingBlocks[b5Root] = true
+	r.pendingBlocks.insert(&ethpb.SignedBeaconBlock{Block: b2}, b2Root)
+	r.pendingBlocks.insert(&ethpb.SignedBeaconBlock{Block: b3}, b3Root)
+	r.pendingBlocks.insert(&ethpb.SignedBeaconBlock{Block: b4}, b4Root)
+	r.pendingBlocks.insert(&ethpb.SignedBeaconBlock{Block: b5}, b5Root)
 
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	if len(r.seenPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 0 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 }
 
-func TestService_sortedPendingSlots(t *testing.T) {
+// b0 - b1 - b2 - b3
+// Test b1 and b2 are missing and both are fetched from a peer in a single pass,
+// starting from the pending b3.
+func TestRegularSyncBeaconBlockSubscriber_RequestsMissingAncestors(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	if len(p1.Host.Network().Peers()) != 1 {
+		t.Error("Expected peers to be connected")
+	}
+
 	r := &Service{
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
+		p2p: p1,
+		db:  db,
+		chain: &mock.ChainService{
+			FinalizedCheckPoint: &ethpb.Checkpoint{
+				Epoch: 0,
+			},
+		},
+		pendingBlocks: newPendingBlocksPool(pendingBlocksMemoryLimit),
+	}
+	p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirOutbound)
+	p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
+	p1.Peers().SetChainState(p2.PeerID(), &pb.Status{HeadSlot: 3})
+
+	b0 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
+	if err := r.db.SaveBlock(context.Background(), b0); err != nil {
+		t.Fatal(err)
+	}
+	b0Root, err := stateutil.BlockRoot(b0.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b1 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, ParentRoot: b0Root[:]}}
+	b1Root, err := stateutil.BlockRoot(b1.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 2, ParentRoot: b1Root[:]}}
+	b2Root, err := stateutil.BlockRoot(b2.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b3 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 3, ParentRoot: b2Root[:]}}
+	b3Root, err := stateutil.BlockRoot(b3.Block)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	var lastSlot uint64 = math.MaxUint64
-	r.slotToPendingBlocks[lastSlot] = &ethpb.SignedBeaconBlock{}
-	r.slotToPendingBlocks[lastSlot-3] = &ethpb.SignedBeaconBlock{}
-	r.slotToPendingBlocks[lastSlot-5] = &ethpb.SignedBeaconBlock{}
-	r.slotToPendingBlocks[lastSlot-2] = &ethpb.SignedBeaconBlock{}
+	served := map[[32]byte]*ethpb.SignedBeaconBlock{b1Root: b1, b2Root: b2}
+	var wg sync.WaitGroup
+	wg.Add(2)
+	pcl := protocol.ID(p2p.RPCBlocksByRootTopic + p2.Encoding().ProtocolSuffix())
+	p2.Host.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		req := &pb.BeaconBlocksByRootRequest{}
+		if err := p2.Encoding().DecodeWithLength(stream, req); err != nil {
+			t.Fatal(err)
+		}
+		for _, root := range req.BlockRoots {
+			blk, ok := served[bytesutil.ToBytes32(root)]
+			if !ok {
+				t.Errorf("Unexpected request for root %#x", root)
+				continue
+			}
+			if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+				t.Fatalf("Failed to write to stream: %v", err)
+			}
+			if _, err := p2.Encoding().EncodeWithLength(stream, blk); err != nil {
+				t.Errorf("Could not send response back: %v ", err)
+			}
+		}
+		if err := stream.Close(); err != nil {
+			t.Error(err)
+		}
+	})
 
-	want := []uint64{lastSlot - 5, lastSlot - 3, lastSlot - 2, lastSlot}
-	got := r.sortedPendingSlots()
-	if !reflect.DeepEqual(want, got) {
-		t.Errorf("unexpected pending slots list, want: %v, got: %v", want, got)
+	r.pendingBlocks.insert(b3, b3Root)
+	if err := r.processPendingBlocks(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive both ancestor requests within 1 sec")
+	}
+	if r.pendingBlocks.len() != 3 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
+	}
+	for _, root := range [][32]byte{b1Root, b2Root, b3Root} {
+		if !r.pendingBlocks.has(root) {
+			t.Errorf("Expected block root %#x in pending blocks cache", root)
+		}
 	}
 }
@@ -0,0 +1,123 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/sirupsen/logrus"
+)
+
+// restorePooledOperations inserts the voluntary exits and slashings saved from the operation pools
+// when the node last ran back into the pools. Operations which are no longer valid for the head state
+// are not restored.
+func (r *Service) restorePooledOperations(ctx context.Context) error {
+	headState, err := r.chain.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return errors.New("head state is nil")
+	}
+
+	exits, err := r.db.PoolVoluntaryExits(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve pooled voluntary exits")
+	}
+	for _, exit := range exits {
+		r.exitPool.InsertVoluntaryExit(ctx, headState, exit)
+	}
+	proposerSlashings, err := r.db.PoolProposerSlashings(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve pooled proposer slashings")
+	}
+	for _, slashing := range proposerSlashings {
+		if err := r.slashingPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+			log.WithError(err).Debug("Could not restore proposer slashing")
+		}
+	}
+	attesterSlashings, err := r.db.PoolAttesterSlashings(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve pooled attester slashings")
+	}
+	for _, slashing := range attesterSlashings {
+		if err := r.slashingPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+			log.WithError(err).Debug("Could not restore attester slashing")
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"voluntaryExits":    len(exits),
+		"proposerSlashings": len(proposerSlashings),
+		"attesterSlashings": len(attesterSlashings),
+	}).Debug("Restored pooled operations")
+	return nil
+}
+
+// maintainPooledOperations re-gossips the voluntary exits and slashings of the operation pools
+// once per epoch, until they are included in a block, and saves the pools to the db.
+func (r *Service) maintainPooledOperations() {
+	interval := time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch) * time.Second
+	runutil.RunEvery(r.ctx, interval, func() {
+		if !r.chainStarted || r.initialSync.Syncing() {
+			return
+		}
+		if err := r.rebroadcastPooledOperations(r.ctx); err != nil {
+			log.WithError(err).Error("Could not re-gossip pooled operations")
+		}
+		if err := r.savePooledOperations(r.ctx); err != nil {
+			log.WithError(err).Error("Could not save pooled operations")
+		}
+	})
+}
+
+// rebroadcastPooledOperations gossips the pooled voluntary exits and slashings which can be included
+// on top of the head state. The pools first evict the operations which can no longer be included on
+// top of the head state.
+func (r *Service) rebroadcastPooledOperations(ctx context.Context) error {
+	headState, err := r.chain.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return errors.New("head state is nil")
+	}
+	r.exitPool.Prune(headState)
+	r.slashingPool.Prune(ctx, headState)
+
+	ops := make([]proto.Message, 0)
+	for _, exit := range r.exitPool.PendingExits(headState, headState.Slot()) {
+		ops = append(ops, exit)
+	}
+	for _, slashing := range r.slashingPool.PendingProposerSlashings(ctx, headState) {
+		ops = append(ops, slashing)
+	}
+	for _, slashing := range r.slashingPool.PendingAttesterSlashings(ctx, headState) {
+		ops = append(ops, slashing)
+	}
+	for _, op := range ops {
+		if err := r.p2p.Broadcast(ctx, op); err != nil {
+			return errors.Wrapf(err, "could not broadcast %T", op)
+		}
+		pooledOperationsRebroadcast.Inc()
+	}
+	return nil
+}
+
+// savePooledOperations replaces the voluntary exits and slashings saved from the operation pools
+// with the current content of the pools.
+func (r *Service) savePooledOperations(ctx context.Context) error {
+	if err := r.db.SavePoolVoluntaryExits(ctx, r.exitPool.AllExits()); err != nil {
+		return errors.Wrap(err, "could not save pooled voluntary exits")
+	}
+	if err := r.db.SavePoolProposerSlashings(ctx, r.slashingPool.AllProposerSlashings()); err != nil {
+		return errors.Wrap(err, "could not save pooled proposer slashings")
+	}
+	if err := r.db.SavePoolAttesterSlashings(ctx, r.slashingPool.AllAttesterSlashings()); err != nil {
+		return errors.Wrap(err, "could not save pooled attester slashings")
+	}
+	return nil
+}
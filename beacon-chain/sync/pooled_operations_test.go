@@ -0,0 +1,106 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+)
+
+func TestService_RestorePooledOperations(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	ctx := context.Background()
+	exit, s := setupValidExit(t)
+	if err := db.SavePoolVoluntaryExits(ctx, []*ethpb.SignedVoluntaryExit{exit}); err != nil {
+		t.Fatal(err)
+	}
+	r := &Service{
+		db:           db,
+		chain:        &mock.ChainService{State: s},
+		exitPool:     voluntaryexits.NewPool(),
+		slashingPool: slashings.NewPool(),
+	}
+
+	if err := r.restorePooledOperations(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if exits := r.exitPool.AllExits(); len(exits) != 1 || !proto.Equal(exits[0], exit) {
+		t.Errorf("Wanted restored exit %v, received %v", exit, exits)
+	}
+}
+
+func TestService_RebroadcastAndSavePooledOperations(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	ctx := context.Background()
+	exit, s := setupValidExit(t)
+	p := p2ptest.NewTestP2P(t)
+	r := &Service{
+		db:           db,
+		p2p:          p,
+		chain:        &mock.ChainService{State: s},
+		exitPool:     voluntaryexits.NewPool(),
+		slashingPool: slashings.NewPool(),
+	}
+	r.exitPool.InsertVoluntaryExit(ctx, s, exit)
+
+	if err := r.rebroadcastPooledOperations(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !p.BroadcastCalled {
+		t.Error("Expected the pooled exit to be broadcast")
+	}
+	if status, _ := r.exitPool.ExitStatus(exit.Exit.ValidatorIndex); status != voluntaryexits.ExitPending {
+		t.Errorf("Wanted the exit to remain pending until included, received status %d", status)
+	}
+
+	if err := r.savePooledOperations(ctx); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := db.PoolVoluntaryExits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved) != 1 || !proto.Equal(saved[0], exit) {
+		t.Errorf("Wanted saved exit %v, received %v", exit, saved)
+	}
+}
+
+func TestService_RebroadcastPooledOperations_PrunesInvalidOperations(t *testing.T) {
+	ctx := context.Background()
+	exit, s := setupValidExit(t)
+	p := p2ptest.NewTestP2P(t)
+	headState := s.Copy()
+	val, err := headState.ValidatorAtIndex(exit.Exit.ValidatorIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The validator exited since the exit was pooled.
+	val.ExitEpoch = helpers.CurrentEpoch(headState)
+	if err := headState.UpdateValidatorAtIndex(exit.Exit.ValidatorIndex, val); err != nil {
+		t.Fatal(err)
+	}
+	r := &Service{
+		p2p:          p,
+		chain:        &mock.ChainService{State: headState},
+		exitPool:     voluntaryexits.NewPool(),
+		slashingPool: slashings.NewPool(),
+	}
+	r.exitPool.InsertVoluntaryExit(ctx, s, exit)
+
+	if err := r.rebroadcastPooledOperations(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if p.BroadcastCalled {
+		t.Error("Expected the invalid exit not to be broadcast")
+	}
+	if status, _ := r.exitPool.ExitStatus(exit.Exit.ValidatorIndex); status != voluntaryexits.ExitInvalid {
+		t.Errorf("Wanted the exit to be evicted as invalid, received status %d", status)
+	}
+}
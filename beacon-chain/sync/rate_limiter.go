@@ -0,0 +1,78 @@
+package sync
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+)
+
+var errRateLimited = errors.New(rateLimitedError)
+
+// limiter defines a struct which represents a collection of per-peer token buckets,
+// one for each req/resp topic. It is used to rate limit incoming requests before
+// they are decoded and handed off to their respective rpc handlers.
+type limiter struct {
+	limiterMap map[string]*leakybucket.Collector
+	lock       sync.Mutex
+}
+
+// newRateLimiter instantiates a rate limiter with a collector for each of the
+// registered rpc topics. Capacities are derived from the global beacon node flags.
+func newRateLimiter(p2pProvider p2p.P2P) *limiter {
+	// Initialize request limits for the non-block topics.
+	allowedRequestsPerSecond := float64(flags.Get().RPCRequestLimit)
+	allowedRequestsBurst := int64(flags.Get().RPCRequestLimitBurstFactor * flags.Get().RPCRequestLimit)
+
+	// Initialize block request limits. The blocks topics are additionally bounded by the
+	// amount of blocks served in the respective handlers, so only the number of requests
+	// is limited here.
+	allowedBlockRequestsPerSecond := float64(flags.Get().BlockBatchLimit)
+	allowedBlockRequestsBurst := int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit)
+
+	suffix := p2pProvider.Encoding().ProtocolSuffix()
+	topicMap := map[string]*leakybucket.Collector{
+		p2p.RPCStatusTopic + suffix:        leakybucket.NewCollector(allowedRequestsPerSecond, allowedRequestsBurst, false /* deleteEmptyBuckets */),
+		p2p.RPCGoodByeTopic + suffix:       leakybucket.NewCollector(allowedRequestsPerSecond, allowedRequestsBurst, false /* deleteEmptyBuckets */),
+		p2p.RPCPingTopic + suffix:          leakybucket.NewCollector(allowedRequestsPerSecond, allowedRequestsBurst, false /* deleteEmptyBuckets */),
+		p2p.RPCMetaDataTopic + suffix:      leakybucket.NewCollector(allowedRequestsPerSecond, allowedRequestsBurst, false /* deleteEmptyBuckets */),
+		p2p.RPCBlocksByRangeTopic + suffix: leakybucket.NewCollector(allowedBlockRequestsPerSecond, allowedBlockRequestsBurst, false /* deleteEmptyBuckets */),
+		p2p.RPCBlocksByRootTopic + suffix:  leakybucket.NewCollector(allowedBlockRequestsPerSecond, allowedBlockRequestsBurst, false /* deleteEmptyBuckets */),
+	}
+	return &limiter{limiterMap: topicMap}
+}
+
+// validateRequest checks whether the remote peer of the given stream has enough
+// remaining capacity on the stream's topic to make a request of the provided amount,
+// and if so adds the amount to the peer's bucket. The check and the addition happen
+// in the same critical section, so concurrent requests cannot both pass on the last
+// remaining tokens. Topics without a registered collector are not rate limited.
+func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	collector, ok := l.limiterMap[string(stream.Protocol())]
+	if !ok {
+		return nil
+	}
+	key := stream.Conn().RemotePeer().String()
+	if int64(amt) > collector.Remaining(key) {
+		return errRateLimited
+	}
+	collector.Add(key, int64(amt))
+	return nil
+}
+
+// free releases all the buckets held by the limiter. The collectors stay registered,
+// so handlers still in flight when the service stops keep being rate limited.
+func (l *limiter) free() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for _, collector := range l.limiterMap {
+		collector.Free()
+	}
+}
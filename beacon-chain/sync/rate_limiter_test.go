@@ -0,0 +1,111 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	rlimiter := newRateLimiter(p1)
+	topics := []string{
+		p2p.RPCStatusTopic,
+		p2p.RPCGoodByeTopic,
+		p2p.RPCPingTopic,
+		p2p.RPCMetaDataTopic,
+		p2p.RPCBlocksByRangeTopic,
+		p2p.RPCBlocksByRootTopic,
+	}
+	if len(rlimiter.limiterMap) != len(topics) {
+		t.Fatalf("Wanted %d collectors, got %d", len(topics), len(rlimiter.limiterMap))
+	}
+	for _, topic := range topics {
+		if _, ok := rlimiter.limiterMap[topic+p1.Encoding().ProtocolSuffix()]; !ok {
+			t.Errorf("No collector registered for topic %s", topic)
+		}
+	}
+}
+
+func TestRateLimiter_ExceedCapacity(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	rlimiter := newRateLimiter(p1)
+
+	topic := p2p.RPCPingTopic + p1.Encoding().ProtocolSuffix()
+	p2.Host.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+	stream, err := p1.Host.NewStream(context.Background(), p2.Host.ID(), protocol.ID(topic))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	burst := flags.Get().RPCRequestLimit * flags.Get().RPCRequestLimitBurstFactor
+	for i := 0; i < burst; i++ {
+		if err := rlimiter.validateRequest(stream, 1); err != nil {
+			t.Fatalf("Unexpected error on request %d: %v", i, err)
+		}
+	}
+	if err := rlimiter.validateRequest(stream, 1); err != errRateLimited {
+		t.Errorf("Expected error %v, got %v", errRateLimited, err)
+	}
+}
+
+func TestRateLimiter_ConcurrentRequestsDoNotExceedCapacity(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	rlimiter := newRateLimiter(p1)
+
+	topic := p2p.RPCPingTopic + p1.Encoding().ProtocolSuffix()
+	p2.Host.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+	stream, err := p1.Host.NewStream(context.Background(), p2.Host.ID(), protocol.ID(topic))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	burst := flags.Get().RPCRequestLimit * flags.Get().RPCRequestLimitBurstFactor
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	accepted := 0
+	for i := 0; i < 2*burst; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := rlimiter.validateRequest(stream, 1); err == nil {
+				lock.Lock()
+				accepted++
+				lock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if accepted > burst {
+		t.Errorf("Accepted %d requests, wanted at most %d", accepted, burst)
+	}
+}
+
+func TestRateLimiter_UnknownTopicIsNotLimited(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	rlimiter := newRateLimiter(p1)
+
+	topic := protocol.ID("/testing/foobar/1")
+	p2.Host.SetStreamHandler(topic, func(stream network.Stream) {})
+	stream, err := p1.Host.NewStream(context.Background(), p2.Host.ID(), topic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		if err := rlimiter.validateRequest(stream, 1); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+}
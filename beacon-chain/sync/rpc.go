@@ -90,6 +90,15 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 		// Increment message received counter.
 		messageReceivedCounter.WithLabelValues(topic).Inc()
 
+		// Reject the request before decoding if the peer has exhausted its quota for this topic.
+		if err := r.rateLimiter.validateRequest(stream, 1); err != nil {
+			rpcRateLimitedCounter.WithLabelValues(topic).Inc()
+			log.WithError(err).Debug("Peer exceeded rpc rate limit")
+			r.rateLimitPeer(stream)
+			traceutil.AnnotateError(span, err)
+			return
+		}
+
 		// since metadata requests do not have any data in the payload, we
 		// do not decode anything.
 		if strings.Contains(topic, p2p.RPCMetaDataTopic) {
@@ -145,3 +154,19 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 
 	})
 }
+
+// rateLimitPeer responds to a rate limited request with a resource unavailable error
+// and penalizes the remote peer, disconnecting it once it is considered bad. Goodbye
+// messages expect no response, so their streams are only closed.
+func (r *Service) rateLimitPeer(stream libp2pcore.Stream) {
+	if !strings.Contains(string(stream.Protocol()), p2p.RPCGoodByeTopic) {
+		r.writeErrorResponseToStream(responseCodeResourceUnavailable, rateLimitedError, stream)
+	}
+	r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
+	if r.p2p.Peers().IsBad(stream.Conn().RemotePeer()) {
+		log.WithField("peer", stream.Conn().RemotePeer().Pretty()).Debug("Disconnecting bad peer")
+		if err := r.p2p.Disconnect(stream.Conn().RemotePeer()); err != nil {
+			log.WithError(err).Error("Failed to disconnect peer")
+		}
+	}
+}
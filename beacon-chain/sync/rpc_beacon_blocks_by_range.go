@@ -65,17 +65,8 @@ func (r *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 	for startSlot <= endReqSlot {
 		remainingBucketCapacity = r.blocksRateLimiter.Remaining(stream.Conn().RemotePeer().String())
 		if int64(allowedBlocksPerSecond) > remainingBucketCapacity {
-			r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
-			if r.p2p.Peers().IsBad(stream.Conn().RemotePeer()) {
-				log.Debug("Disconnecting bad peer")
-				defer func() {
-					if err := r.p2p.Disconnect(stream.Conn().RemotePeer()); err != nil {
-						log.WithError(err).Error("Failed to disconnect peer")
-					}
-				}()
-			}
-			r.writeErrorResponseToStream(responseCodeInvalidRequest, rateLimitedError, stream)
-			return errors.New(rateLimitedError)
+			r.rateLimitPeer(stream)
+			return errRateLimited
 		}
 
 		// TODO(3147): Update this with reasonable constraints.
@@ -49,11 +49,7 @@ func (r *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots
 		if err != nil {
 			return err
 		}
-		r.pendingQueueLock.Lock()
-		r.slotToPendingBlocks[blk.Block.Slot] = blk
-		r.seenPendingBlocks[blkRoot] = true
-		r.pendingQueueLock.Unlock()
-
+		r.pendingBlocks.insert(blk, blkRoot)
 	}
 	return nil
 }
@@ -87,24 +83,8 @@ func (r *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{
 	}
 
 	if int64(len(req.BlockRoots)) > r.blocksRateLimiter.Remaining(stream.Conn().RemotePeer().String()) {
-		r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
-		if r.p2p.Peers().IsBad(stream.Conn().RemotePeer()) {
-			log.Debug("Disconnecting bad peer")
-			defer func() {
-				if err := r.p2p.Disconnect(stream.Conn().RemotePeer()); err != nil {
-					log.WithError(err).Error("Failed to disconnect peer")
-				}
-			}()
-		}
-		resp, err := r.generateErrorResponse(responseCodeInvalidRequest, rateLimitedError)
-		if err != nil {
-			log.WithError(err).Error("Failed to generate a response error")
-		} else {
-			if _, err := stream.Write(resp); err != nil {
-				log.WithError(err).Errorf("Failed to write to stream")
-			}
-		}
-		return errors.New(rateLimitedError)
+		r.rateLimitPeer(stream)
+		return errRateLimited
 	}
 
 	if uint64(len(req.BlockRoots)) > params.BeaconNetworkConfig().MaxRequestBlocks {
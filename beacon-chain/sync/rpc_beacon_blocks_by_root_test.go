@@ -121,10 +121,9 @@ func TestRecentBeaconBlocks_RPCRequestSent(t *testing.T) {
 			FinalizedCheckPoint: finalizedCheckpt,
 			Root:                blockARoot[:],
 		},
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:   make(map[[32]byte]bool),
-		ctx:                 context.Background(),
-		blocksRateLimiter:   leakybucket.NewCollector(10000, 10000, false),
+		pendingBlocks:     newPendingBlocksPool(pendingBlocksMemoryLimit),
+		ctx:               context.Background(),
+		blocksRateLimiter: leakybucket.NewCollector(10000, 10000, false),
 	}
 
 	// Setup streams
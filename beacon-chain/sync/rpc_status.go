@@ -68,7 +68,7 @@ func (r *Service) resyncIfBehind() {
 					"peersEpoch":   highestEpoch,
 				}).Info("Fallen behind peers; reverting to initial sync to catch up")
 				numberOfTimesResyncedCounter.Inc()
-				r.clearPendingSlots()
+				r.clearPendingBlocks()
 				if err := r.initialSync.Resync(); err != nil {
 					log.Errorf("Could not resync chain: %v", err)
 				}
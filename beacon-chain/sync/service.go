@@ -80,17 +80,16 @@ type Service struct {
 	exitPool                  *voluntaryexits.Pool
 	slashingPool              *slashings.Pool
 	chain                     blockchainService
-	slotToPendingBlocks       map[uint64]*ethpb.SignedBeaconBlock
-	seenPendingBlocks         map[[32]byte]bool
+	pendingBlocks             *pendingBlocksPool
 	blkRootToPendingAtts      map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof
 	pendingAttsLock           sync.RWMutex
-	pendingQueueLock          sync.RWMutex
 	chainStarted              bool
 	initialSync               Checker
 	validateBlockLock         sync.RWMutex
 	stateNotifier             statefeed.Notifier
 	blockNotifier             blockfeed.Notifier
 	blocksRateLimiter         *leakybucket.Collector
+	rateLimiter               *limiter
 	attestationNotifier       operation.Notifier
 	seenBlockLock             sync.RWMutex
 	seenBlockCache            *lru.Cache
@@ -124,14 +123,14 @@ func NewRegularSync(cfg *Config) *Service {
 		chain:                cfg.Chain,
 		initialSync:          cfg.InitialSync,
 		attestationNotifier:  cfg.AttestationNotifier,
-		slotToPendingBlocks:  make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:    make(map[[32]byte]bool),
+		pendingBlocks:        newPendingBlocksPool(pendingBlocksMemoryLimit),
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
 		stateNotifier:        cfg.StateNotifier,
 		blockNotifier:        cfg.BlockNotifier,
 		stateSummaryCache:    cfg.StateSummaryCache,
 		stateGen:             cfg.StateGen,
 		blocksRateLimiter:    leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, false /* deleteEmptyBuckets */),
+		rateLimiter:          newRateLimiter(cfg.P2P),
 	}
 
 	go r.registerHandlers()
@@ -152,6 +151,7 @@ func (r *Service) Start() {
 	r.processPendingAttsQueue()
 	r.maintainPeerStatuses()
 	r.resyncIfBehind()
+	r.maintainPooledOperations()
 
 	// Update sync metrics.
 	runutil.RunEvery(r.ctx, time.Second*10, r.updateMetrics)
@@ -159,7 +159,18 @@ func (r *Service) Start() {
 
 // Stop the regular sync service.
 func (r *Service) Stop() error {
+	defer func() {
+		if r.rateLimiter != nil {
+			r.rateLimiter.free()
+		}
+	}()
 	defer r.cancel()
+	if r.chainStarted {
+		// Save the operations received since the pools were last saved.
+		if err := r.savePooledOperations(context.Background()); err != nil {
+			log.WithError(err).Error("Could not save pooled operations")
+		}
+	}
 	return nil
 }
 
@@ -231,6 +242,10 @@ func (r *Service) registerHandlers() {
 				r.registerRPCHandlers()
 				r.registerSubscribers()
 
+				if err := r.restorePooledOperations(r.ctx); err != nil {
+					log.WithError(err).Error("Could not restore pooled operations")
+				}
+
 				if data.StartTime.After(roughtime.Now()) {
 					stateSub.Unsubscribe()
 					time.Sleep(roughtime.Until(data.StartTime))
@@ -16,6 +16,8 @@ func TestMain(m *testing.M) {
 	flags.Init(&flags.GlobalFlags{
 		BlockBatchLimit:            64,
 		BlockBatchLimitBurstFactor: 10,
+		RPCRequestLimit:            1,
+		RPCRequestLimitBurstFactor: 5,
 	})
 
 	os.Exit(m.Run())
@@ -69,12 +69,9 @@ func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		return pubsub.ValidationIgnore
 	}
 
-	r.pendingQueueLock.RLock()
-	if r.seenPendingBlocks[blockRoot] {
-		r.pendingQueueLock.RUnlock()
+	if r.pendingBlocks.has(blockRoot) {
 		return pubsub.ValidationIgnore
 	}
-	r.pendingQueueLock.RUnlock()
 
 	// Add metrics for block arrival time subtracts slot start time.
 	if captureArrivalTimeMetric(uint64(r.chain.GenesisTime().Unix()), blk.Block.Slot) != nil {
@@ -93,10 +90,7 @@ func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 
 	// Handle block when the parent is unknown.
 	if !r.db.HasBlock(ctx, bytesutil.ToBytes32(blk.Block.ParentRoot)) {
-		r.pendingQueueLock.Lock()
-		r.slotToPendingBlocks[blk.Block.Slot] = blk
-		r.seenPendingBlocks[blockRoot] = true
-		r.pendingQueueLock.Unlock()
+		r.pendingBlocks.insert(blk, blockRoot)
 		return pubsub.ValidationIgnore
 	}
 
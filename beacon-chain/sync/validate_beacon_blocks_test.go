@@ -193,11 +193,10 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 			FinalizedCheckPoint: &ethpb.Checkpoint{
 				Epoch: 0,
 			}},
-		seenBlockCache:      c,
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:   make(map[[32]byte]bool),
-		stateSummaryCache:   stateSummaryCache,
-		stateGen:            stateGen,
+		seenBlockCache:    c,
+		pendingBlocks:     newPendingBlocksPool(pendingBlocksMemoryLimit),
+		stateSummaryCache: stateSummaryCache,
+		stateGen:          stateGen,
 	}
 
 	buf := new(bytes.Buffer)
@@ -290,11 +289,10 @@ func TestValidateBeaconBlockPubSub_AdvanceEpochsForState(t *testing.T) {
 			FinalizedCheckPoint: &ethpb.Checkpoint{
 				Epoch: 0,
 			}},
-		seenBlockCache:      c,
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:   make(map[[32]byte]bool),
-		stateSummaryCache:   stateSummaryCache,
-		stateGen:            stateGen,
+		seenBlockCache:    c,
+		pendingBlocks:     newPendingBlocksPool(pendingBlocksMemoryLimit),
+		stateSummaryCache: stateSummaryCache,
+		stateGen:          stateGen,
 	}
 
 	buf := new(bytes.Buffer)
@@ -388,13 +386,12 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 		t.Fatal(err)
 	}
 	r := &Service{
-		p2p:                 p,
-		db:                  db,
-		initialSync:         &mockSync.Sync{IsSyncing: false},
-		chain:               &mock.ChainService{Genesis: time.Now()},
-		seenBlockCache:      c,
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		p2p:            p,
+		db:             db,
+		initialSync:    &mockSync.Sync{IsSyncing: false},
+		chain:          &mock.ChainService{Genesis: time.Now()},
+		seenBlockCache: c,
+		pendingBlocks:  newPendingBlocksPool(pendingBlocksMemoryLimit),
 	}
 
 	buf := new(bytes.Buffer)
@@ -527,10 +524,9 @@ func TestValidateBeaconBlockPubSub_SeenProposerSlot(t *testing.T) {
 			FinalizedCheckPoint: &ethpb.Checkpoint{
 				Epoch: 0,
 			}},
-		seenBlockCache:      c,
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:   make(map[[32]byte]bool),
-		stateSummaryCache:   cache.NewStateSummaryCache(),
+		seenBlockCache:    c,
+		pendingBlocks:     newPendingBlocksPool(pendingBlocksMemoryLimit),
+		stateSummaryCache: cache.NewStateSummaryCache(),
 	}
 
 	buf := new(bytes.Buffer)
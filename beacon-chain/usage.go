@@ -93,14 +93,22 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GRPCGatewayHost,
 			flags.GRPCGatewayPort,
 			flags.HTTPWeb3ProviderFlag,
+			flags.FallbackWeb3ProviderFlag,
+			flags.DepositSnapshotFlag,
 			flags.SetGCPercent,
 			flags.UnsafeSync,
 			flags.SlasherCertFlag,
 			flags.SlasherProviderFlag,
 			flags.SlotsPerArchivedPoint,
+			flags.ArchivedPointsPerFullState,
+			flags.HotStateReplayBudget,
+			flags.HotStateCacheMemory,
+			flags.HotStateNonFinalityEpochs,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
+			flags.RPCRequestLimit,
+			flags.RPCRequestLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
 			flags.SlotsPerArchivedPoint,
 		},
@@ -149,6 +157,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ArchiveValidatorSetChangesFlag,
 			flags.ArchiveBlocksFlag,
 			flags.ArchiveAttestationsFlag,
+			flags.ArchiveValidatorRewardsFlag,
 		},
 	},
 }
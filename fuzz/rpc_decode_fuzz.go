@@ -0,0 +1,82 @@
+package fuzz
+
+import (
+	"bytes"
+	"fmt"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+var rpcEncoders = []encoder.NetworkEncoding{
+	&encoder.SszNetworkEncoder{UseSnappyCompression: false},
+	&encoder.SszNetworkEncoder{UseSnappyCompression: true},
+}
+
+// decodeWithLength decodes length prefixed input bytes into a new message, with each of the
+// supported req/resp encodings. Successfully decoded messages must fit within the maximum size
+// of their SSZ schema, and must encode back without errors.
+func decodeWithLength(b []byte, newMsg func() interface{}) {
+	for _, e := range rpcEncoders {
+		msg := newMsg()
+		if err := e.DecodeWithLength(bytes.NewReader(b), msg); err != nil {
+			continue
+		}
+		if _, err := e.EncodeWithLength(new(bytes.Buffer), msg); err != nil {
+			panic(fmt.Sprintf("decoded message %T could not be encoded: %v", msg, err))
+		}
+		maxSize, ok := encoder.MaxSSZSize(msg)
+		if !ok {
+			continue
+		}
+		enc, err := ssz.Marshal(msg)
+		if err != nil {
+			panic(err)
+		}
+		if uint64(len(enc)) > maxSize {
+			panic(fmt.Sprintf("decoded message %T of size %d exceeds max size %d", msg, len(enc), maxSize))
+		}
+	}
+}
+
+// BeaconFuzzP2PDecodeStatus implements libfuzzer and beacon fuzz interface.
+func BeaconFuzzP2PDecodeStatus(b []byte) {
+	decodeWithLength(b, func() interface{} { return &pb.Status{} })
+}
+
+// BeaconFuzzP2PDecodeGoodbye implements libfuzzer and beacon fuzz interface.
+func BeaconFuzzP2PDecodeGoodbye(b []byte) {
+	decodeWithLength(b, func() interface{} { return new(uint64) })
+}
+
+// BeaconFuzzP2PDecodePing implements libfuzzer and beacon fuzz interface.
+func BeaconFuzzP2PDecodePing(b []byte) {
+	decodeWithLength(b, func() interface{} { return new(uint64) })
+}
+
+// BeaconFuzzP2PDecodeMetaData implements libfuzzer and beacon fuzz interface.
+func BeaconFuzzP2PDecodeMetaData(b []byte) {
+	decodeWithLength(b, func() interface{} { return &pb.MetaData{} })
+}
+
+// BeaconFuzzP2PDecodeBlocksByRangeRequest implements libfuzzer and beacon fuzz interface.
+func BeaconFuzzP2PDecodeBlocksByRangeRequest(b []byte) {
+	decodeWithLength(b, func() interface{} { return &pb.BeaconBlocksByRangeRequest{} })
+}
+
+// BeaconFuzzP2PDecodeBlocksByRootRequest implements libfuzzer and beacon fuzz interface.
+func BeaconFuzzP2PDecodeBlocksByRootRequest(b []byte) {
+	decodeWithLength(b, func() interface{} { return &pb.BeaconBlocksByRootRequest{} })
+}
+
+// BeaconFuzzP2PDecodeBlockResponse implements libfuzzer and beacon fuzz interface.
+func BeaconFuzzP2PDecodeBlockResponse(b []byte) {
+	decodeWithLength(b, func() interface{} { return &ethpb.SignedBeaconBlock{} })
+}
+
+// BeaconFuzzP2PDecodeErrorResponse implements libfuzzer and beacon fuzz interface.
+func BeaconFuzzP2PDecodeErrorResponse(b []byte) {
+	decodeWithLength(b, func() interface{} { return &pb.ErrorResponse{} })
+}
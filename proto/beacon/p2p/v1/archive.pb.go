@@ -174,41 +174,170 @@ func (m *ArchivedCommitteeInfo) GetAttesterSeed() []byte {
 	return nil
 }
 
+type ArchivedValidatorRewards struct {
+	SourceReward         []uint64 `protobuf:"varint,1,rep,packed,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	SourcePenalty        []uint64 `protobuf:"varint,2,rep,packed,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetReward         []uint64 `protobuf:"varint,3,rep,packed,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	TargetPenalty        []uint64 `protobuf:"varint,4,rep,packed,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadReward           []uint64 `protobuf:"varint,5,rep,packed,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	HeadPenalty          []uint64 `protobuf:"varint,6,rep,packed,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InclusionDelayReward []uint64 `protobuf:"varint,7,rep,packed,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	ProposerReward       []uint64 `protobuf:"varint,8,rep,packed,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	InactivityPenalty    []uint64 `protobuf:"varint,9,rep,packed,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	SlashingPenalty      []uint64 `protobuf:"varint,10,rep,packed,name=slashing_penalty,json=slashingPenalty,proto3" json:"slashing_penalty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchivedValidatorRewards) Reset()         { *m = ArchivedValidatorRewards{} }
+func (m *ArchivedValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ArchivedValidatorRewards) ProtoMessage()    {}
+func (*ArchivedValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289929478e9672a3, []int{2}
+}
+func (m *ArchivedValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedValidatorRewards.Merge(m, src)
+}
+func (m *ArchivedValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedValidatorRewards proto.InternalMessageInfo
+
+func (m *ArchivedValidatorRewards) GetSourceReward() []uint64 {
+	if m != nil {
+		return m.SourceReward
+	}
+	return nil
+}
+
+func (m *ArchivedValidatorRewards) GetSourcePenalty() []uint64 {
+	if m != nil {
+		return m.SourcePenalty
+	}
+	return nil
+}
+
+func (m *ArchivedValidatorRewards) GetTargetReward() []uint64 {
+	if m != nil {
+		return m.TargetReward
+	}
+	return nil
+}
+
+func (m *ArchivedValidatorRewards) GetTargetPenalty() []uint64 {
+	if m != nil {
+		return m.TargetPenalty
+	}
+	return nil
+}
+
+func (m *ArchivedValidatorRewards) GetHeadReward() []uint64 {
+	if m != nil {
+		return m.HeadReward
+	}
+	return nil
+}
+
+func (m *ArchivedValidatorRewards) GetHeadPenalty() []uint64 {
+	if m != nil {
+		return m.HeadPenalty
+	}
+	return nil
+}
+
+func (m *ArchivedValidatorRewards) GetInclusionDelayReward() []uint64 {
+	if m != nil {
+		return m.InclusionDelayReward
+	}
+	return nil
+}
+
+func (m *ArchivedValidatorRewards) GetProposerReward() []uint64 {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return nil
+}
+
+func (m *ArchivedValidatorRewards) GetInactivityPenalty() []uint64 {
+	if m != nil {
+		return m.InactivityPenalty
+	}
+	return nil
+}
+
+func (m *ArchivedValidatorRewards) GetSlashingPenalty() []uint64 {
+	if m != nil {
+		return m.SlashingPenalty
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ArchivedActiveSetChanges)(nil), "ethereum.beacon.p2p.v1.ArchivedActiveSetChanges")
 	proto.RegisterType((*ArchivedCommitteeInfo)(nil), "ethereum.beacon.p2p.v1.ArchivedCommitteeInfo")
+	proto.RegisterType((*ArchivedValidatorRewards)(nil), "ethereum.beacon.p2p.v1.ArchivedValidatorRewards")
 }
 
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/archive.proto", fileDescriptor_289929478e9672a3) }
 
 var fileDescriptor_289929478e9672a3 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0x27, 0x66, 0xe9, 0xea, 0xd8, 0x55, 0x76, 0xc0, 0x25, 0x2c, 0xd2, 0x5d, 0x83, 0x60, 0x2f,
-	0x3b, 0x21, 0x59, 0xf0, 0xe0, 0xad, 0x2d, 0x1e, 0x3c, 0x08, 0x92, 0x42, 0xaf, 0x65, 0x92, 0xbc,
-	0x66, 0x46, 0x93, 0xcc, 0x90, 0x99, 0x84, 0xda, 0xab, 0x07, 0xbf, 0x9a, 0x47, 0x3f, 0x81, 0x48,
-	0x3f, 0x82, 0x9f, 0x40, 0x32, 0x49, 0x1a, 0x2d, 0x74, 0x6f, 0xf9, 0xbd, 0xdf, 0x9f, 0xf7, 0x5e,
-	0xde, 0xa0, 0x57, 0xb2, 0x14, 0x5a, 0x78, 0x11, 0xd0, 0x58, 0x14, 0x9e, 0x0c, 0xa4, 0x57, 0xfb,
-	0x1e, 0x2d, 0x63, 0xc6, 0x6b, 0x20, 0x86, 0xc3, 0x57, 0xa0, 0x19, 0x94, 0x50, 0xe5, 0xa4, 0x55,
-	0x11, 0x19, 0x48, 0x52, 0xfb, 0xd7, 0x77, 0x29, 0xd7, 0xac, 0x8a, 0x48, 0x2c, 0x72, 0x2f, 0x15,
-	0xa9, 0xf0, 0x8c, 0x3c, 0xaa, 0x36, 0x06, 0xb5, 0xb9, 0xcd, 0x57, 0x1b, 0x73, 0x7d, 0x03, 0x9a,
-	0x79, 0xb5, 0x4f, 0x33, 0xc9, 0xa8, 0xdf, 0x35, 0x5c, 0x47, 0x99, 0x88, 0xbf, 0xb4, 0x02, 0xf7,
-	0x9b, 0x8d, 0x9c, 0x59, 0xdb, 0x39, 0x99, 0xc5, 0x9a, 0xd7, 0xb0, 0x04, 0xbd, 0x60, 0xb4, 0x48,
-	0x41, 0xe1, 0x97, 0xe8, 0x09, 0x6d, 0x6a, 0x54, 0x43, 0xe2, 0x58, 0xb7, 0xf6, 0xf4, 0x2c, 0x1c,
-	0x0a, 0xf8, 0x0a, 0x8d, 0x60, 0xcb, 0x1b, 0xea, 0x91, 0xa1, 0x3a, 0x84, 0x1d, 0x74, 0xae, 0x32,
-	0xaa, 0x18, 0x24, 0x8e, 0x6d, 0x88, 0x1e, 0x36, 0x0c, 0x7c, 0x86, 0xb8, 0xb1, 0x9c, 0xb5, 0x4c,
-	0x07, 0xf1, 0x47, 0xf4, 0xbc, 0x16, 0x59, 0x55, 0x68, 0x5a, 0x7e, 0x5d, 0x37, 0x39, 0xca, 0x19,
-	0xdd, 0xda, 0xd3, 0xa7, 0xc1, 0x6b, 0x72, 0xf8, 0x11, 0xa0, 0x19, 0xe9, 0x57, 0x21, 0xab, 0x5e,
-	0xfd, 0x7e, 0xcb, 0x75, 0xf8, 0xac, 0xfe, 0x17, 0x2a, 0xbc, 0x42, 0x58, 0x96, 0x42, 0x0a, 0x05,
-	0xe5, 0xda, 0x34, 0xe7, 0x45, 0xaa, 0x9c, 0x73, 0x93, 0xf8, 0xe6, 0x44, 0xe2, 0xa7, 0xce, 0xb0,
-	0xec, 0xf4, 0xe1, 0xa5, 0x3c, 0xaa, 0x98, 0x5c, 0xaa, 0x35, 0x28, 0xfd, 0x5f, 0xee, 0xe3, 0x07,
-	0x73, 0x67, 0x9d, 0x61, 0xc8, 0xa5, 0x47, 0x15, 0xe5, 0x7e, 0xb7, 0xd0, 0x8b, 0xfe, 0x0a, 0x0b,
-	0x91, 0xe7, 0x5c, 0x6b, 0x80, 0x0f, 0xc5, 0x46, 0xe0, 0xb7, 0xe8, 0x62, 0xd8, 0x04, 0xcc, 0x19,
-	0xac, 0xe9, 0x78, 0x7e, 0xf9, 0xe7, 0xd7, 0xcd, 0x85, 0x52, 0xbb, 0x3b, 0xc5, 0x77, 0xf0, 0xce,
-	0xbd, 0x0f, 0xdc, 0x70, 0x7c, 0x18, 0x17, 0x20, 0x69, 0x7c, 0xc3, 0xa4, 0x60, 0x6e, 0x74, 0xca,
-	0x77, 0x18, 0x07, 0x20, 0x99, 0x8f, 0x7f, 0xec, 0x27, 0xd6, 0xcf, 0xfd, 0xc4, 0xfa, 0xbd, 0x9f,
-	0x58, 0xd1, 0xc8, 0x3c, 0x92, 0xfb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xce, 0x29, 0x1c, 0xa1,
-	0xb1, 0x02, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7d, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0xc0, 0x55, 0x3a, 0xda, 0xcd, 0x4b, 0x5b, 0x6a, 0x41, 0x55, 0x4d, 0x88, 0xb2, 0xc2, 0xb4,
+	0x71, 0x68, 0xa2, 0x76, 0x88, 0x03, 0xb7, 0x6e, 0x70, 0xe0, 0x80, 0x34, 0x75, 0x52, 0xaf, 0x95,
+	0x9b, 0x7c, 0x4b, 0x0c, 0x69, 0x1c, 0xc5, 0x4e, 0xa0, 0xbb, 0x72, 0xe0, 0x71, 0x78, 0x0d, 0x8e,
+	0x3c, 0x01, 0x42, 0x3c, 0x02, 0x4f, 0x80, 0xe3, 0x3f, 0xc9, 0x98, 0x34, 0x0e, 0x51, 0xe2, 0xef,
+	0xfb, 0x7d, 0x3f, 0xdb, 0xf1, 0x67, 0x74, 0x98, 0x66, 0x4c, 0x30, 0x6f, 0x0d, 0xc4, 0x67, 0x89,
+	0x97, 0xce, 0x52, 0xaf, 0x98, 0x7a, 0x24, 0xf3, 0x23, 0x5a, 0x80, 0xab, 0x72, 0x78, 0x00, 0x22,
+	0x82, 0x0c, 0xf2, 0x8d, 0xab, 0x29, 0x57, 0x52, 0x6e, 0x31, 0x3d, 0x98, 0x84, 0x54, 0x44, 0xf9,
+	0xda, 0xf5, 0xd9, 0xc6, 0x0b, 0x59, 0xc8, 0x3c, 0x85, 0xaf, 0xf3, 0x2b, 0x35, 0xd2, 0xde, 0xf2,
+	0x4b, 0x6b, 0x0e, 0x46, 0x52, 0x23, 0xe5, 0x24, 0x4e, 0x23, 0x32, 0x35, 0x13, 0xae, 0xd6, 0x31,
+	0xf3, 0x3f, 0x6a, 0x60, 0xfc, 0xa5, 0x89, 0x86, 0x73, 0x3d, 0x73, 0x30, 0xf7, 0x85, 0x7c, 0x5d,
+	0x82, 0x38, 0x8f, 0x48, 0x12, 0x02, 0xc7, 0x8f, 0xd1, 0x1e, 0x29, 0x63, 0x44, 0x40, 0x30, 0x6c,
+	0x3c, 0x6d, 0x9e, 0xec, 0x2c, 0xea, 0x00, 0x1e, 0xa0, 0x16, 0x7c, 0xa6, 0x65, 0xea, 0x9e, 0x4a,
+	0x99, 0x11, 0x1e, 0xa2, 0x36, 0x8f, 0x09, 0x8f, 0x64, 0xa2, 0xa9, 0x12, 0x76, 0x58, 0x66, 0xe0,
+	0x03, 0xf8, 0x65, 0xc9, 0x8e, 0xce, 0x98, 0x21, 0x7e, 0x8f, 0x7a, 0x05, 0x8b, 0xf3, 0x44, 0x90,
+	0x6c, 0xbb, 0x2a, 0x3d, 0x7c, 0xd8, 0x92, 0xc4, 0xfe, 0xec, 0xb9, 0x5b, 0xfd, 0x08, 0xf9, 0xe1,
+	0xda, 0xad, 0xb8, 0x4b, 0x4b, 0xbf, 0x95, 0xf0, 0xa2, 0x5b, 0xdc, 0x1c, 0x72, 0xbc, 0x44, 0x58,
+	0x6e, 0x2f, 0x65, 0x1c, 0xb2, 0x95, 0x9a, 0x9c, 0x26, 0x21, 0x1f, 0xb6, 0x95, 0xf1, 0xf8, 0x0e,
+	0xe3, 0x85, 0x29, 0xb8, 0x34, 0xfc, 0xa2, 0x9f, 0xde, 0x8a, 0x28, 0x2f, 0x11, 0x02, 0xb8, 0xf8,
+	0xc7, 0xbb, 0xfb, 0x5f, 0xef, 0xdc, 0x14, 0xd4, 0x5e, 0x72, 0x2b, 0xc2, 0xc7, 0x5f, 0x1b, 0xe8,
+	0x91, 0x3d, 0x85, 0x73, 0xb6, 0xd9, 0x50, 0x49, 0xc0, 0xbb, 0xe4, 0x8a, 0xe1, 0x57, 0xa8, 0x53,
+	0xef, 0x04, 0xd4, 0x31, 0x34, 0x4e, 0x9c, 0xb3, 0xfe, 0x9f, 0x9f, 0xa3, 0x0e, 0xe7, 0xd7, 0x13,
+	0x4e, 0xaf, 0xe1, 0xf5, 0xf8, 0x74, 0x36, 0x5e, 0x38, 0xd5, 0x72, 0x25, 0x56, 0xd6, 0xd5, 0x2b,
+	0x05, 0x75, 0x46, 0x77, 0xd5, 0x55, 0xcb, 0x91, 0xd8, 0xf8, 0xdb, 0x8d, 0x7e, 0x58, 0x92, 0x98,
+	0x06, 0x44, 0xb0, 0x6c, 0x01, 0x9f, 0x48, 0x16, 0x70, 0xfc, 0x0c, 0x75, 0x38, 0xcb, 0x33, 0x1f,
+	0x56, 0x99, 0x8a, 0x98, 0x9e, 0x70, 0x74, 0x50, 0x53, 0xf8, 0x08, 0x75, 0x0d, 0x94, 0x42, 0x42,
+	0x62, 0xb1, 0x35, 0xed, 0x61, 0x4a, 0x2f, 0x74, 0xb0, 0x74, 0xc9, 0xf3, 0x0a, 0x41, 0x58, 0x97,
+	0xee, 0x15, 0x47, 0x07, 0x6b, 0x97, 0x81, 0xac, 0x4b, 0xf7, 0x8d, 0x29, 0xb5, 0xae, 0x11, 0xda,
+	0x8f, 0x80, 0x04, 0xd6, 0x74, 0x5f, 0x31, 0xa8, 0x0c, 0x19, 0xcf, 0x21, 0x72, 0x14, 0x60, 0x2d,
+	0x2d, 0x45, 0xa8, 0x22, 0xeb, 0x78, 0x89, 0x06, 0x34, 0xf1, 0xe3, 0x9c, 0x53, 0x79, 0x43, 0x02,
+	0x88, 0xc9, 0xd6, 0xea, 0xda, 0x0a, 0x7e, 0x58, 0x65, 0xdf, 0x94, 0x49, 0x23, 0x3e, 0x46, 0xbd,
+	0xea, 0x78, 0x0c, 0xbe, 0xab, 0xf0, 0xae, 0x0d, 0x1b, 0x70, 0x82, 0x30, 0x4d, 0xd4, 0xdd, 0xa1,
+	0x62, 0x5b, 0xad, 0x63, 0x4f, 0xb1, 0xfd, 0x3a, 0x63, 0x57, 0xf3, 0x02, 0x3d, 0xb0, 0xfd, 0x55,
+	0xc1, 0x48, 0xc1, 0x3d, 0x1b, 0x37, 0xe8, 0x99, 0xf3, 0xfd, 0xf7, 0x93, 0xc6, 0x0f, 0xf9, 0xfc,
+	0x92, 0xcf, 0xba, 0xa5, 0xae, 0xf5, 0xe9, 0x5f, 0xa4, 0x8d, 0xa9, 0x5f, 0x63, 0x04, 0x00, 0x00,
 }
 
 func (m *ArchivedActiveSetChanges) Marshal() (dAtA []byte, err error) {
@@ -393,6 +522,213 @@ func (m *ArchivedCommitteeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArchivedValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SlashingPenalty) > 0 {
+		dAtA10 := make([]byte, len(m.SlashingPenalty)*10)
+		var j9 int
+		for _, num := range m.SlashingPenalty {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintArchive(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.InactivityPenalty) > 0 {
+		dAtA12 := make([]byte, len(m.InactivityPenalty)*10)
+		var j11 int
+		for _, num := range m.InactivityPenalty {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintArchive(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ProposerReward) > 0 {
+		dAtA14 := make([]byte, len(m.ProposerReward)*10)
+		var j13 int
+		for _, num := range m.ProposerReward {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintArchive(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.InclusionDelayReward) > 0 {
+		dAtA16 := make([]byte, len(m.InclusionDelayReward)*10)
+		var j15 int
+		for _, num := range m.InclusionDelayReward {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintArchive(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.HeadPenalty) > 0 {
+		dAtA18 := make([]byte, len(m.HeadPenalty)*10)
+		var j17 int
+		for _, num := range m.HeadPenalty {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintArchive(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.HeadReward) > 0 {
+		dAtA20 := make([]byte, len(m.HeadReward)*10)
+		var j19 int
+		for _, num := range m.HeadReward {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintArchive(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetPenalty) > 0 {
+		dAtA22 := make([]byte, len(m.TargetPenalty)*10)
+		var j21 int
+		for _, num := range m.TargetPenalty {
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintArchive(dAtA, i, uint64(j21))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TargetReward) > 0 {
+		dAtA24 := make([]byte, len(m.TargetReward)*10)
+		var j23 int
+		for _, num := range m.TargetReward {
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintArchive(dAtA, i, uint64(j23))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourcePenalty) > 0 {
+		dAtA26 := make([]byte, len(m.SourcePenalty)*10)
+		var j25 int
+		for _, num := range m.SourcePenalty {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintArchive(dAtA, i, uint64(j25))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceReward) > 0 {
+		dAtA28 := make([]byte, len(m.SourceReward)*10)
+		var j27 int
+		for _, num := range m.SourceReward {
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintArchive(dAtA, i, uint64(j27))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintArchive(dAtA []byte, offset int, v uint64) int {
 	offset -= sovArchive(v)
 	base := offset
@@ -482,6 +818,88 @@ func (m *ArchivedCommitteeInfo) Size() (n int) {
 	return n
 }
 
+func (m *ArchivedValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SourceReward) > 0 {
+		l = 0
+		for _, e := range m.SourceReward {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.SourcePenalty) > 0 {
+		l = 0
+		for _, e := range m.SourcePenalty {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.TargetReward) > 0 {
+		l = 0
+		for _, e := range m.TargetReward {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.TargetPenalty) > 0 {
+		l = 0
+		for _, e := range m.TargetPenalty {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.HeadReward) > 0 {
+		l = 0
+		for _, e := range m.HeadReward {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.HeadPenalty) > 0 {
+		l = 0
+		for _, e := range m.HeadPenalty {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.InclusionDelayReward) > 0 {
+		l = 0
+		for _, e := range m.InclusionDelayReward {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.ProposerReward) > 0 {
+		l = 0
+		for _, e := range m.ProposerReward {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.InactivityPenalty) > 0 {
+		l = 0
+		for _, e := range m.InactivityPenalty {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.SlashingPenalty) > 0 {
+		l = 0
+		for _, e := range m.SlashingPenalty {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovArchive(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1070,6 +1488,820 @@ func (m *ArchivedCommitteeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ArchivedValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SourceReward = append(m.SourceReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SourceReward) == 0 {
+					m.SourceReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SourceReward = append(m.SourceReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceReward", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SourcePenalty = append(m.SourcePenalty, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SourcePenalty) == 0 {
+					m.SourcePenalty = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SourcePenalty = append(m.SourcePenalty, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePenalty", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetReward = append(m.TargetReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetReward) == 0 {
+					m.TargetReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetReward = append(m.TargetReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReward", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetPenalty = append(m.TargetPenalty, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetPenalty) == 0 {
+					m.TargetPenalty = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetPenalty = append(m.TargetPenalty, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPenalty", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.HeadReward = append(m.HeadReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.HeadReward) == 0 {
+					m.HeadReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.HeadReward = append(m.HeadReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadReward", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.HeadPenalty = append(m.HeadPenalty, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.HeadPenalty) == 0 {
+					m.HeadPenalty = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.HeadPenalty = append(m.HeadPenalty, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadPenalty", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InclusionDelayReward = append(m.InclusionDelayReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InclusionDelayReward) == 0 {
+					m.InclusionDelayReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InclusionDelayReward = append(m.InclusionDelayReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDelayReward", wireType)
+			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProposerReward = append(m.ProposerReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProposerReward) == 0 {
+					m.ProposerReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProposerReward = append(m.ProposerReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
+			}
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InactivityPenalty = append(m.InactivityPenalty, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InactivityPenalty) == 0 {
+					m.InactivityPenalty = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InactivityPenalty = append(m.InactivityPenalty, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
+			}
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SlashingPenalty = append(m.SlashingPenalty, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SlashingPenalty) == 0 {
+					m.SlashingPenalty = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SlashingPenalty = append(m.SlashingPenalty, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingPenalty", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipArchive(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
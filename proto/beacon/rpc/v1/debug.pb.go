@@ -467,738 +467,3761 @@ func (m *ProtoArrayNode) GetBestDescendant() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
-	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
-	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
-	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
-	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
+type ListReorgsRequest struct {
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
-
-var fileDescriptor_851e5cb2de3d61dd = []byte{
-	// 754 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xdb, 0x48,
-	0x14, 0xc6, 0x89, 0x13, 0xc8, 0x10, 0x85, 0xec, 0x2c, 0xca, 0x7a, 0x03, 0x84, 0xac, 0x59, 0x01,
-	0xda, 0x55, 0x6d, 0x85, 0xf6, 0x50, 0x71, 0x23, 0x10, 0x02, 0x12, 0xa2, 0x95, 0x43, 0x2f, 0xe5,
-	0x10, 0x39, 0xf6, 0x4b, 0xec, 0xc6, 0x78, 0x8c, 0x3d, 0x4e, 0x9b, 0xf6, 0x86, 0xaa, 0xf6, 0xd8,
-	0x43, 0x0f, 0xfd, 0x97, 0x7a, 0xac, 0xd4, 0x7f, 0xa0, 0x42, 0xfd, 0x43, 0xaa, 0x99, 0x71, 0x42,
-	0x10, 0x89, 0xfa, 0x43, 0xbd, 0xcd, 0xf7, 0xbd, 0x6f, 0xbe, 0x37, 0xf3, 0x66, 0xde, 0x43, 0xeb,
-	0x41, 0x48, 0x28, 0xd1, 0x3b, 0x60, 0x5a, 0xc4, 0xd7, 0xc3, 0xc0, 0xd2, 0x07, 0x35, 0xdd, 0x86,
-	0x4e, 0xdc, 0xd3, 0x78, 0x04, 0x97, 0x80, 0x3a, 0x10, 0x42, 0x7c, 0xa1, 0x09, 0x8d, 0x16, 0x06,
-	0x96, 0x36, 0xa8, 0x95, 0x57, 0x7b, 0x84, 0xf4, 0x3c, 0xd0, 0xcd, 0xc0, 0xd5, 0x4d, 0xdf, 0x27,
-	0xd4, 0xa4, 0x2e, 0xf1, 0x23, 0xb1, 0xab, 0xbc, 0x92, 0x44, 0x39, 0xea, 0xc4, 0x5d, 0x1d, 0x2e,
-	0x02, 0x3a, 0x14, 0x41, 0xf5, 0x1c, 0xe1, 0x3a, 0xf7, 0x6a, 0x51, 0x93, 0x82, 0x01, 0x97, 0x31,
-	0x44, 0x14, 0x2f, 0x23, 0x39, 0xf2, 0x08, 0x55, 0xa4, 0xaa, 0xb4, 0x2d, 0x1f, 0xcd, 0x19, 0x1c,
-	0xe1, 0x75, 0x84, 0x3a, 0x1e, 0xb1, 0xfa, 0xed, 0x90, 0x10, 0xaa, 0xa4, 0xaa, 0xd2, 0x76, 0xfe,
-	0x68, 0xce, 0xc8, 0x71, 0xce, 0x20, 0x84, 0xd6, 0x0b, 0x28, 0x7f, 0x19, 0x43, 0x38, 0x6c, 0x77,
-	0x5d, 0x8f, 0x42, 0xa8, 0xde, 0x43, 0xf9, 0x3a, 0x0f, 0x26, 0xb6, 0x6b, 0xb7, 0x0c, 0x98, 0x79,
-	0x7e, 0x62, 0xbb, 0xba, 0x85, 0x16, 0x5b, 0xad, 0xa7, 0x06, 0x44, 0x01, 0xf1, 0x23, 0xc0, 0x0a,
-	0x9a, 0x07, 0xdf, 0x22, 0x36, 0xd8, 0x89, 0x74, 0x04, 0xd5, 0xb7, 0x12, 0xfa, 0xf3, 0x84, 0xf4,
-	0x7a, 0xae, 0xdf, 0x3b, 0x81, 0x01, 0x78, 0x23, 0xff, 0x26, 0xca, 0x78, 0x0c, 0x73, 0x7d, 0x61,
-	0xa7, 0xa6, 0x4d, 0xaf, 0x97, 0x36, 0x65, 0xaf, 0x26, 0x80, 0xd8, 0xaf, 0x6e, 0xa1, 0x0c, 0xc7,
-	0x78, 0x01, 0xc9, 0xc7, 0xa7, 0x87, 0x8f, 0x8a, 0x73, 0x38, 0x87, 0x32, 0x07, 0x8d, 0xfa, 0x93,
-	0x66, 0x51, 0x62, 0xcb, 0x33, 0x63, 0x6f, 0xbf, 0x51, 0x4c, 0xa9, 0x6f, 0xd2, 0x68, 0xf5, 0x31,
-	0x2b, 0xe4, 0x5e, 0x18, 0x9a, 0xc3, 0x43, 0x12, 0xf6, 0xf7, 0x1d, 0xe2, 0x5a, 0x30, 0xbe, 0xc4,
-	0x16, 0x5a, 0x0a, 0xc2, 0xd8, 0x87, 0x36, 0x75, 0x42, 0x88, 0x1c, 0xe2, 0x89, 0xcb, 0xc8, 0x46,
-	0x81, 0xd3, 0x67, 0x23, 0x96, 0x09, 0x9f, 0xc5, 0x11, 0x75, 0xbb, 0x2e, 0xd8, 0x6d, 0x08, 0x88,
-	0xe5, 0xf0, 0x0a, 0xcb, 0x46, 0x61, 0x4c, 0x37, 0x18, 0xcb, 0x84, 0x5d, 0xd7, 0x37, 0x3d, 0xf7,
-	0xe5, 0x58, 0x98, 0x16, 0xc2, 0x31, 0x2d, 0x84, 0x06, 0xfa, 0x83, 0xbf, 0x71, 0xdb, 0x64, 0x67,
-	0x6b, 0xfb, 0xc4, 0x86, 0x48, 0x91, 0xab, 0xe9, 0xed, 0xc5, 0x9d, 0xcd, 0x59, 0x95, 0xb9, 0xb9,
-	0xcb, 0x29, 0xb1, 0xc1, 0x58, 0x0a, 0x6e, 0xe1, 0x08, 0x9f, 0xa3, 0x79, 0xd7, 0xb7, 0x5d, 0x0b,
-	0x22, 0x25, 0xc3, 0x9d, 0xf6, 0xbe, 0xef, 0x74, 0xb7, 0x2a, 0xda, 0xb1, 0xf0, 0x68, 0xf8, 0x34,
-	0x1c, 0x1a, 0x23, 0xc7, 0xf2, 0x2e, 0xca, 0x4f, 0x06, 0x70, 0x11, 0xa5, 0xfb, 0x30, 0xe4, 0xf5,
-	0xca, 0x19, 0x6c, 0x89, 0x97, 0x51, 0x66, 0x60, 0x7a, 0x31, 0x24, 0xa5, 0x11, 0x60, 0x37, 0xf5,
-	0x50, 0x52, 0xaf, 0x52, 0xa8, 0x70, 0xfb, 0xf0, 0x18, 0x4f, 0x7e, 0xe2, 0xe4, 0x0b, 0x63, 0x24,
-	0xdf, 0x7c, 0x5e, 0x83, 0xaf, 0x71, 0x09, 0x65, 0x03, 0x33, 0x04, 0x9f, 0x26, 0x75, 0x4c, 0xd0,
-	0xb4, 0x17, 0x91, 0x7f, 0xf4, 0x45, 0x32, 0x53, 0x5f, 0xa4, 0x84, 0xb2, 0xcf, 0xc1, 0xed, 0x39,
-	0x54, 0xc9, 0x8a, 0x4c, 0x02, 0xf1, 0xbe, 0x80, 0x88, 0xb6, 0x2d, 0xc7, 0xf5, 0x6c, 0x65, 0x9e,
-	0xc7, 0x72, 0x8c, 0xd9, 0x67, 0x04, 0xf3, 0xe7, 0x61, 0x1b, 0x22, 0x0b, 0x7c, 0xdb, 0xf4, 0xa9,
-	0xb2, 0x20, 0xfc, 0x19, 0x7d, 0x30, 0x66, 0x77, 0x3e, 0xc8, 0x28, 0x73, 0xc0, 0xe6, 0x05, 0x7e,
-	0x2d, 0xa1, 0x42, 0x13, 0xe8, 0x44, 0x6b, 0xe3, 0xff, 0x66, 0xbd, 0xd4, 0xdd, 0xfe, 0x2f, 0x6f,
-	0xcc, 0xd2, 0x4e, 0xf4, 0xa7, 0xfa, 0xcf, 0xd5, 0xe7, 0xaf, 0xef, 0x53, 0x2b, 0xf8, 0x6f, 0x1d,
-	0xa8, 0xa3, 0x0f, 0x6a, 0xa6, 0x17, 0x38, 0x66, 0x32, 0xb0, 0xf4, 0x88, 0xe7, 0x7c, 0x81, 0x16,
-	0xd8, 0x29, 0x58, 0x87, 0xe3, 0x7f, 0x67, 0xe6, 0x9f, 0x18, 0x11, 0xbf, 0x21, 0x33, 0x9f, 0x27,
-	0xf8, 0x15, 0x5a, 0x6a, 0x01, 0x9d, 0x6c, 0x74, 0xfc, 0xff, 0x4f, 0x8c, 0x83, 0x72, 0x49, 0x13,
-	0x53, 0x53, 0x1b, 0x4d, 0x4d, 0xad, 0xc1, 0xa6, 0xa6, 0xba, 0xc1, 0x53, 0xaf, 0xa9, 0x2b, 0xd3,
-	0x52, 0x7b, 0xc2, 0x08, 0xbf, 0x93, 0xd0, 0x5f, 0x4d, 0xa0, 0xd3, 0x5a, 0x00, 0xcf, 0x30, 0x2e,
-	0x3f, 0xf8, 0x95, 0x46, 0x52, 0x37, 0xf9, 0x71, 0xaa, 0xb8, 0x32, 0xed, 0x38, 0x5d, 0x12, 0xf6,
-	0x2d, 0xae, 0xaf, 0xe7, 0x3f, 0x5e, 0x57, 0xa4, 0x4f, 0xd7, 0x15, 0xe9, 0xcb, 0x75, 0x45, 0xea,
-	0x64, 0x79, 0xee, 0xfb, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x2a, 0x67, 0x24, 0x71, 0x06,
-	0x00, 0x00,
+func (m *ListReorgsRequest) Reset()         { *m = ListReorgsRequest{} }
+func (m *ListReorgsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReorgsRequest) ProtoMessage()    {}
+func (*ListReorgsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{6}
+}
+func (m *ListReorgsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListReorgsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListReorgsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListReorgsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReorgsRequest.Merge(m, src)
+}
+func (m *ListReorgsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListReorgsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReorgsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ListReorgsRequest proto.InternalMessageInfo
 
-// DebugClient is the client API for Debug service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DebugClient interface {
-	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
+func (m *ListReorgsRequest) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
 }
 
-type debugClient struct {
-	cc *grpc.ClientConn
+func (m *ListReorgsRequest) GetEndSlot() uint64 {
+	if m != nil {
+		return m.EndSlot
+	}
+	return 0
 }
 
-func NewDebugClient(cc *grpc.ClientConn) DebugClient {
-	return &debugClient{cc}
+func (m *ListReorgsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListReorgsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
 	}
-	return out, nil
+	return ""
 }
 
-func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ListReorgsResponse struct {
+	Reorgs               []*Reorg `protobuf:"bytes,1,rep,name=reorgs,proto3" json:"reorgs,omitempty"`
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32    `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReorgsResponse) Reset()         { *m = ListReorgsResponse{} }
+func (m *ListReorgsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReorgsResponse) ProtoMessage()    {}
+func (*ListReorgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{7}
+}
+func (m *ListReorgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListReorgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListReorgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ListReorgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReorgsResponse.Merge(m, src)
+}
+func (m *ListReorgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListReorgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReorgsResponse.DiscardUnknown(m)
 }
 
-func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListReorgsResponse proto.InternalMessageInfo
+
+func (m *ListReorgsResponse) GetReorgs() []*Reorg {
+	if m != nil {
+		return m.Reorgs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
-	out := new(ProtoArrayForkChoiceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListReorgsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return out, nil
+	return ""
 }
 
-// DebugServer is the server API for Debug service.
-type DebugServer interface {
-	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
-	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
-	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*types.Empty, error)
-	GetProtoArrayForkChoice(context.Context, *types.Empty) (*ProtoArrayForkChoiceResponse, error)
+func (m *ListReorgsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
 }
 
-// UnimplementedDebugServer can be embedded to have forward compatible implementations.
-type UnimplementedDebugServer struct {
+type Reorg struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	OldHeadRoot          []byte   `protobuf:"bytes,2,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	OldHeadSlot          uint64   `protobuf:"varint,3,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,4,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	NewHeadSlot          uint64   `protobuf:"varint,5,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	CommonAncestorRoot   []byte   `protobuf:"bytes,6,opt,name=common_ancestor_root,json=commonAncestorRoot,proto3" json:"common_ancestor_root,omitempty"`
+	CommonAncestorSlot   uint64   `protobuf:"varint,7,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3" json:"common_ancestor_slot,omitempty"`
+	Depth                uint64   `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	NewBranchWeight      uint64   `protobuf:"varint,9,opt,name=new_branch_weight,json=newBranchWeight,proto3" json:"new_branch_weight,omitempty"`
+	OldBranchWeight      uint64   `protobuf:"varint,10,opt,name=old_branch_weight,json=oldBranchWeight,proto3" json:"old_branch_weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
+func (m *Reorg) Reset()         { *m = Reorg{} }
+func (m *Reorg) String() string { return proto.CompactTextString(m) }
+func (*Reorg) ProtoMessage()    {}
+func (*Reorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{8}
 }
-func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+func (m *Reorg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+func (m *Reorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reorg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *types.Empty) (*ProtoArrayForkChoiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+func (m *Reorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reorg.Merge(m, src)
+}
+func (m *Reorg) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reorg.DiscardUnknown(m)
 }
 
-func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
-	s.RegisterService(&_Debug_serviceDesc, srv)
+var xxx_messageInfo_Reorg proto.InternalMessageInfo
+
+func (m *Reorg) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
 }
 
-func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BeaconStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Reorg) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBeaconState(ctx, in)
+	return nil
+}
+
+func (m *Reorg) GetOldHeadSlot() uint64 {
+	if m != nil {
+		return m.OldHeadSlot
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
+	return 0
+}
+
+func (m *Reorg) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+	return nil
+}
+
+func (m *Reorg) GetNewHeadSlot() uint64 {
+	if m != nil {
+		return m.NewHeadSlot
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Reorg) GetCommonAncestorRoot() []byte {
+	if m != nil {
+		return m.CommonAncestorRoot
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBlock(ctx, in)
+	return nil
+}
+
+func (m *Reorg) GetCommonAncestorSlot() uint64 {
+	if m != nil {
+		return m.CommonAncestorSlot
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
+	return 0
+}
+
+func (m *Reorg) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+	return 0
+}
+
+func (m *Reorg) GetNewBranchWeight() uint64 {
+	if m != nil {
+		return m.NewBranchWeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoggingLevelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Reorg) GetOldBranchWeight() uint64 {
+	if m != nil {
+		return m.OldBranchWeight
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).SetLoggingLevel(ctx, in)
+	return 0
+}
+
+type ListValidatorRewardsRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Indices              []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListValidatorRewardsRequest) Reset()         { *m = ListValidatorRewardsRequest{} }
+func (m *ListValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorRewardsRequest) ProtoMessage()    {}
+func (*ListValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{9}
+}
+func (m *ListValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListValidatorRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
+}
+func (m *ListValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorRewardsRequest.Merge(m, src)
+}
+func (m *ListValidatorRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListValidatorRewardsRequest proto.InternalMessageInfo
+
+func (m *ListValidatorRewardsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
+	return 0
+}
+
+func (m *ListValidatorRewardsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ListValidatorRewardsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*types.Empty))
+	return 0
+}
+
+func (m *ListValidatorRewardsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Debug_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.Debug",
-	HandlerType: (*DebugServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetBeaconState",
-			Handler:    _Debug_GetBeaconState_Handler,
-		},
-		{
-			MethodName: "GetBlock",
-			Handler:    _Debug_GetBlock_Handler,
-		},
-		{
-			MethodName: "SetLoggingLevel",
-			Handler:    _Debug_SetLoggingLevel_Handler,
-		},
-		{
-			MethodName: "GetProtoArrayForkChoice",
-			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/debug.proto",
+type ListValidatorRewardsResponse struct {
+	Epoch                uint64              `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Rewards              []*ValidatorRewards `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextPageToken        string              `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32               `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListValidatorRewardsResponse) Reset()         { *m = ListValidatorRewardsResponse{} }
+func (m *ListValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorRewardsResponse) ProtoMessage()    {}
+func (*ListValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{10}
+}
+func (m *ListValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListValidatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorRewardsResponse.Merge(m, src)
+}
+func (m *ListValidatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ListValidatorRewardsResponse proto.InternalMessageInfo
+
+func (m *ListValidatorRewardsResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if m.QueryFilter != nil {
-		{
-			size := m.QueryFilter.Size()
-			i -= size
-			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	return 0
+}
+
+func (m *ListValidatorRewardsResponse) GetRewards() []*ValidatorRewards {
+	if m != nil {
+		return m.Rewards
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListValidatorRewardsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
 }
 
-func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+func (m *ListValidatorRewardsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
 }
-func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type ValidatorRewards struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	SourceReward         uint64   `protobuf:"varint,2,opt,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	SourcePenalty        uint64   `protobuf:"varint,3,opt,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetReward         uint64   `protobuf:"varint,4,opt,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	TargetPenalty        uint64   `protobuf:"varint,5,opt,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadReward           uint64   `protobuf:"varint,6,opt,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	HeadPenalty          uint64   `protobuf:"varint,7,opt,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InclusionDelayReward uint64   `protobuf:"varint,8,opt,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	ProposerReward       uint64   `protobuf:"varint,9,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	InactivityPenalty    uint64   `protobuf:"varint,10,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	SlashingPenalty      uint64   `protobuf:"varint,11,opt,name=slashing_penalty,json=slashingPenalty,proto3" json:"slashing_penalty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BlockRoot != nil {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{11}
 }
-func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
 }
 
-func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
 	}
-	if len(m.BlockRoot) > 0 {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ValidatorRewards) GetSourceReward() uint64 {
+	if m != nil {
+		return m.SourceReward
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SSZResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards) GetSourcePenalty() uint64 {
+	if m != nil {
+		return m.SourcePenalty
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SSZResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorRewards) GetTargetReward() uint64 {
+	if m != nil {
+		return m.TargetReward
+	}
+	return 0
 }
 
-func (m *SSZResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ValidatorRewards) GetTargetPenalty() uint64 {
+	if m != nil {
+		return m.TargetPenalty
 	}
-	if len(m.Encoded) > 0 {
-		i -= len(m.Encoded)
-		copy(dAtA[i:], m.Encoded)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ValidatorRewards) GetHeadReward() uint64 {
+	if m != nil {
+		return m.HeadReward
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LoggingLevelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards) GetHeadPenalty() uint64 {
+	if m != nil {
+		return m.HeadPenalty
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LoggingLevelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorRewards) GetInclusionDelayReward() uint64 {
+	if m != nil {
+		return m.InclusionDelayReward
+	}
+	return 0
 }
 
-func (m *LoggingLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ValidatorRewards) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
 	}
-	if m.Level != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Level))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ValidatorRewards) GetInactivityPenalty() uint64 {
+	if m != nil {
+		return m.InactivityPenalty
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProtoArrayForkChoiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards) GetSlashingPenalty() uint64 {
+	if m != nil {
+		return m.SlashingPenalty
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProtoArrayForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SimulateBlockProposalRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ParentRoot           []byte   `protobuf:"bytes,2,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	RandaoReveal         []byte   `protobuf:"bytes,3,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
+	Graffiti             []byte   `protobuf:"bytes,4,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ProtoArrayForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Indices) > 0 {
-		for k := range m.Indices {
-			v := m.Indices[k]
-			baseI := i
-			i = encodeVarintDebug(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ProtoArrayNodes) > 0 {
-		for iNdEx := len(m.ProtoArrayNodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProtoArrayNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDebug(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *SimulateBlockProposalRequest) Reset()         { *m = SimulateBlockProposalRequest{} }
+func (m *SimulateBlockProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateBlockProposalRequest) ProtoMessage()    {}
+func (*SimulateBlockProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{12}
+}
+func (m *SimulateBlockProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateBlockProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateBlockProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.FinalizedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *SimulateBlockProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBlockProposalRequest.Merge(m, src)
+}
+func (m *SimulateBlockProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateBlockProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBlockProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBlockProposalRequest proto.InternalMessageInfo
+
+func (m *SimulateBlockProposalRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	if m.JustifiedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *SimulateBlockProposalRequest) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
 	}
-	if m.PruneThreshold != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.PruneThreshold))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *SimulateBlockProposalRequest) GetRandaoReveal() []byte {
+	if m != nil {
+		return m.RandaoReveal
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ProtoArrayNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SimulateBlockProposalRequest) GetGraffiti() []byte {
+	if m != nil {
+		return m.Graffiti
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ProtoArrayNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SimulatedBlockProposal struct {
+	EncodedBlock           []byte   `protobuf:"bytes,1,opt,name=encoded_block,json=encodedBlock,proto3" json:"encoded_block,omitempty"`
+	StateRoot              []byte   `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	ProposerIndex          uint64   `protobuf:"varint,3,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	ProposerReward         uint64   `protobuf:"varint,4,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	AttestingValidators    uint64   `protobuf:"varint,5,opt,name=attesting_validators,json=attestingValidators,proto3" json:"attesting_validators,omitempty"`
+	NewAttestingValidators uint64   `protobuf:"varint,6,opt,name=new_attesting_validators,json=newAttestingValidators,proto3" json:"new_attesting_validators,omitempty"`
+	CommitteeValidators    uint64   `protobuf:"varint,7,opt,name=committee_validators,json=committeeValidators,proto3" json:"committee_validators,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
-func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.BestDescendant != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.BestDescendant))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.BestChild != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.BestChild))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Weight != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.FinalizedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.JustifiedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Parent != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Parent))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x8
+func (m *SimulatedBlockProposal) Reset()         { *m = SimulatedBlockProposal{} }
+func (m *SimulatedBlockProposal) String() string { return proto.CompactTextString(m) }
+func (*SimulatedBlockProposal) ProtoMessage()    {}
+func (*SimulatedBlockProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{13}
+}
+func (m *SimulatedBlockProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedBlockProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedBlockProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SimulatedBlockProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedBlockProposal.Merge(m, src)
+}
+func (m *SimulatedBlockProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedBlockProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedBlockProposal.DiscardUnknown(m)
 }
 
-func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDebug(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_SimulatedBlockProposal proto.InternalMessageInfo
+
+func (m *SimulatedBlockProposal) GetEncodedBlock() []byte {
+	if m != nil {
+		return m.EncodedBlock
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *BeaconStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *SimulatedBlockProposal) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
 	}
-	return n
+	return nil
 }
 
-func (m *BeaconStateRequest_Slot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SimulatedBlockProposal) GetProposerIndex() uint64 {
+	if m != nil {
+		return m.ProposerIndex
 	}
-	var l int
-	_ = l
-	n += 1 + sovDebug(uint64(m.Slot))
-	return n
+	return 0
 }
-func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockRoot != nil {
-		l = len(m.BlockRoot)
-		n += 1 + l + sovDebug(uint64(l))
+
+func (m *SimulatedBlockProposal) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
 	}
-	return n
+	return 0
 }
-func (m *BlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *SimulatedBlockProposal) GetAttestingValidators() uint64 {
+	if m != nil {
+		return m.AttestingValidators
 	}
-	return n
+	return 0
 }
 
-func (m *SSZResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Encoded)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SimulatedBlockProposal) GetNewAttestingValidators() uint64 {
+	if m != nil {
+		return m.NewAttestingValidators
 	}
-	return n
+	return 0
 }
 
-func (m *LoggingLevelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Level != 0 {
-		n += 1 + sovDebug(uint64(m.Level))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SimulatedBlockProposal) GetCommitteeValidators() uint64 {
+	if m != nil {
+		return m.CommitteeValidators
 	}
-	return n
+	return 0
 }
 
-func (m *ProtoArrayForkChoiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PruneThreshold != 0 {
-		n += 1 + sovDebug(uint64(m.PruneThreshold))
-	}
-	if m.JustifiedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
-	}
-	if m.FinalizedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
+	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
+	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
+	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
+	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
+	proto.RegisterType((*ListReorgsRequest)(nil), "ethereum.beacon.rpc.v1.ListReorgsRequest")
+	proto.RegisterType((*ListReorgsResponse)(nil), "ethereum.beacon.rpc.v1.ListReorgsResponse")
+	proto.RegisterType((*Reorg)(nil), "ethereum.beacon.rpc.v1.Reorg")
+	proto.RegisterType((*ListValidatorRewardsRequest)(nil), "ethereum.beacon.rpc.v1.ListValidatorRewardsRequest")
+	proto.RegisterType((*ListValidatorRewardsResponse)(nil), "ethereum.beacon.rpc.v1.ListValidatorRewardsResponse")
+	proto.RegisterType((*ValidatorRewards)(nil), "ethereum.beacon.rpc.v1.ValidatorRewards")
+	proto.RegisterType((*SimulateBlockProposalRequest)(nil), "ethereum.beacon.rpc.v1.SimulateBlockProposalRequest")
+	proto.RegisterType((*SimulatedBlockProposal)(nil), "ethereum.beacon.rpc.v1.SimulatedBlockProposal")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
+
+var fileDescriptor_851e5cb2de3d61dd = []byte{
+	// 1524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x57, 0xdb, 0x6e, 0xdb, 0x46,
+	0x10, 0x8d, 0x64, 0xc9, 0xb6, 0xc6, 0x8a, 0x2f, 0x1b, 0xd7, 0x75, 0x64, 0xe7, 0xc6, 0xa4, 0xb9,
+	0x15, 0x91, 0x6a, 0x27, 0x05, 0x82, 0xbc, 0xd9, 0x89, 0x73, 0x01, 0x82, 0xc4, 0xa0, 0xd2, 0x16,
+	0x68, 0x1e, 0x08, 0x5a, 0x5a, 0x4b, 0xac, 0x69, 0x92, 0x25, 0x57, 0x76, 0xd4, 0xbe, 0x05, 0x45,
+	0x8b, 0x3e, 0x15, 0x68, 0xfb, 0x07, 0x05, 0xfa, 0x1b, 0x7d, 0xed, 0x43, 0x1f, 0x02, 0xf4, 0x07,
+	0x8a, 0xa2, 0x1f, 0xd0, 0x1f, 0x28, 0xd0, 0xd9, 0x99, 0x25, 0x4d, 0x3b, 0x54, 0x92, 0x5e, 0x1e,
+	0x04, 0x68, 0xcf, 0xcc, 0x9c, 0x1d, 0xce, 0x9e, 0x1d, 0x0e, 0xe1, 0x4c, 0x14, 0x87, 0x2a, 0x6c,
+	0x6d, 0x49, 0xb7, 0x13, 0x06, 0xad, 0x38, 0xea, 0xb4, 0xf6, 0x56, 0x5a, 0x5d, 0xb9, 0x35, 0xe8,
+	0x35, 0xc9, 0x22, 0x16, 0xa4, 0xea, 0xcb, 0x58, 0x0e, 0x76, 0x9b, 0xec, 0xd3, 0x44, 0x9f, 0xe6,
+	0xde, 0x4a, 0x63, 0xb9, 0x17, 0x86, 0x3d, 0x5f, 0xb6, 0xdc, 0xc8, 0x6b, 0xb9, 0x41, 0x10, 0x2a,
+	0x57, 0x79, 0x61, 0x90, 0x70, 0x54, 0x63, 0xc9, 0x58, 0x69, 0xb5, 0x35, 0xd8, 0x6e, 0xc9, 0xdd,
+	0x48, 0x0d, 0xd9, 0x68, 0x3d, 0x05, 0xb1, 0x4e, 0x5c, 0x6d, 0x0c, 0x92, 0xb6, 0xfc, 0x74, 0x20,
+	0x13, 0x25, 0xe6, 0xa1, 0x92, 0xf8, 0xa1, 0x5a, 0x2c, 0x9d, 0x2d, 0x5d, 0xae, 0xdc, 0x3f, 0x66,
+	0xd3, 0x4a, 0x9c, 0x01, 0xd8, 0xf2, 0xc3, 0xce, 0x8e, 0x13, 0x87, 0x68, 0x2b, 0xa3, 0xad, 0x8e,
+	0xb6, 0x1a, 0x61, 0x36, 0x42, 0xeb, 0xd3, 0x50, 0xc7, 0xf8, 0x78, 0xe8, 0x6c, 0x7b, 0xbe, 0x92,
+	0xb1, 0x75, 0x0d, 0xea, 0xeb, 0x64, 0x34, 0xb4, 0xa7, 0x0e, 0x11, 0x68, 0xf2, 0x7a, 0x2e, 0xdc,
+	0xba, 0x04, 0x53, 0xed, 0xf6, 0xc7, 0xb6, 0x4c, 0x22, 0x4c, 0x5e, 0x8a, 0x45, 0x98, 0x90, 0x41,
+	0x27, 0xec, 0xca, 0xae, 0x71, 0x4d, 0x97, 0xd6, 0x57, 0x25, 0x38, 0xf1, 0x30, 0xec, 0xf5, 0xbc,
+	0xa0, 0xf7, 0x50, 0xee, 0x49, 0x3f, 0xe5, 0xbf, 0x07, 0x55, 0x5f, 0xaf, 0xc9, 0x7f, 0x7a, 0x75,
+	0xa5, 0x59, 0x5c, 0xaf, 0x66, 0x41, 0x6c, 0x93, 0x17, 0x1c, 0x8f, 0x99, 0x54, 0x69, 0x2d, 0x26,
+	0xa1, 0xf2, 0xe0, 0xd1, 0xdd, 0xc7, 0xb3, 0xc7, 0x44, 0x0d, 0xaa, 0x77, 0x36, 0xd6, 0x3f, 0xb8,
+	0x37, 0x5b, 0xd2, 0x7f, 0x9f, 0xd8, 0x6b, 0xb7, 0x37, 0x66, 0xcb, 0xd6, 0x97, 0x63, 0xb0, 0xbc,
+	0xa9, 0x0b, 0xb9, 0x16, 0xc7, 0xee, 0xf0, 0x6e, 0x18, 0xef, 0xdc, 0xee, 0x87, 0x5e, 0x47, 0x66,
+	0x0f, 0x71, 0x09, 0x66, 0xa2, 0x78, 0x10, 0x48, 0x47, 0xf5, 0x63, 0x99, 0xf4, 0x43, 0x9f, 0x1f,
+	0xa6, 0x62, 0x4f, 0x13, 0xfc, 0x24, 0x45, 0xb5, 0xe3, 0x27, 0x83, 0x44, 0x79, 0xdb, 0x9e, 0xec,
+	0x3a, 0x32, 0x0a, 0x3b, 0x7d, 0xaa, 0x30, 0x3a, 0x66, 0xf0, 0x86, 0x46, 0xb5, 0xe3, 0xb6, 0x17,
+	0xb8, 0xbe, 0xf7, 0x59, 0xe6, 0x38, 0xc6, 0x8e, 0x19, 0xcc, 0x8e, 0x36, 0xcc, 0xd1, 0x19, 0x3b,
+	0xae, 0xce, 0xcd, 0x09, 0xb0, 0x74, 0xc9, 0x62, 0xe5, 0xec, 0xd8, 0xe5, 0xa9, 0xd5, 0x8b, 0xa3,
+	0x2a, 0x73, 0xf0, 0x2c, 0x8f, 0xd0, 0xdd, 0x9e, 0x89, 0x0e, 0xad, 0x13, 0xf1, 0x14, 0x26, 0xbc,
+	0xa0, 0x8b, 0x0f, 0x98, 0x2c, 0x56, 0x89, 0x69, 0xed, 0xf5, 0x4c, 0x2f, 0x57, 0xa5, 0xf9, 0x80,
+	0x39, 0x36, 0x02, 0x15, 0x0f, 0xed, 0x94, 0xb1, 0x71, 0x0b, 0xea, 0x79, 0x83, 0x98, 0x85, 0xb1,
+	0x1d, 0x39, 0xa4, 0x7a, 0xd5, 0x6c, 0xfd, 0x17, 0x75, 0x59, 0xdd, 0x73, 0xfd, 0x81, 0x34, 0xa5,
+	0xe1, 0xc5, 0xad, 0xf2, 0xcd, 0x92, 0xf5, 0xbc, 0x0c, 0xd3, 0x87, 0x93, 0x17, 0x22, 0x2f, 0x62,
+	0x23, 0x61, 0xc4, 0x0e, 0xc4, 0x6b, 0xd3, 0x7f, 0xb1, 0x00, 0xe3, 0x91, 0x1b, 0xcb, 0x40, 0x99,
+	0x3a, 0x9a, 0x55, 0xd1, 0x89, 0x54, 0xde, 0xf4, 0x44, 0xaa, 0x85, 0x27, 0x82, 0x3b, 0xed, 0x4b,
+	0xaf, 0xd7, 0x57, 0x8b, 0xe3, 0xbc, 0x13, 0xaf, 0xe8, 0x5e, 0xa0, 0x06, 0x9d, 0x4e, 0xdf, 0x43,
+	0x7d, 0x4c, 0x90, 0xad, 0xa6, 0x91, 0xdb, 0x1a, 0xd0, 0xfc, 0x64, 0xc6, 0x03, 0xe8, 0xc8, 0xa0,
+	0xeb, 0x62, 0xa6, 0x93, 0xcc, 0xaf, 0xe1, 0x3b, 0x19, 0x6a, 0x7d, 0x5d, 0x82, 0xb9, 0x87, 0x5e,
+	0xa2, 0x6c, 0x19, 0xc6, 0xbd, 0x24, 0x77, 0xeb, 0x12, 0xe5, 0xc6, 0xca, 0xc9, 0x55, 0xa3, 0x46,
+	0x48, 0x5b, 0x97, 0xe4, 0x24, 0x4c, 0x62, 0x38, 0x1b, 0xb9, 0xac, 0x78, 0xcf, 0xba, 0x64, 0x5a,
+	0x82, 0x5a, 0xe4, 0xf6, 0xa4, 0x93, 0xe0, 0x23, 0x50, 0x71, 0xaa, 0xf6, 0xa4, 0x06, 0xda, 0xb8,
+	0xd6, 0xb4, 0x64, 0x54, 0xe1, 0x8e, 0x0c, 0xa8, 0x32, 0x35, 0x9b, 0xdc, 0x9f, 0x68, 0xc0, 0xfa,
+	0xb6, 0x04, 0x22, 0x9f, 0x8b, 0xb9, 0x0f, 0xef, 0xc3, 0x78, 0x4c, 0x08, 0x26, 0xa2, 0xf5, 0x73,
+	0x6a, 0x94, 0x7e, 0x28, 0xce, 0x36, 0xce, 0xe2, 0x22, 0xcc, 0x04, 0xf2, 0x99, 0x72, 0x72, 0x3b,
+	0x96, 0x69, 0xc7, 0xe3, 0x1a, 0xde, 0x4c, 0x77, 0xd5, 0x49, 0x29, 0x6c, 0x7f, 0x7e, 0x3e, 0xe5,
+	0x1a, 0x21, 0x3a, 0x67, 0xeb, 0xaf, 0x32, 0x54, 0x89, 0xb8, 0x50, 0x1c, 0x16, 0x1c, 0xc7, 0x9b,
+	0xe8, 0xf4, 0xa5, 0xdb, 0xcd, 0xb5, 0x38, 0x7b, 0x0a, 0xc1, 0xfb, 0x88, 0xe9, 0x1e, 0x75, 0xc8,
+	0x87, 0x08, 0x58, 0x33, 0xa9, 0x4f, 0xdb, 0xf0, 0x04, 0x72, 0x3f, 0xc7, 0x53, 0x61, 0x1e, 0x04,
+	0xf3, 0x3c, 0x99, 0x0f, 0xf1, 0xb0, 0x62, 0x52, 0x1f, 0xe2, 0x79, 0x0f, 0xe6, 0x3b, 0xe1, 0xee,
+	0x6e, 0x18, 0x38, 0x6e, 0x80, 0x97, 0x42, 0x85, 0x31, 0xd3, 0x8d, 0x13, 0x9d, 0x60, 0xdb, 0x9a,
+	0x31, 0x11, 0x6b, 0x41, 0x04, 0x91, 0xb3, 0xa4, 0x8e, 0x44, 0xd0, 0x1e, 0x78, 0xa3, 0xba, 0x32,
+	0x52, 0x7d, 0xa3, 0x28, 0x5e, 0x88, 0xab, 0x30, 0xa7, 0xb3, 0xdb, 0x8a, 0x91, 0xa6, 0xef, 0x18,
+	0xcd, 0xd6, 0xc8, 0x03, 0xcf, 0x61, 0x7f, 0x9d, 0xf0, 0x8f, 0x58, 0xbc, 0xe8, 0xab, 0x2b, 0x72,
+	0xd8, 0x17, 0xd8, 0x17, 0x0d, 0x79, 0x5f, 0x2d, 0xd0, 0x25, 0x2d, 0x8a, 0x0f, 0xf1, 0x56, 0x74,
+	0x5d, 0x9d, 0xb5, 0xdc, 0x77, 0xe3, 0x6e, 0x72, 0xf0, 0xde, 0xa9, 0xf2, 0xfd, 0xe1, 0x63, 0xe1,
+	0x85, 0x7e, 0x11, 0xa4, 0x4d, 0xa7, 0x8c, 0xa2, 0xa9, 0x64, 0x1d, 0xe3, 0x3f, 0x09, 0xf4, 0xa7,
+	0x12, 0x2c, 0x17, 0xe7, 0x62, 0xa4, 0x5a, 0x9c, 0xcc, 0x3a, 0x4c, 0xc4, 0xec, 0x48, 0xc9, 0x4c,
+	0xad, 0x5e, 0x1e, 0xa5, 0xe0, 0x97, 0x88, 0xd3, 0xc0, 0x22, 0x35, 0x8f, 0xbd, 0x5e, 0xcd, 0x95,
+	0xa3, 0x6a, 0x7e, 0x31, 0x06, 0xb3, 0x47, 0x37, 0xd1, 0xcd, 0x62, 0x2f, 0xc5, 0x1c, 0xac, 0x93,
+	0x7c, 0x96, 0xbe, 0x70, 0x32, 0xf8, 0x81, 0x46, 0xc5, 0x79, 0x38, 0x9e, 0x84, 0x83, 0xb8, 0x23,
+	0x1d, 0x4e, 0xcb, 0x5c, 0xfe, 0x3a, 0x83, 0x4c, 0x27, 0xde, 0x81, 0x69, 0xe3, 0x14, 0x49, 0x6c,
+	0x65, 0x6a, 0x68, 0xf4, 0x6e, 0x42, 0x37, 0x19, 0xd4, 0x5c, 0xd8, 0x4f, 0x7a, 0x52, 0xa5, 0x5c,
+	0xdc, 0x28, 0xeb, 0x0c, 0x1e, 0x70, 0x19, 0xa7, 0x94, 0x8b, 0x35, 0x6f, 0x42, 0x53, 0xae, 0x33,
+	0x30, 0xc5, 0x37, 0x87, 0x99, 0xb8, 0x53, 0x82, 0x86, 0x0c, 0xcf, 0x39, 0xa8, 0x93, 0x43, 0xca,
+	0xc2, 0xe2, 0xa6, 0xa0, 0x94, 0xe3, 0x06, 0x2c, 0x78, 0x41, 0xc7, 0x1f, 0x24, 0x38, 0x06, 0x61,
+	0xdb, 0xf4, 0xf1, 0xf5, 0x67, 0xe8, 0x58, 0xe6, 0xf3, 0x99, 0xf5, 0x8e, 0x36, 0x1a, 0x62, 0x7a,
+	0x57, 0x87, 0x51, 0x98, 0xc8, 0x38, 0x75, 0xaf, 0xa5, 0xef, 0x6a, 0x86, 0x8d, 0xe3, 0x35, 0x10,
+	0xd8, 0xd8, 0x3b, 0xca, 0xdb, 0xf3, 0xd4, 0x30, 0xcb, 0x83, 0x35, 0x3f, 0x77, 0x60, 0x49, 0xb3,
+	0xb9, 0x02, 0xb3, 0x89, 0xef, 0x26, 0x7d, 0x1c, 0x39, 0x32, 0xe7, 0x29, 0xbe, 0x20, 0x29, 0x6e,
+	0x5c, 0xad, 0xef, 0x51, 0x94, 0x6d, 0x6f, 0x77, 0xe0, 0xe3, 0x30, 0x46, 0xa3, 0xd3, 0x26, 0xed,
+	0xec, 0x66, 0x23, 0x4e, 0x51, 0xdf, 0xc2, 0x8a, 0xf1, 0x2b, 0x2b, 0xdf, 0xb5, 0x80, 0x21, 0x6a,
+	0x0b, 0x78, 0x3c, 0x78, 0x0b, 0xbb, 0x6e, 0x88, 0x8f, 0xb5, 0x27, 0x5d, 0x9f, 0x0e, 0xb1, 0x6e,
+	0xd7, 0x19, 0xb4, 0x09, 0x13, 0x0d, 0x98, 0xec, 0xc5, 0xee, 0xf6, 0xb6, 0xa7, 0x3c, 0xd3, 0xb0,
+	0xb2, 0xb5, 0xf5, 0x4b, 0x19, 0x16, 0xd2, 0xb4, 0xba, 0x87, 0xf2, 0xd2, 0xdc, 0x66, 0x2c, 0x73,
+	0x68, 0x92, 0x33, 0xb3, 0x5a, 0xdd, 0x80, 0xe4, 0x6c, 0x5e, 0x41, 0x4a, 0xe6, 0x13, 0xac, 0x11,
+	0x42, 0xf9, 0xa1, 0x32, 0xb2, 0xc2, 0xb3, 0x64, 0x8d, 0xca, 0x52, 0x94, 0x15, 0x5b, 0x70, 0x3e,
+	0x95, 0xc2, 0xf3, 0x59, 0x81, 0x79, 0x57, 0x29, 0x2c, 0x97, 0xae, 0x78, 0x26, 0xfb, 0xc4, 0xe8,
+	0xed, 0x44, 0x66, 0xcb, 0x2e, 0x4f, 0x22, 0x6e, 0xc2, 0xa2, 0xee, 0x78, 0x85, 0x61, 0x2c, 0xc1,
+	0x05, 0xb4, 0xaf, 0x15, 0x44, 0xae, 0x70, 0xcf, 0xf5, 0xd0, 0x24, 0xf3, 0x51, 0x2c, 0xcb, 0x13,
+	0x99, 0xed, 0x20, 0x64, 0xf5, 0xcf, 0x09, 0x1c, 0x26, 0xf5, 0x5c, 0x2f, 0xbe, 0x28, 0xc1, 0xf4,
+	0x3d, 0xa9, 0x72, 0x23, 0xb8, 0xb8, 0x3a, 0xaa, 0x9f, 0xbc, 0x3c, 0xa7, 0x37, 0xce, 0x8f, 0xf2,
+	0xcd, 0xcd, 0xd1, 0xd6, 0xb9, 0xe7, 0xbf, 0xfe, 0xf1, 0x5d, 0x79, 0x49, 0x9c, 0x6c, 0xa1, 0x33,
+	0x7e, 0x52, 0xb8, 0x7e, 0xd4, 0x77, 0xcd, 0x87, 0x45, 0x8b, 0x0e, 0x41, 0x3c, 0x83, 0x49, 0x9d,
+	0x05, 0x9d, 0xd5, 0x85, 0x91, 0xfb, 0xe7, 0x46, 0xf9, 0xff, 0x61, 0x67, 0x52, 0x8b, 0xf8, 0x1c,
+	0x66, 0xda, 0x52, 0xe5, 0x07, 0x72, 0xf1, 0xee, 0x3f, 0x18, 0xdb, 0x1b, 0x0b, 0x4d, 0xfe, 0xba,
+	0x69, 0xa6, 0x5f, 0x37, 0xcd, 0x0d, 0xfd, 0x75, 0x63, 0x9d, 0xa7, 0xad, 0x4f, 0x59, 0x4b, 0x45,
+	0x5b, 0xfb, 0x4c, 0x24, 0xbe, 0x29, 0xc1, 0xdb, 0xf8, 0xdc, 0x45, 0xa3, 0xaa, 0x18, 0x41, 0xdc,
+	0xb8, 0xf1, 0x6f, 0x06, 0x5e, 0xeb, 0x22, 0xa5, 0x73, 0x56, 0x9c, 0x2e, 0x4a, 0x67, 0x1b, 0xfd,
+	0x3b, 0xbc, 0x2b, 0x7e, 0xd9, 0xc0, 0xc1, 0xd4, 0x24, 0xae, 0x8c, 0x2c, 0xc5, 0xd1, 0x29, 0xaf,
+	0x71, 0xf5, 0x4d, 0x5c, 0x4d, 0x36, 0x16, 0x65, 0xb3, 0x2c, 0x1a, 0x45, 0xd9, 0x98, 0x89, 0xeb,
+	0xc7, 0x12, 0xcc, 0x17, 0xbd, 0x1e, 0xc5, 0xf5, 0x57, 0x6d, 0x34, 0xe2, 0xc5, 0x3e, 0xba, 0x6a,
+	0xaf, 0x7a, 0x03, 0xa7, 0x87, 0x28, 0x96, 0x8a, 0xf3, 0xe4, 0x7c, 0x7e, 0x28, 0xc1, 0x5b, 0x85,
+	0x2d, 0x53, 0x8c, 0xdc, 0xf4, 0x55, 0x1d, 0xb6, 0xd1, 0x7c, 0x5d, 0xd4, 0xe1, 0x06, 0x68, 0x5d,
+	0xa0, 0x24, 0x4f, 0x8b, 0xe5, 0xa2, 0x24, 0x23, 0xe3, 0xb5, 0x5e, 0xff, 0xf9, 0xf7, 0xd3, 0xa5,
+	0x17, 0xf8, 0xfb, 0x0d, 0x7f, 0x5b, 0xe3, 0x24, 0xaa, 0xeb, 0x7f, 0x03, 0x4d, 0xa2, 0x9b, 0x65,
+	0xf2, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DebugClient is the client API for Debug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugClient interface {
+	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
+	ListReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (*ListReorgsResponse, error)
+	ListValidatorRewards(ctx context.Context, in *ListValidatorRewardsRequest, opts ...grpc.CallOption) (*ListValidatorRewardsResponse, error)
+	SimulateBlockProposal(ctx context.Context, in *SimulateBlockProposalRequest, opts ...grpc.CallOption) (*SimulatedBlockProposal, error)
+}
+
+type debugClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDebugClient(cc *grpc.ClientConn) DebugClient {
+	return &debugClient{cc}
+}
+
+func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
+	out := new(ProtoArrayForkChoiceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (*ListReorgsResponse, error) {
+	out := new(ListReorgsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListReorgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListValidatorRewards(ctx context.Context, in *ListValidatorRewardsRequest, opts ...grpc.CallOption) (*ListValidatorRewardsResponse, error) {
+	out := new(ListValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SimulateBlockProposal(ctx context.Context, in *SimulateBlockProposalRequest, opts ...grpc.CallOption) (*SimulatedBlockProposal, error) {
+	out := new(SimulatedBlockProposal)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SimulateBlockProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugServer is the server API for Debug service.
+type DebugServer interface {
+	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
+	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
+	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*types.Empty, error)
+	GetProtoArrayForkChoice(context.Context, *types.Empty) (*ProtoArrayForkChoiceResponse, error)
+	ListReorgs(context.Context, *ListReorgsRequest) (*ListReorgsResponse, error)
+	ListValidatorRewards(context.Context, *ListValidatorRewardsRequest) (*ListValidatorRewardsResponse, error)
+	SimulateBlockProposal(context.Context, *SimulateBlockProposalRequest) (*SimulatedBlockProposal, error)
+}
+
+// UnimplementedDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugServer struct {
+}
+
+func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
+}
+func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+}
+func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *types.Empty) (*ProtoArrayForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+}
+func (*UnimplementedDebugServer) ListReorgs(ctx context.Context, req *ListReorgsRequest) (*ListReorgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReorgs not implemented")
+}
+func (*UnimplementedDebugServer) ListValidatorRewards(ctx context.Context, req *ListValidatorRewardsRequest) (*ListValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorRewards not implemented")
+}
+func (*UnimplementedDebugServer) SimulateBlockProposal(ctx context.Context, req *SimulateBlockProposalRequest) (*SimulatedBlockProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBlockProposal not implemented")
+}
+
+func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
+	s.RegisterService(&_Debug_serviceDesc, srv)
+}
+
+func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBeaconState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoggingLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SetLoggingLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListReorgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReorgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListReorgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListReorgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListReorgs(ctx, req.(*ListReorgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListValidatorRewards(ctx, req.(*ListValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SimulateBlockProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateBlockProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SimulateBlockProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SimulateBlockProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SimulateBlockProposal(ctx, req.(*SimulateBlockProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Debug_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Debug",
+	HandlerType: (*DebugServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconState",
+			Handler:    _Debug_GetBeaconState_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _Debug_GetBlock_Handler,
+		},
+		{
+			MethodName: "SetLoggingLevel",
+			Handler:    _Debug_SetLoggingLevel_Handler,
+		},
+		{
+			MethodName: "GetProtoArrayForkChoice",
+			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
+		},
+		{
+			MethodName: "ListReorgs",
+			Handler:    _Debug_ListReorgs_Handler,
+		},
+		{
+			MethodName: "ListValidatorRewards",
+			Handler:    _Debug_ListValidatorRewards_Handler,
+		},
+		{
+			MethodName: "SimulateBlockProposal",
+			Handler:    _Debug_SimulateBlockProposal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/debug.proto",
+}
+
+func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockRoot != nil {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSZResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSZResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSZResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Encoded) > 0 {
+		i -= len(m.Encoded)
+		copy(dAtA[i:], m.Encoded)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoggingLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoggingLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoggingLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Level != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtoArrayForkChoiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoArrayForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoArrayForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		for k := range m.Indices {
+			v := m.Indices[k]
+			baseI := i
+			i = encodeVarintDebug(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ProtoArrayNodes) > 0 {
+		for iNdEx := len(m.ProtoArrayNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtoArrayNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PruneThreshold != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PruneThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtoArrayNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoArrayNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BestDescendant != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BestDescendant))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BestChild != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BestChild))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Weight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Parent != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListReorgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListReorgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListReorgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.EndSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.StartSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListReorgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListReorgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListReorgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reorgs) > 0 {
+		for iNdEx := len(m.Reorgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reorgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reorg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reorg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reorg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OldBranchWeight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.OldBranchWeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NewBranchWeight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NewBranchWeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Depth != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CommonAncestorSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.CommonAncestorSlot))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CommonAncestorRoot) > 0 {
+		i -= len(m.CommonAncestorRoot)
+		copy(dAtA[i:], m.CommonAncestorRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.CommonAncestorRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NewHeadSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NewHeadSlot))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewHeadRoot) > 0 {
+		i -= len(m.NewHeadRoot)
+		copy(dAtA[i:], m.NewHeadRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.NewHeadRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OldHeadSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.OldHeadSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OldHeadRoot) > 0 {
+		i -= len(m.OldHeadRoot)
+		copy(dAtA[i:], m.OldHeadRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.OldHeadRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListValidatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintDebug(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListValidatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListValidatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListValidatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SlashingPenalty != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.SlashingPenalty))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.InactivityPenalty != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.InactivityPenalty))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ProposerReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ProposerReward))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InclusionDelayReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.InclusionDelayReward))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HeadPenalty != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.HeadPenalty))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HeadReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.HeadReward))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TargetPenalty != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TargetPenalty))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TargetReward))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourcePenalty != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.SourcePenalty))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.SourceReward))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateBlockProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateBlockProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateBlockProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Graffiti) > 0 {
+		i -= len(m.Graffiti)
+		copy(dAtA[i:], m.Graffiti)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Graffiti)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RandaoReveal) > 0 {
+		i -= len(m.RandaoReveal)
+		copy(dAtA[i:], m.RandaoReveal)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.RandaoReveal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParentRoot) > 0 {
+		i -= len(m.ParentRoot)
+		copy(dAtA[i:], m.ParentRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.ParentRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedBlockProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedBlockProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedBlockProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CommitteeValidators != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.CommitteeValidators))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NewAttestingValidators != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NewAttestingValidators))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AttestingValidators != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.AttestingValidators))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProposerReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ProposerReward))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProposerIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ProposerIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EncodedBlock) > 0 {
+		i -= len(m.EncodedBlock)
+		copy(dAtA[i:], m.EncodedBlock)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.EncodedBlock)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDebug(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BeaconStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovDebug(uint64(m.Slot))
+	return n
+}
+func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	return n
+}
+func (m *BlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSZResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Encoded)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LoggingLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovDebug(uint64(m.Level))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoArrayForkChoiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PruneThreshold != 0 {
+		n += 1 + sovDebug(uint64(m.PruneThreshold))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if len(m.ProtoArrayNodes) > 0 {
+		for _, e := range m.ProtoArrayNodes {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		for k, v := range m.Indices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + sovDebug(uint64(v))
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoArrayNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovDebug(uint64(m.Parent))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovDebug(uint64(m.Weight))
+	}
+	if m.BestChild != 0 {
+		n += 1 + sovDebug(uint64(m.BestChild))
+	}
+	if m.BestDescendant != 0 {
+		n += 1 + sovDebug(uint64(m.BestDescendant))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListReorgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		n += 1 + sovDebug(uint64(m.StartSlot))
+	}
+	if m.EndSlot != 0 {
+		n += 1 + sovDebug(uint64(m.EndSlot))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovDebug(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListReorgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reorgs) > 0 {
+		for _, e := range m.Reorgs {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovDebug(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Reorg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.OldHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.OldHeadSlot != 0 {
+		n += 1 + sovDebug(uint64(m.OldHeadSlot))
+	}
+	l = len(m.NewHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.NewHeadSlot != 0 {
+		n += 1 + sovDebug(uint64(m.NewHeadSlot))
+	}
+	l = len(m.CommonAncestorRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.CommonAncestorSlot != 0 {
+		n += 1 + sovDebug(uint64(m.CommonAncestorSlot))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovDebug(uint64(m.Depth))
+	}
+	if m.NewBranchWeight != 0 {
+		n += 1 + sovDebug(uint64(m.NewBranchWeight))
+	}
+	if m.OldBranchWeight != 0 {
+		n += 1 + sovDebug(uint64(m.OldBranchWeight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovDebug(uint64(m.Epoch))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovDebug(uint64(e))
+		}
+		n += 1 + sovDebug(uint64(l)) + l
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovDebug(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovDebug(uint64(m.Epoch))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovDebug(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovDebug(uint64(m.ValidatorIndex))
+	}
+	if m.SourceReward != 0 {
+		n += 1 + sovDebug(uint64(m.SourceReward))
+	}
+	if m.SourcePenalty != 0 {
+		n += 1 + sovDebug(uint64(m.SourcePenalty))
+	}
+	if m.TargetReward != 0 {
+		n += 1 + sovDebug(uint64(m.TargetReward))
+	}
+	if m.TargetPenalty != 0 {
+		n += 1 + sovDebug(uint64(m.TargetPenalty))
+	}
+	if m.HeadReward != 0 {
+		n += 1 + sovDebug(uint64(m.HeadReward))
+	}
+	if m.HeadPenalty != 0 {
+		n += 1 + sovDebug(uint64(m.HeadPenalty))
+	}
+	if m.InclusionDelayReward != 0 {
+		n += 1 + sovDebug(uint64(m.InclusionDelayReward))
+	}
+	if m.ProposerReward != 0 {
+		n += 1 + sovDebug(uint64(m.ProposerReward))
+	}
+	if m.InactivityPenalty != 0 {
+		n += 1 + sovDebug(uint64(m.InactivityPenalty))
+	}
+	if m.SlashingPenalty != 0 {
+		n += 1 + sovDebug(uint64(m.SlashingPenalty))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SimulateBlockProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.ParentRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.RandaoReveal)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Graffiti)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SimulatedBlockProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncodedBlock)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.ProposerIndex != 0 {
+		n += 1 + sovDebug(uint64(m.ProposerIndex))
+	}
+	if m.ProposerReward != 0 {
+		n += 1 + sovDebug(uint64(m.ProposerReward))
+	}
+	if m.AttestingValidators != 0 {
+		n += 1 + sovDebug(uint64(m.AttestingValidators))
+	}
+	if m.NewAttestingValidators != 0 {
+		n += 1 + sovDebug(uint64(m.NewAttestingValidators))
+	}
+	if m.CommitteeValidators != 0 {
+		n += 1 + sovDebug(uint64(m.CommitteeValidators))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDebug(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDebug(x uint64) (n int) {
+	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &BeaconStateRequest_Slot{v}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &BeaconStateRequest_BlockRoot{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSZResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSZResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSZResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
+			if m.Encoded == nil {
+				m.Encoded = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoggingLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoggingLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= LoggingLevelRequest_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneThreshold", wireType)
+			}
+			m.PruneThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoArrayNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtoArrayNodes = append(m.ProtoArrayNodes, &ProtoArrayNode{})
+			if err := m.ProtoArrayNodes[len(m.ProtoArrayNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Indices == nil {
+				m.Indices = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDebug(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Indices[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoArrayNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoArrayNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestChild", wireType)
+			}
+			m.BestChild = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestChild |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestDescendant", wireType)
+			}
+			m.BestDescendant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestDescendant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListReorgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListReorgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListReorgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSlot", wireType)
+			}
+			m.EndSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	if len(m.ProtoArrayNodes) > 0 {
-		for _, e := range m.ProtoArrayNodes {
-			l = e.Size()
-			n += 1 + l + sovDebug(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListReorgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListReorgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListReorgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reorgs = append(m.Reorgs, &Reorg{})
+			if err := m.Reorgs[len(m.Reorgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if len(m.Indices) > 0 {
-		for k, v := range m.Indices {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + sovDebug(uint64(v))
-			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reorg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reorg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reorg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldHeadRoot = append(m.OldHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldHeadRoot == nil {
+				m.OldHeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadSlot", wireType)
+			}
+			m.OldHeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldHeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewHeadRoot = append(m.NewHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewHeadRoot == nil {
+				m.NewHeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadSlot", wireType)
+			}
+			m.NewHeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewHeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommonAncestorRoot = append(m.CommonAncestorRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommonAncestorRoot == nil {
+				m.CommonAncestorRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorSlot", wireType)
+			}
+			m.CommonAncestorSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommonAncestorSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBranchWeight", wireType)
+			}
+			m.NewBranchWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewBranchWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldBranchWeight", wireType)
+			}
+			m.OldBranchWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldBranchWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *ProtoArrayNode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
-	}
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.Parent != 0 {
-		n += 1 + sovDebug(uint64(m.Parent))
-	}
-	if m.JustifiedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
-	}
-	if m.FinalizedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
-	}
-	if m.Weight != 0 {
-		n += 1 + sovDebug(uint64(m.Weight))
-	}
-	if m.BestChild != 0 {
-		n += 1 + sovDebug(uint64(m.BestChild))
-	}
-	if m.BestDescendant != 0 {
-		n += 1 + sovDebug(uint64(m.BestDescendant))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDebug(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDebug(x uint64) (n int) {
-	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
+func (m *ListValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1217,21 +4240,116 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListValidatorRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDebug
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDebug
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var v uint64
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1241,17 +4359,16 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &BeaconStateRequest_Slot{v}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1261,24 +4378,23 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.QueryFilter = &BeaconStateRequest_BlockRoot{v}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1305,7 +4421,7 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+func (m *ListValidatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1328,17 +4444,36 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListValidatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListValidatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1348,26 +4483,77 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			m.Rewards = append(m.Rewards, &ValidatorRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -1393,7 +4579,7 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SSZResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1416,17 +4602,17 @@ func (m *SSZResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SSZResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SSZResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
 			}
-			var byteLen int
+			m.ValidatorIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1436,85 +4622,187 @@ func (m *SSZResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDebug
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceReward", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
+			m.SourceReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePenalty", wireType)
 			}
-			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
-			if m.Encoded == nil {
-				m.Encoded = []byte{}
+			m.SourcePenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourcePenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReward", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDebug
+			m.TargetReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDebug
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPenalty", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.TargetPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDebug
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadReward", wireType)
+			}
+			m.HeadReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadPenalty", wireType)
+			}
+			m.HeadPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDelayReward", wireType)
+			}
+			m.InclusionDelayReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDelayReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
+			}
+			m.ProposerReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InactivityPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivityPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LoggingLevelRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoggingLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingPenalty", wireType)
 			}
-			m.Level = 0
+			m.SlashingPenalty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1524,7 +4812,7 @@ func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Level |= LoggingLevelRequest_Level(b&0x7F) << shift
+				m.SlashingPenalty |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1554,7 +4842,7 @@ func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulateBlockProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1577,17 +4865,17 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateBlockProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateBlockProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PruneThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.PruneThreshold = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1597,16 +4885,16 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PruneThreshold |= uint64(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRoot", wireType)
 			}
-			m.JustifiedEpoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1616,35 +4904,31 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
 			}
-			m.FinalizedEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FinalizedEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRoot = append(m.ParentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentRoot == nil {
+				m.ParentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtoArrayNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1654,31 +4938,31 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtoArrayNodes = append(m.ProtoArrayNodes, &ProtoArrayNode{})
-			if err := m.ProtoArrayNodes[len(m.ProtoArrayNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoReveal == nil {
+				m.RandaoReveal = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1688,104 +4972,25 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Indices == nil {
-				m.Indices = make(map[string]uint64)
-			}
-			var mapkey string
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDebug
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDebug
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthDebug
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthDebug
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDebug
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipDebug(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthDebug
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Graffiti = append(m.Graffiti[:0], dAtA[iNdEx:postIndex]...)
+			if m.Graffiti == nil {
+				m.Graffiti = []byte{}
 			}
-			m.Indices[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1812,7 +5017,7 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
+func (m *SimulatedBlockProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1835,17 +5040,17 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoArrayNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulatedBlockProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoArrayNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulatedBlockProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedBlock", wireType)
 			}
-			m.Slot = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1855,14 +5060,29 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedBlock = append(m.EncodedBlock[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedBlock == nil {
+				m.EncodedBlock = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1889,16 +5109,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerIndex", wireType)
 			}
-			m.Parent = 0
+			m.ProposerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1908,16 +5128,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Parent |= uint64(b&0x7F) << shift
+				m.ProposerIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
 			}
-			m.JustifiedEpoch = 0
+			m.ProposerReward = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1927,16 +5147,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				m.ProposerReward |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestingValidators", wireType)
 			}
-			m.FinalizedEpoch = 0
+			m.AttestingValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1946,16 +5166,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				m.AttestingValidators |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAttestingValidators", wireType)
 			}
-			m.Weight = 0
+			m.NewAttestingValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -1965,35 +5185,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				m.NewAttestingValidators |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BestChild", wireType)
-			}
-			m.BestChild = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BestChild |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BestDescendant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeValidators", wireType)
 			}
-			m.BestDescendant = 0
+			m.CommitteeValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2003,7 +5204,7 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BestDescendant |= uint64(b&0x7F) << shift
+				m.CommitteeValidators |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
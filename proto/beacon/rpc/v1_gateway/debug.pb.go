@@ -417,6 +417,630 @@ func (m *ProtoArrayNode) GetBestDescendant() uint64 {
 	return 0
 }
 
+type ListReorgsRequest struct {
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReorgsRequest) Reset()         { *m = ListReorgsRequest{} }
+func (m *ListReorgsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReorgsRequest) ProtoMessage()    {}
+func (*ListReorgsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{6}
+}
+
+func (m *ListReorgsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReorgsRequest.Unmarshal(m, b)
+}
+func (m *ListReorgsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReorgsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListReorgsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReorgsRequest.Merge(m, src)
+}
+func (m *ListReorgsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReorgsRequest.Size(m)
+}
+func (m *ListReorgsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReorgsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReorgsRequest proto.InternalMessageInfo
+
+func (m *ListReorgsRequest) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *ListReorgsRequest) GetEndSlot() uint64 {
+	if m != nil {
+		return m.EndSlot
+	}
+	return 0
+}
+
+func (m *ListReorgsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListReorgsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListReorgsResponse struct {
+	Reorgs               []*Reorg `protobuf:"bytes,1,rep,name=reorgs,proto3" json:"reorgs,omitempty"`
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32    `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReorgsResponse) Reset()         { *m = ListReorgsResponse{} }
+func (m *ListReorgsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReorgsResponse) ProtoMessage()    {}
+func (*ListReorgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{7}
+}
+
+func (m *ListReorgsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReorgsResponse.Unmarshal(m, b)
+}
+func (m *ListReorgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReorgsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListReorgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReorgsResponse.Merge(m, src)
+}
+func (m *ListReorgsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReorgsResponse.Size(m)
+}
+func (m *ListReorgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReorgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReorgsResponse proto.InternalMessageInfo
+
+func (m *ListReorgsResponse) GetReorgs() []*Reorg {
+	if m != nil {
+		return m.Reorgs
+	}
+	return nil
+}
+
+func (m *ListReorgsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListReorgsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type Reorg struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	OldHeadRoot          []byte   `protobuf:"bytes,2,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	OldHeadSlot          uint64   `protobuf:"varint,3,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,4,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	NewHeadSlot          uint64   `protobuf:"varint,5,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	CommonAncestorRoot   []byte   `protobuf:"bytes,6,opt,name=common_ancestor_root,json=commonAncestorRoot,proto3" json:"common_ancestor_root,omitempty"`
+	CommonAncestorSlot   uint64   `protobuf:"varint,7,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3" json:"common_ancestor_slot,omitempty"`
+	Depth                uint64   `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	NewBranchWeight      uint64   `protobuf:"varint,9,opt,name=new_branch_weight,json=newBranchWeight,proto3" json:"new_branch_weight,omitempty"`
+	OldBranchWeight      uint64   `protobuf:"varint,10,opt,name=old_branch_weight,json=oldBranchWeight,proto3" json:"old_branch_weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Reorg) Reset()         { *m = Reorg{} }
+func (m *Reorg) String() string { return proto.CompactTextString(m) }
+func (*Reorg) ProtoMessage()    {}
+func (*Reorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{8}
+}
+
+func (m *Reorg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Reorg.Unmarshal(m, b)
+}
+func (m *Reorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Reorg.Marshal(b, m, deterministic)
+}
+func (m *Reorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reorg.Merge(m, src)
+}
+func (m *Reorg) XXX_Size() int {
+	return xxx_messageInfo_Reorg.Size(m)
+}
+func (m *Reorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reorg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reorg proto.InternalMessageInfo
+
+func (m *Reorg) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *Reorg) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
+	}
+	return nil
+}
+
+func (m *Reorg) GetOldHeadSlot() uint64 {
+	if m != nil {
+		return m.OldHeadSlot
+	}
+	return 0
+}
+
+func (m *Reorg) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
+	}
+	return nil
+}
+
+func (m *Reorg) GetNewHeadSlot() uint64 {
+	if m != nil {
+		return m.NewHeadSlot
+	}
+	return 0
+}
+
+func (m *Reorg) GetCommonAncestorRoot() []byte {
+	if m != nil {
+		return m.CommonAncestorRoot
+	}
+	return nil
+}
+
+func (m *Reorg) GetCommonAncestorSlot() uint64 {
+	if m != nil {
+		return m.CommonAncestorSlot
+	}
+	return 0
+}
+
+func (m *Reorg) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *Reorg) GetNewBranchWeight() uint64 {
+	if m != nil {
+		return m.NewBranchWeight
+	}
+	return 0
+}
+
+func (m *Reorg) GetOldBranchWeight() uint64 {
+	if m != nil {
+		return m.OldBranchWeight
+	}
+	return 0
+}
+
+type ListValidatorRewardsRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Indices              []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListValidatorRewardsRequest) Reset()         { *m = ListValidatorRewardsRequest{} }
+func (m *ListValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorRewardsRequest) ProtoMessage()    {}
+func (*ListValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{9}
+}
+
+func (m *ListValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListValidatorRewardsRequest.Unmarshal(m, b)
+}
+func (m *ListValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListValidatorRewardsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorRewardsRequest.Merge(m, src)
+}
+func (m *ListValidatorRewardsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListValidatorRewardsRequest.Size(m)
+}
+func (m *ListValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListValidatorRewardsRequest proto.InternalMessageInfo
+
+func (m *ListValidatorRewardsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ListValidatorRewardsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *ListValidatorRewardsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListValidatorRewardsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListValidatorRewardsResponse struct {
+	Epoch                uint64              `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Rewards              []*ValidatorRewards `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextPageToken        string              `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32               `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListValidatorRewardsResponse) Reset()         { *m = ListValidatorRewardsResponse{} }
+func (m *ListValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorRewardsResponse) ProtoMessage()    {}
+func (*ListValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{10}
+}
+
+func (m *ListValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListValidatorRewardsResponse.Unmarshal(m, b)
+}
+func (m *ListValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListValidatorRewardsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorRewardsResponse.Merge(m, src)
+}
+func (m *ListValidatorRewardsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListValidatorRewardsResponse.Size(m)
+}
+func (m *ListValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListValidatorRewardsResponse proto.InternalMessageInfo
+
+func (m *ListValidatorRewardsResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ListValidatorRewardsResponse) GetRewards() []*ValidatorRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *ListValidatorRewardsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListValidatorRewardsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type ValidatorRewards struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	SourceReward         uint64   `protobuf:"varint,2,opt,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	SourcePenalty        uint64   `protobuf:"varint,3,opt,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetReward         uint64   `protobuf:"varint,4,opt,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	TargetPenalty        uint64   `protobuf:"varint,5,opt,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadReward           uint64   `protobuf:"varint,6,opt,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	HeadPenalty          uint64   `protobuf:"varint,7,opt,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InclusionDelayReward uint64   `protobuf:"varint,8,opt,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	ProposerReward       uint64   `protobuf:"varint,9,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	InactivityPenalty    uint64   `protobuf:"varint,10,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	SlashingPenalty      uint64   `protobuf:"varint,11,opt,name=slashing_penalty,json=slashingPenalty,proto3" json:"slashing_penalty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{11}
+}
+
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorRewards.Unmarshal(m, b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return xxx_messageInfo_ValidatorRewards.Size(m)
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetSourceReward() uint64 {
+	if m != nil {
+		return m.SourceReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetSourcePenalty() uint64 {
+	if m != nil {
+		return m.SourcePenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetTargetReward() uint64 {
+	if m != nil {
+		return m.TargetReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetTargetPenalty() uint64 {
+	if m != nil {
+		return m.TargetPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetHeadReward() uint64 {
+	if m != nil {
+		return m.HeadReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetHeadPenalty() uint64 {
+	if m != nil {
+		return m.HeadPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetInclusionDelayReward() uint64 {
+	if m != nil {
+		return m.InclusionDelayReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetInactivityPenalty() uint64 {
+	if m != nil {
+		return m.InactivityPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetSlashingPenalty() uint64 {
+	if m != nil {
+		return m.SlashingPenalty
+	}
+	return 0
+}
+
+type SimulateBlockProposalRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ParentRoot           []byte   `protobuf:"bytes,2,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	RandaoReveal         []byte   `protobuf:"bytes,3,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
+	Graffiti             []byte   `protobuf:"bytes,4,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulateBlockProposalRequest) Reset()         { *m = SimulateBlockProposalRequest{} }
+func (m *SimulateBlockProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateBlockProposalRequest) ProtoMessage()    {}
+func (*SimulateBlockProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{12}
+}
+
+func (m *SimulateBlockProposalRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateBlockProposalRequest.Unmarshal(m, b)
+}
+func (m *SimulateBlockProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateBlockProposalRequest.Marshal(b, m, deterministic)
+}
+func (m *SimulateBlockProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBlockProposalRequest.Merge(m, src)
+}
+func (m *SimulateBlockProposalRequest) XXX_Size() int {
+	return xxx_messageInfo_SimulateBlockProposalRequest.Size(m)
+}
+func (m *SimulateBlockProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBlockProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBlockProposalRequest proto.InternalMessageInfo
+
+func (m *SimulateBlockProposalRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *SimulateBlockProposalRequest) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
+	}
+	return nil
+}
+
+func (m *SimulateBlockProposalRequest) GetRandaoReveal() []byte {
+	if m != nil {
+		return m.RandaoReveal
+	}
+	return nil
+}
+
+func (m *SimulateBlockProposalRequest) GetGraffiti() []byte {
+	if m != nil {
+		return m.Graffiti
+	}
+	return nil
+}
+
+type SimulatedBlockProposal struct {
+	EncodedBlock           []byte   `protobuf:"bytes,1,opt,name=encoded_block,json=encodedBlock,proto3" json:"encoded_block,omitempty"`
+	StateRoot              []byte   `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	ProposerIndex          uint64   `protobuf:"varint,3,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	ProposerReward         uint64   `protobuf:"varint,4,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	AttestingValidators    uint64   `protobuf:"varint,5,opt,name=attesting_validators,json=attestingValidators,proto3" json:"attesting_validators,omitempty"`
+	NewAttestingValidators uint64   `protobuf:"varint,6,opt,name=new_attesting_validators,json=newAttestingValidators,proto3" json:"new_attesting_validators,omitempty"`
+	CommitteeValidators    uint64   `protobuf:"varint,7,opt,name=committee_validators,json=committeeValidators,proto3" json:"committee_validators,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *SimulatedBlockProposal) Reset()         { *m = SimulatedBlockProposal{} }
+func (m *SimulatedBlockProposal) String() string { return proto.CompactTextString(m) }
+func (*SimulatedBlockProposal) ProtoMessage()    {}
+func (*SimulatedBlockProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{13}
+}
+
+func (m *SimulatedBlockProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulatedBlockProposal.Unmarshal(m, b)
+}
+func (m *SimulatedBlockProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulatedBlockProposal.Marshal(b, m, deterministic)
+}
+func (m *SimulatedBlockProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedBlockProposal.Merge(m, src)
+}
+func (m *SimulatedBlockProposal) XXX_Size() int {
+	return xxx_messageInfo_SimulatedBlockProposal.Size(m)
+}
+func (m *SimulatedBlockProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedBlockProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedBlockProposal proto.InternalMessageInfo
+
+func (m *SimulatedBlockProposal) GetEncodedBlock() []byte {
+	if m != nil {
+		return m.EncodedBlock
+	}
+	return nil
+}
+
+func (m *SimulatedBlockProposal) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *SimulatedBlockProposal) GetProposerIndex() uint64 {
+	if m != nil {
+		return m.ProposerIndex
+	}
+	return 0
+}
+
+func (m *SimulatedBlockProposal) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return 0
+}
+
+func (m *SimulatedBlockProposal) GetAttestingValidators() uint64 {
+	if m != nil {
+		return m.AttestingValidators
+	}
+	return 0
+}
+
+func (m *SimulatedBlockProposal) GetNewAttestingValidators() uint64 {
+	if m != nil {
+		return m.NewAttestingValidators
+	}
+	return 0
+}
+
+func (m *SimulatedBlockProposal) GetCommitteeValidators() uint64 {
+	if m != nil {
+		return m.CommitteeValidators
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
 	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
@@ -426,68 +1050,124 @@ func init() {
 	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
 	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
 	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
+	proto.RegisterType((*ListReorgsRequest)(nil), "ethereum.beacon.rpc.v1.ListReorgsRequest")
+	proto.RegisterType((*ListReorgsResponse)(nil), "ethereum.beacon.rpc.v1.ListReorgsResponse")
+	proto.RegisterType((*Reorg)(nil), "ethereum.beacon.rpc.v1.Reorg")
+	proto.RegisterType((*ListValidatorRewardsRequest)(nil), "ethereum.beacon.rpc.v1.ListValidatorRewardsRequest")
+	proto.RegisterType((*ListValidatorRewardsResponse)(nil), "ethereum.beacon.rpc.v1.ListValidatorRewardsResponse")
+	proto.RegisterType((*ValidatorRewards)(nil), "ethereum.beacon.rpc.v1.ValidatorRewards")
+	proto.RegisterType((*SimulateBlockProposalRequest)(nil), "ethereum.beacon.rpc.v1.SimulateBlockProposalRequest")
+	proto.RegisterType((*SimulatedBlockProposal)(nil), "ethereum.beacon.rpc.v1.SimulatedBlockProposal")
 }
 
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
 
 var fileDescriptor_851e5cb2de3d61dd = []byte{
-	// 743 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xae, 0x13, 0x27, 0x6d, 0xb6, 0x51, 0x1a, 0x96, 0x2a, 0x98, 0xb4, 0xa5, 0xc1, 0x45, 0x6d,
-	0x05, 0xc2, 0x56, 0x02, 0x07, 0xd4, 0x5b, 0xd3, 0xa6, 0x69, 0xa5, 0xaa, 0x20, 0xa7, 0x5c, 0xe8,
-	0x21, 0x72, 0xec, 0x49, 0x6c, 0xe2, 0x7a, 0x5d, 0x7b, 0x1d, 0x08, 0xdc, 0x2a, 0x04, 0x47, 0x0e,
-	0x1c, 0x78, 0x30, 0x5e, 0x81, 0x07, 0x41, 0xbb, 0xeb, 0xa4, 0x89, 0x9a, 0x88, 0x1f, 0x71, 0xdb,
-	0xf9, 0xe6, 0x9b, 0x6f, 0x76, 0x67, 0x76, 0x06, 0x6d, 0x06, 0x21, 0xa1, 0x44, 0xef, 0x80, 0x69,
-	0x11, 0x5f, 0x0f, 0x03, 0x4b, 0x1f, 0x54, 0x75, 0x1b, 0x3a, 0x71, 0x4f, 0xe3, 0x1e, 0x5c, 0x02,
-	0xea, 0x40, 0x08, 0xf1, 0xa5, 0x26, 0x38, 0x5a, 0x18, 0x58, 0xda, 0xa0, 0x5a, 0x9e, 0x0e, 0x0c,
-	0x6a, 0x01, 0x0b, 0xa4, 0xc3, 0x00, 0x22, 0x11, 0x58, 0x5e, 0xef, 0x11, 0xd2, 0xf3, 0x40, 0x37,
-	0x03, 0x57, 0x37, 0x7d, 0x9f, 0x50, 0x93, 0xba, 0xc4, 0x1f, 0x79, 0xd7, 0x12, 0x2f, 0xb7, 0x3a,
-	0x71, 0x57, 0x87, 0xcb, 0x80, 0x0e, 0x85, 0x53, 0xbd, 0x40, 0xb8, 0xce, 0x75, 0x5b, 0xd4, 0xa4,
-	0x60, 0xc0, 0x55, 0x0c, 0x11, 0xc5, 0xab, 0x48, 0x8e, 0x3c, 0x42, 0x15, 0xa9, 0x22, 0xed, 0xca,
-	0xc7, 0x0b, 0x06, 0xb7, 0xf0, 0x26, 0x42, 0x1d, 0x8f, 0x58, 0xfd, 0x76, 0x48, 0x08, 0x55, 0x52,
-	0x15, 0x69, 0x37, 0x7f, 0xbc, 0x60, 0xe4, 0x38, 0x66, 0x10, 0x42, 0xeb, 0x05, 0x94, 0xbf, 0x8a,
-	0x21, 0x1c, 0xb6, 0xbb, 0xae, 0x47, 0x21, 0x54, 0x9f, 0xa2, 0x7c, 0x9d, 0x3b, 0x13, 0xd9, 0x8d,
-	0x29, 0x01, 0x26, 0x9e, 0x9f, 0x08, 0x57, 0x77, 0xd0, 0x72, 0xab, 0xf5, 0xc6, 0x80, 0x28, 0x20,
-	0x7e, 0x04, 0x58, 0x41, 0x8b, 0xe0, 0x5b, 0xc4, 0x06, 0x3b, 0xa1, 0x8e, 0x4c, 0xf5, 0x8b, 0x84,
-	0xee, 0x9e, 0x92, 0x5e, 0xcf, 0xf5, 0x7b, 0xa7, 0x30, 0x00, 0x6f, 0xa4, 0xdf, 0x44, 0x19, 0x8f,
-	0xd9, 0x9c, 0x5f, 0xa8, 0x55, 0xb5, 0xd9, 0x05, 0xd5, 0x66, 0xc4, 0x6a, 0xc2, 0x10, 0xf1, 0xea,
-	0x0e, 0xca, 0x70, 0x1b, 0x2f, 0x21, 0xf9, 0xe4, 0xec, 0xe8, 0x65, 0x71, 0x01, 0xe7, 0x50, 0xe6,
-	0xb0, 0x51, 0x7f, 0xdd, 0x2c, 0x4a, 0xec, 0x78, 0x6e, 0xec, 0x1f, 0x34, 0x8a, 0x29, 0xf5, 0x73,
-	0x1a, 0xad, 0xbf, 0x62, 0x85, 0xdc, 0x0f, 0x43, 0x73, 0x78, 0x44, 0xc2, 0xfe, 0x81, 0x43, 0x5c,
-	0x0b, 0xc6, 0x8f, 0xd8, 0x41, 0x2b, 0x41, 0x18, 0xfb, 0xd0, 0xa6, 0x4e, 0x08, 0x91, 0x43, 0x3c,
-	0xf1, 0x18, 0xd9, 0x28, 0x70, 0xf8, 0x7c, 0x84, 0x32, 0xe2, 0xdb, 0x38, 0xa2, 0x6e, 0xd7, 0x05,
-	0xbb, 0x0d, 0x01, 0xb1, 0x1c, 0x5e, 0x61, 0xd9, 0x28, 0x8c, 0xe1, 0x06, 0x43, 0x19, 0xb1, 0xeb,
-	0xfa, 0xa6, 0xe7, 0x7e, 0x18, 0x13, 0xd3, 0x82, 0x38, 0x86, 0x05, 0xd1, 0x40, 0x77, 0x78, 0x8f,
-	0xdb, 0x26, 0xbb, 0x5b, 0xdb, 0x27, 0x36, 0x44, 0x8a, 0x5c, 0x49, 0xef, 0x2e, 0xd7, 0xb6, 0xe7,
-	0x55, 0xe6, 0xe6, 0x2d, 0x67, 0xc4, 0x06, 0x63, 0x25, 0x98, 0xb2, 0x23, 0x7c, 0x81, 0x16, 0x5d,
-	0xdf, 0x76, 0x2d, 0x88, 0x94, 0x0c, 0x57, 0xda, 0xff, 0xbd, 0xd2, 0xed, 0xaa, 0x68, 0x27, 0x42,
-	0xa3, 0xe1, 0xd3, 0x70, 0x68, 0x8c, 0x14, 0xcb, 0x7b, 0x28, 0x3f, 0xe9, 0xc0, 0x45, 0x94, 0xee,
-	0xc3, 0x90, 0xd7, 0x2b, 0x67, 0xb0, 0x23, 0x5e, 0x45, 0x99, 0x81, 0xe9, 0xc5, 0x90, 0x94, 0x46,
-	0x18, 0x7b, 0xa9, 0x17, 0x92, 0x7a, 0x9d, 0x42, 0x85, 0xe9, 0xcb, 0x63, 0x3c, 0xf9, 0x89, 0x93,
-	0x2f, 0x8c, 0x91, 0x7c, 0xf3, 0x79, 0x0d, 0x7e, 0xc6, 0x25, 0x94, 0x0d, 0xcc, 0x10, 0x7c, 0x9a,
-	0xd4, 0x31, 0xb1, 0x66, 0x75, 0x44, 0xfe, 0xd3, 0x8e, 0x64, 0x66, 0x76, 0xa4, 0x84, 0xb2, 0xef,
-	0xc0, 0xed, 0x39, 0x54, 0xc9, 0x8a, 0x4c, 0xc2, 0xe2, 0x73, 0x01, 0x11, 0x6d, 0x5b, 0x8e, 0xeb,
-	0xd9, 0xca, 0x22, 0xf7, 0xe5, 0x18, 0x72, 0xc0, 0x00, 0xa6, 0xcf, 0xdd, 0x36, 0x44, 0x16, 0xf8,
-	0xb6, 0xe9, 0x53, 0x65, 0x49, 0xe8, 0x33, 0xf8, 0x70, 0x8c, 0xd6, 0xbe, 0xcb, 0x28, 0x73, 0xc8,
-	0x16, 0x0a, 0xfe, 0x24, 0xa1, 0x42, 0x13, 0xe8, 0xc4, 0x68, 0xe3, 0xc7, 0xf3, 0x3a, 0x75, 0x7b,
-	0xfe, 0xcb, 0x5b, 0xf3, 0xb8, 0x13, 0xf3, 0xa9, 0x3e, 0xbc, 0xfe, 0xf1, 0xf3, 0x5b, 0x6a, 0x0d,
-	0xdf, 0xd7, 0x81, 0x3a, 0xfa, 0xa0, 0x6a, 0x7a, 0x81, 0x63, 0x26, 0x1b, 0x4d, 0x8f, 0x78, 0xce,
-	0xf7, 0x68, 0x89, 0xdd, 0x82, 0x4d, 0x38, 0x7e, 0x34, 0x37, 0xff, 0xc4, 0x8a, 0xf8, 0x0f, 0x99,
-	0xf9, 0x3e, 0xc1, 0x1f, 0xd1, 0x4a, 0x0b, 0xe8, 0xe4, 0xa0, 0xe3, 0x27, 0x7f, 0xb1, 0x0e, 0xca,
-	0x25, 0x4d, 0x6c, 0x4d, 0x6d, 0xb4, 0x35, 0xb5, 0x06, 0xdb, 0x9a, 0xea, 0x16, 0x4f, 0xbd, 0xa1,
-	0xae, 0xcd, 0x4a, 0xed, 0x09, 0x21, 0xfc, 0x55, 0x42, 0xf7, 0x9a, 0x40, 0x67, 0x8d, 0x00, 0x9e,
-	0x23, 0x5c, 0x7e, 0xfe, 0x2f, 0x83, 0xa4, 0x6e, 0xf3, 0xeb, 0x54, 0xf0, 0x83, 0x59, 0xd7, 0xe9,
-	0x92, 0xb0, 0x6f, 0x71, 0x7e, 0x27, 0xcb, 0xb3, 0x3d, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0x87,
-	0x62, 0x7e, 0x5b, 0x84, 0x06, 0x00, 0x00,
+	// 1507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x57, 0xdb, 0x6e, 0xdb, 0x46,
+	0x10, 0x8d, 0x64, 0xc9, 0xb6, 0xc6, 0x8a, 0x2f, 0x1b, 0xd7, 0x75, 0x64, 0xe7, 0xc6, 0xa4, 0xb9,
+	0x15, 0x91, 0x6a, 0x27, 0x05, 0x82, 0xbc, 0xd9, 0x89, 0x73, 0x01, 0x82, 0xc4, 0xa0, 0xd2, 0x16,
+	0x68, 0x1e, 0x08, 0x5a, 0x5a, 0x49, 0xac, 0x69, 0x92, 0x25, 0x57, 0x72, 0xdc, 0xbe, 0x05, 0x45,
+	0x8b, 0x3e, 0x15, 0x68, 0xfb, 0x07, 0x05, 0xfa, 0x1b, 0xfd, 0x81, 0x3e, 0x14, 0xe8, 0x2f, 0xf4,
+	0x03, 0xfa, 0x03, 0x05, 0x3a, 0x3b, 0xb3, 0xa4, 0x69, 0x87, 0x4a, 0xd2, 0xcb, 0x9b, 0xf6, 0xcc,
+	0xcc, 0xd9, 0xe1, 0xec, 0xd9, 0xd9, 0x11, 0x9c, 0x8b, 0xe2, 0x50, 0x85, 0xad, 0x1d, 0xe9, 0x76,
+	0xc2, 0xa0, 0x15, 0x47, 0x9d, 0xd6, 0x68, 0xad, 0xd5, 0x95, 0x3b, 0xc3, 0x7e, 0x93, 0x2c, 0x62,
+	0x49, 0xaa, 0x81, 0x8c, 0xe5, 0x70, 0xaf, 0xc9, 0x3e, 0x4d, 0xf4, 0x69, 0x8e, 0xd6, 0x1a, 0xab,
+	0xfd, 0x30, 0xec, 0xfb, 0xb2, 0xe5, 0x46, 0x5e, 0xcb, 0x0d, 0x82, 0x50, 0xb9, 0xca, 0x0b, 0x83,
+	0x84, 0xa3, 0x1a, 0x2b, 0xc6, 0x4a, 0xab, 0x9d, 0x61, 0xaf, 0x25, 0xf7, 0x22, 0x75, 0xc0, 0x46,
+	0xeb, 0x39, 0x88, 0x4d, 0xe2, 0x6a, 0x63, 0x90, 0xb4, 0xe5, 0xe7, 0x43, 0x99, 0x28, 0xb1, 0x08,
+	0x95, 0xc4, 0x0f, 0xd5, 0x72, 0xe9, 0x7c, 0xe9, 0x6a, 0xe5, 0xe1, 0x09, 0x9b, 0x56, 0xe2, 0x1c,
+	0xc0, 0x8e, 0x1f, 0x76, 0x76, 0x9d, 0x38, 0x44, 0x5b, 0x19, 0x6d, 0x75, 0xb4, 0xd5, 0x08, 0xb3,
+	0x11, 0xda, 0x9c, 0x85, 0x3a, 0xc6, 0xc7, 0x07, 0x4e, 0xcf, 0xf3, 0x95, 0x8c, 0xad, 0x1b, 0x50,
+	0xdf, 0x24, 0xa3, 0xa1, 0x3d, 0x73, 0x84, 0x40, 0x93, 0xd7, 0x73, 0xe1, 0xd6, 0x15, 0x98, 0x69,
+	0xb7, 0x3f, 0xb5, 0x65, 0x12, 0x61, 0xf2, 0x52, 0x2c, 0xc3, 0x94, 0x0c, 0x3a, 0x61, 0x57, 0x76,
+	0x8d, 0x6b, 0xba, 0xb4, 0xbe, 0x29, 0xc1, 0xa9, 0xc7, 0x61, 0xbf, 0xef, 0x05, 0xfd, 0xc7, 0x72,
+	0x24, 0xfd, 0x94, 0xff, 0x01, 0x54, 0x7d, 0xbd, 0x26, 0xff, 0xd9, 0xf5, 0xb5, 0x66, 0x71, 0xbd,
+	0x9a, 0x05, 0xb1, 0x4d, 0x5e, 0x70, 0x3c, 0x66, 0x52, 0xa5, 0xb5, 0x98, 0x86, 0xca, 0xa3, 0x27,
+	0xf7, 0x9f, 0xce, 0x9f, 0x10, 0x35, 0xa8, 0xde, 0xdb, 0xda, 0xfc, 0xe8, 0xc1, 0x7c, 0x49, 0xff,
+	0x7c, 0x66, 0x6f, 0xdc, 0xdd, 0x9a, 0x2f, 0x5b, 0x5f, 0x4f, 0xc0, 0xea, 0xb6, 0x2e, 0xe4, 0x46,
+	0x1c, 0xbb, 0x07, 0xf7, 0xc3, 0x78, 0xf7, 0xee, 0x20, 0xf4, 0x3a, 0x32, 0xfb, 0x88, 0x2b, 0x30,
+	0x17, 0xc5, 0xc3, 0x40, 0x3a, 0x6a, 0x10, 0xcb, 0x64, 0x10, 0xfa, 0xfc, 0x31, 0x15, 0x7b, 0x96,
+	0xe0, 0x67, 0x29, 0xaa, 0x1d, 0x3f, 0x1b, 0x26, 0xca, 0xeb, 0x79, 0xb2, 0xeb, 0xc8, 0x28, 0xec,
+	0x0c, 0xa8, 0xc2, 0xe8, 0x98, 0xc1, 0x5b, 0x1a, 0xd5, 0x8e, 0x3d, 0x2f, 0x70, 0x7d, 0xef, 0x8b,
+	0xcc, 0x71, 0x82, 0x1d, 0x33, 0x98, 0x1d, 0x6d, 0x58, 0xa0, 0x33, 0x76, 0x5c, 0x9d, 0x9b, 0x13,
+	0x60, 0xe9, 0x92, 0xe5, 0xca, 0xf9, 0x89, 0xab, 0x33, 0xeb, 0x97, 0xc7, 0x55, 0xe6, 0xf0, 0x5b,
+	0x9e, 0xa0, 0xbb, 0x3d, 0x17, 0x1d, 0x59, 0x27, 0xe2, 0x39, 0x4c, 0x79, 0x41, 0x17, 0x3f, 0x30,
+	0x59, 0xae, 0x12, 0xd3, 0xc6, 0x9b, 0x99, 0x5e, 0xad, 0x4a, 0xf3, 0x11, 0x73, 0x6c, 0x05, 0x2a,
+	0x3e, 0xb0, 0x53, 0xc6, 0xc6, 0x1d, 0xa8, 0xe7, 0x0d, 0x62, 0x1e, 0x26, 0x76, 0xe5, 0x01, 0xd5,
+	0xab, 0x66, 0xeb, 0x9f, 0xa8, 0xcb, 0xea, 0xc8, 0xf5, 0x87, 0xd2, 0x94, 0x86, 0x17, 0x77, 0xca,
+	0xb7, 0x4b, 0xd6, 0xcb, 0x32, 0xcc, 0x1e, 0x4d, 0x5e, 0x88, 0xbc, 0x88, 0x8d, 0x84, 0x11, 0x3b,
+	0x14, 0xaf, 0x4d, 0xbf, 0xc5, 0x12, 0x4c, 0x46, 0x6e, 0x2c, 0x03, 0x65, 0xea, 0x68, 0x56, 0x45,
+	0x27, 0x52, 0x79, 0xdb, 0x13, 0xa9, 0x16, 0x9e, 0x08, 0xee, 0xb4, 0x2f, 0xbd, 0xfe, 0x40, 0x2d,
+	0x4f, 0xf2, 0x4e, 0xbc, 0xa2, 0x7b, 0x81, 0x1a, 0x74, 0x3a, 0x03, 0x0f, 0xf5, 0x31, 0x45, 0xb6,
+	0x9a, 0x46, 0xee, 0x6a, 0x40, 0xf3, 0x93, 0x19, 0x0f, 0xa0, 0x23, 0x83, 0xae, 0x8b, 0x99, 0x4e,
+	0x33, 0xbf, 0x86, 0xef, 0x65, 0xa8, 0xf5, 0x6d, 0x09, 0x16, 0x1e, 0x7b, 0x89, 0xb2, 0x65, 0x18,
+	0xf7, 0x93, 0xdc, 0xad, 0x4b, 0x94, 0x1b, 0x2b, 0x27, 0x57, 0x8d, 0x1a, 0x21, 0x6d, 0x5d, 0x92,
+	0xd3, 0x30, 0x8d, 0xe1, 0x6c, 0xe4, 0xb2, 0xe2, 0x3d, 0xeb, 0x92, 0x69, 0x05, 0x6a, 0x91, 0xdb,
+	0x97, 0x4e, 0x82, 0x9f, 0x40, 0xc5, 0xa9, 0xda, 0xd3, 0x1a, 0x68, 0xe3, 0x5a, 0xd3, 0x92, 0x51,
+	0x85, 0xbb, 0x32, 0xa0, 0xca, 0xd4, 0x6c, 0x72, 0x7f, 0xa6, 0x01, 0xeb, 0xfb, 0x12, 0x88, 0x7c,
+	0x2e, 0xe6, 0x3e, 0x7c, 0x08, 0x93, 0x31, 0x21, 0x98, 0x88, 0xd6, 0xcf, 0x99, 0x71, 0xfa, 0xa1,
+	0x38, 0xdb, 0x38, 0x8b, 0xcb, 0x30, 0x17, 0xc8, 0x17, 0xca, 0xc9, 0xed, 0x58, 0xa6, 0x1d, 0x4f,
+	0x6a, 0x78, 0x3b, 0xdd, 0x55, 0x27, 0xa5, 0xb0, 0xfd, 0xf9, 0xf9, 0x94, 0x6b, 0x84, 0xe8, 0x9c,
+	0xad, 0xbf, 0xca, 0x50, 0x25, 0xe2, 0x42, 0x71, 0x58, 0x70, 0x12, 0x6f, 0xa2, 0x33, 0x90, 0x6e,
+	0x37, 0xd7, 0xe2, 0xec, 0x19, 0x04, 0x1f, 0x22, 0xa6, 0x7b, 0xd4, 0x11, 0x1f, 0x22, 0x60, 0xcd,
+	0xa4, 0x3e, 0x6d, 0xc3, 0x13, 0xc8, 0xfd, 0x1c, 0x4f, 0x85, 0x79, 0x10, 0xcc, 0xf3, 0x64, 0x3e,
+	0xc4, 0xc3, 0x8a, 0x49, 0x7d, 0x88, 0xe7, 0x03, 0x58, 0xec, 0x84, 0x7b, 0x7b, 0x61, 0xe0, 0xb8,
+	0x01, 0x5e, 0x0a, 0x15, 0xc6, 0x4c, 0x37, 0x49, 0x74, 0x82, 0x6d, 0x1b, 0xc6, 0x44, 0xac, 0x05,
+	0x11, 0x44, 0xce, 0x92, 0x3a, 0x16, 0x41, 0x7b, 0xe0, 0x8d, 0xea, 0xca, 0x48, 0x0d, 0x8c, 0xa2,
+	0x78, 0x21, 0xae, 0xc3, 0x82, 0xce, 0x6e, 0x27, 0x46, 0x9a, 0x81, 0x63, 0x34, 0x5b, 0x23, 0x0f,
+	0x3c, 0x87, 0xfd, 0x4d, 0xc2, 0x3f, 0x61, 0xf1, 0xa2, 0xaf, 0xae, 0xc8, 0x51, 0x5f, 0x60, 0x5f,
+	0x34, 0xe4, 0x7d, 0xb5, 0x40, 0x57, 0xb4, 0x28, 0x3e, 0xc6, 0x5b, 0xd1, 0x75, 0x75, 0xd6, 0x72,
+	0xdf, 0x8d, 0xbb, 0xc9, 0xe1, 0xbb, 0x53, 0xe5, 0xfb, 0xc3, 0xc7, 0xc2, 0x0b, 0xfd, 0x10, 0xa4,
+	0x4d, 0xa7, 0x8c, 0xa2, 0xa9, 0x64, 0x1d, 0xe3, 0x3f, 0x09, 0xf4, 0x97, 0x12, 0xac, 0x16, 0xe7,
+	0x62, 0xa4, 0x5a, 0x9c, 0xcc, 0x26, 0x4c, 0xc5, 0xec, 0x48, 0xc9, 0xcc, 0xac, 0x5f, 0x1d, 0xa7,
+	0xe0, 0x57, 0x88, 0xd3, 0xc0, 0x22, 0x35, 0x4f, 0xbc, 0x59, 0xcd, 0x95, 0xe3, 0x6a, 0xfe, 0x6d,
+	0x02, 0xe6, 0x8f, 0x6f, 0xa2, 0x9b, 0xc5, 0x28, 0xc5, 0x1c, 0xac, 0x93, 0x7c, 0x91, 0x3e, 0x38,
+	0x19, 0xfc, 0x48, 0xa3, 0xe2, 0x22, 0x9c, 0x4c, 0xc2, 0x61, 0xdc, 0x91, 0x0e, 0xa7, 0x65, 0x2e,
+	0x7f, 0x9d, 0x41, 0xa6, 0x13, 0xef, 0xc1, 0xac, 0x71, 0x8a, 0x24, 0xb6, 0x32, 0x75, 0x60, 0xf4,
+	0x6e, 0x42, 0xb7, 0x19, 0xd4, 0x5c, 0xd8, 0x4f, 0xfa, 0x52, 0xa5, 0x5c, 0xdc, 0x28, 0xeb, 0x0c,
+	0x1e, 0x72, 0x19, 0xa7, 0x94, 0x8b, 0x35, 0x6f, 0x42, 0x53, 0xae, 0x73, 0x30, 0xc3, 0x37, 0x87,
+	0x99, 0xb8, 0x53, 0x82, 0x86, 0x0c, 0xcf, 0x05, 0xa8, 0x93, 0x43, 0xca, 0xc2, 0xe2, 0xa6, 0xa0,
+	0x94, 0xe3, 0x16, 0x2c, 0x79, 0x41, 0xc7, 0x1f, 0x26, 0x38, 0x06, 0x61, 0xdb, 0xf4, 0xf1, 0xf9,
+	0x33, 0x74, 0x2c, 0xf3, 0xc5, 0xcc, 0x7a, 0x4f, 0x1b, 0x0d, 0x31, 0xbd, 0xd5, 0x61, 0x14, 0x26,
+	0x32, 0x4e, 0xdd, 0x6b, 0xe9, 0x5b, 0xcd, 0xb0, 0x71, 0xbc, 0x01, 0x02, 0x1b, 0x7b, 0x47, 0x79,
+	0x23, 0x4f, 0x1d, 0x64, 0x79, 0xb0, 0xe6, 0x17, 0x0e, 0x2d, 0x69, 0x36, 0xd7, 0x60, 0x3e, 0xf1,
+	0xdd, 0x64, 0x80, 0x23, 0x47, 0xe6, 0x3c, 0xc3, 0x17, 0x24, 0xc5, 0x8d, 0xab, 0xf5, 0x23, 0x8a,
+	0xb2, 0xed, 0xed, 0x0d, 0x7d, 0x1c, 0xc6, 0x68, 0x74, 0xda, 0xa6, 0x9d, 0xdd, 0x6c, 0xc4, 0x29,
+	0xea, 0x5b, 0x58, 0x31, 0x7e, 0xb2, 0xf2, 0x5d, 0x0b, 0x18, 0xa2, 0xb6, 0x80, 0xc7, 0x83, 0xb7,
+	0xb0, 0xeb, 0x86, 0xf8, 0x59, 0x23, 0xe9, 0xfa, 0x74, 0x88, 0x75, 0xbb, 0xce, 0xa0, 0x4d, 0x98,
+	0x68, 0xc0, 0x74, 0x3f, 0x76, 0x7b, 0x3d, 0x4f, 0x79, 0xa6, 0x61, 0x65, 0x6b, 0xeb, 0xd7, 0x32,
+	0x2c, 0xa5, 0x69, 0x75, 0x8f, 0xe4, 0xa5, 0xb9, 0xcd, 0x58, 0xe6, 0xd0, 0x24, 0x67, 0x66, 0xb5,
+	0xba, 0x01, 0xc9, 0xd9, 0x3c, 0x41, 0x4a, 0xe6, 0x13, 0xac, 0x11, 0x42, 0xf9, 0xa1, 0x32, 0xb2,
+	0xc2, 0xb3, 0x64, 0x8d, 0xca, 0x52, 0x94, 0x15, 0x5b, 0x70, 0x3e, 0x95, 0xc2, 0xf3, 0x59, 0x83,
+	0x45, 0x57, 0x29, 0x2c, 0x97, 0xae, 0x78, 0x26, 0xfb, 0xc4, 0xe8, 0xed, 0x54, 0x66, 0xcb, 0x2e,
+	0x4f, 0x22, 0x6e, 0xc3, 0xb2, 0xee, 0x78, 0x85, 0x61, 0x2c, 0xc1, 0x25, 0xb4, 0x6f, 0x14, 0x44,
+	0xae, 0x71, 0xcf, 0xf5, 0xd0, 0x24, 0xf3, 0x51, 0x2c, 0xcb, 0x53, 0x99, 0xed, 0x30, 0x64, 0xfd,
+	0xcf, 0x29, 0x1c, 0x26, 0xf5, 0x5c, 0x2f, 0xbe, 0x2a, 0xc1, 0xec, 0x03, 0xa9, 0x72, 0x23, 0xb8,
+	0xb8, 0x3e, 0xae, 0x9f, 0xbc, 0x3a, 0xa7, 0x37, 0x2e, 0x8e, 0xf3, 0xcd, 0xcd, 0xd1, 0xd6, 0x85,
+	0x97, 0xbf, 0xff, 0xf1, 0x43, 0x79, 0x45, 0x9c, 0x6e, 0xa1, 0x33, 0xfe, 0xa5, 0x70, 0xfd, 0x68,
+	0xe0, 0x9a, 0x3f, 0x16, 0x2d, 0x3a, 0x04, 0xf1, 0x02, 0xa6, 0x75, 0x16, 0x74, 0x56, 0x97, 0xc6,
+	0xee, 0x9f, 0x1b, 0xe5, 0xff, 0x87, 0x9d, 0x49, 0x2d, 0xe2, 0x4b, 0x98, 0x6b, 0x4b, 0x95, 0x1f,
+	0xc8, 0xc5, 0xfb, 0xff, 0x60, 0x6c, 0x6f, 0x2c, 0x35, 0xf9, 0xdf, 0x4d, 0x33, 0xfd, 0x77, 0xd3,
+	0xdc, 0xd2, 0xff, 0x6e, 0xac, 0x8b, 0xb4, 0xf5, 0x19, 0x6b, 0xa5, 0x68, 0x6b, 0x9f, 0x89, 0xc4,
+	0x77, 0x25, 0x78, 0x17, 0xbf, 0xbb, 0x68, 0x54, 0x15, 0x63, 0x88, 0x1b, 0xb7, 0xfe, 0xcd, 0xc0,
+	0x6b, 0x5d, 0xa6, 0x74, 0xce, 0x8b, 0xb3, 0x45, 0xe9, 0xf4, 0xd0, 0xbf, 0xc3, 0xbb, 0xe2, 0x3f,
+	0x1b, 0x38, 0x9c, 0x9a, 0xc4, 0xb5, 0xb1, 0xa5, 0x38, 0x3e, 0xe5, 0x35, 0xae, 0xbf, 0x8d, 0xab,
+	0xc9, 0xc6, 0xa2, 0x6c, 0x56, 0x45, 0xa3, 0x28, 0x1b, 0x33, 0x71, 0xfd, 0x5c, 0x82, 0xc5, 0xa2,
+	0xe7, 0x51, 0xdc, 0x7c, 0xdd, 0x46, 0x63, 0x1e, 0xf6, 0xf1, 0x55, 0x7b, 0xdd, 0x0b, 0x9c, 0x1e,
+	0xa2, 0x58, 0x29, 0xce, 0x93, 0xf3, 0xf9, 0xa9, 0x04, 0xef, 0x14, 0xb6, 0x4c, 0x31, 0x76, 0xd3,
+	0xd7, 0x75, 0xd8, 0x46, 0xf3, 0x4d, 0x51, 0x47, 0x1b, 0xa0, 0x75, 0x89, 0x92, 0x3c, 0x2b, 0x56,
+	0x8b, 0x92, 0x8c, 0x8c, 0xd7, 0xce, 0x24, 0xc9, 0xe8, 0xe6, 0xdf, 0x45, 0x97, 0x6b, 0x7a, 0xe4,
+	0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConnInterface
+var _ grpc.ClientConn
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+const _ = grpc.SupportPackageIsVersion4
 
 // DebugClient is the client API for Debug service.
 //
@@ -497,13 +1177,16 @@ type DebugClient interface {
 	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
 	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	GetProtoArrayForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
+	ListReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (*ListReorgsResponse, error)
+	ListValidatorRewards(ctx context.Context, in *ListValidatorRewardsRequest, opts ...grpc.CallOption) (*ListValidatorRewardsResponse, error)
+	SimulateBlockProposal(ctx context.Context, in *SimulateBlockProposalRequest, opts ...grpc.CallOption) (*SimulatedBlockProposal, error)
 }
 
 type debugClient struct {
-	cc grpc.ClientConnInterface
+	cc *grpc.ClientConn
 }
 
-func NewDebugClient(cc grpc.ClientConnInterface) DebugClient {
+func NewDebugClient(cc *grpc.ClientConn) DebugClient {
 	return &debugClient{cc}
 }
 
@@ -543,12 +1226,42 @@ func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *empty.Emp
 	return out, nil
 }
 
+func (c *debugClient) ListReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (*ListReorgsResponse, error) {
+	out := new(ListReorgsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListReorgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListValidatorRewards(ctx context.Context, in *ListValidatorRewardsRequest, opts ...grpc.CallOption) (*ListValidatorRewardsResponse, error) {
+	out := new(ListValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SimulateBlockProposal(ctx context.Context, in *SimulateBlockProposalRequest, opts ...grpc.CallOption) (*SimulatedBlockProposal, error) {
+	out := new(SimulatedBlockProposal)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SimulateBlockProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServer is the server API for Debug service.
 type DebugServer interface {
 	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
 	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
 	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*empty.Empty, error)
 	GetProtoArrayForkChoice(context.Context, *empty.Empty) (*ProtoArrayForkChoiceResponse, error)
+	ListReorgs(context.Context, *ListReorgsRequest) (*ListReorgsResponse, error)
+	ListValidatorRewards(context.Context, *ListValidatorRewardsRequest) (*ListValidatorRewardsResponse, error)
+	SimulateBlockProposal(context.Context, *SimulateBlockProposalRequest) (*SimulatedBlockProposal, error)
 }
 
 // UnimplementedDebugServer can be embedded to have forward compatible implementations.
@@ -567,6 +1280,15 @@ func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *Loggi
 func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *empty.Empty) (*ProtoArrayForkChoiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
 }
+func (*UnimplementedDebugServer) ListReorgs(ctx context.Context, req *ListReorgsRequest) (*ListReorgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReorgs not implemented")
+}
+func (*UnimplementedDebugServer) ListValidatorRewards(ctx context.Context, req *ListValidatorRewardsRequest) (*ListValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorRewards not implemented")
+}
+func (*UnimplementedDebugServer) SimulateBlockProposal(ctx context.Context, req *SimulateBlockProposalRequest) (*SimulatedBlockProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBlockProposal not implemented")
+}
 
 func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
 	s.RegisterService(&_Debug_serviceDesc, srv)
@@ -644,6 +1366,60 @@ func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_ListReorgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReorgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListReorgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListReorgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListReorgs(ctx, req.(*ListReorgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListValidatorRewards(ctx, req.(*ListValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SimulateBlockProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateBlockProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SimulateBlockProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SimulateBlockProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SimulateBlockProposal(ctx, req.(*SimulateBlockProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Debug_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.Debug",
 	HandlerType: (*DebugServer)(nil),
@@ -664,6 +1440,18 @@ var _Debug_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProtoArrayForkChoice",
 			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
 		},
+		{
+			MethodName: "ListReorgs",
+			Handler:    _Debug_ListReorgs_Handler,
+		},
+		{
+			MethodName: "ListValidatorRewards",
+			Handler:    _Debug_ListValidatorRewards_Handler,
+		},
+		{
+			MethodName: "SimulateBlockProposal",
+			Handler:    _Debug_SimulateBlockProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/debug.proto",
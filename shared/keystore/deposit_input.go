@@ -26,9 +26,15 @@ import (
 //
 // See: https://github.com/ethereum/eth2.0-specs/blob/master/specs/validator/0_beacon-chain-validator.md#submit-deposit
 func DepositInput(depositKey *Key, withdrawalKey *Key, amountInGwei uint64) (*ethpb.Deposit_Data, [32]byte, error) {
+	return DepositInputForCredentials(depositKey, WithdrawalCredentials(withdrawalKey.PublicKey.Marshal()), amountInGwei)
+}
+
+// DepositInputForCredentials is the deposit input of a deposit key for deposits made to the given
+// withdrawal credentials. It returns the signed deposit data and its root.
+func DepositInputForCredentials(depositKey *Key, withdrawalCredentials []byte, amountInGwei uint64) (*ethpb.Deposit_Data, [32]byte, error) {
 	di := &ethpb.Deposit_Data{
 		PublicKey:             depositKey.PublicKey.Marshal(),
-		WithdrawalCredentials: withdrawalCredentialsHash(withdrawalKey),
+		WithdrawalCredentials: withdrawalCredentials,
 		Amount:                amountInGwei,
 	}
 
@@ -55,14 +61,14 @@ func DepositInput(depositKey *Key, withdrawalKey *Key, amountInGwei uint64) (*et
 	return di, dr, nil
 }
 
-// withdrawalCredentialsHash forms a 32 byte hash of the withdrawal public
-// address.
+// WithdrawalCredentials forms a 32 byte hash of the withdrawal public
+// key.
 //
 // The specification is as follows:
 //   withdrawal_credentials[:1] == BLS_WITHDRAWAL_PREFIX_BYTE
 //   withdrawal_credentials[1:] == hash(withdrawal_pubkey)[1:]
 // where withdrawal_credentials is of type bytes32.
-func withdrawalCredentialsHash(withdrawalKey *Key) []byte {
-	h := hashutil.Hash(withdrawalKey.PublicKey.Marshal())
+func WithdrawalCredentials(withdrawalPubKey []byte) []byte {
+	h := hashutil.Hash(withdrawalPubKey)
 	return append([]byte{params.BeaconConfig().BLSWithdrawalPrefixByte}, h[1:]...)[:32]
 }
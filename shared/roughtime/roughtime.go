@@ -43,3 +43,14 @@ func Until(t time.Time) time.Duration {
 func Now() time.Time {
 	return time.Now().Add(offset)
 }
+
+// SetNow sets the clock so that Now returns the given time, and keeps advancing from there. This
+// is meant for tests that need to control time, such as spec tests replaying ticks. It returns a
+// function restoring the previous clock.
+func SetNow(t time.Time) func() {
+	previous := offset
+	offset = time.Until(t)
+	return func() {
+		offset = previous
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/bits"
 
 	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -41,6 +42,60 @@ func CreateTrieFromProto(trieObj *protodb.SparseMerkleTrie) *SparseMerkleTrie {
 	return trie
 }
 
+// CreateTrieFromFinalized creates a Merkle trie of depositCount leaves from the roots of the
+// complete subtrees covering them, ordered from the largest subtree to the smallest, as
+// returned by FinalizedBranches. Items can be inserted into the trie from index depositCount
+// onwards, however no Merkle proofs can be computed for the first depositCount leaves.
+func CreateTrieFromFinalized(finalized [][]byte, depositCount uint64, depth int) (*SparseMerkleTrie, error) {
+	if depositCount == 0 {
+		return nil, errors.New("no deposits provided to create Merkle trie")
+	}
+	if depositCount > 1<<uint(depth) {
+		return nil, fmt.Errorf("deposit count %d exceeds the capacity of a trie of depth %d", depositCount, depth)
+	}
+	if len(finalized) != bits.OnesCount64(depositCount) {
+		return nil, fmt.Errorf("wanted %d finalized branches for %d deposits, received %d", bits.OnesCount64(depositCount), depositCount, len(finalized))
+	}
+	layers := make([][][]byte, depth+1)
+	for i := range layers {
+		width := uint64(1) << uint(i)
+		layers[i] = make([][]byte, (depositCount+width-1)/width)
+	}
+	idx := 0
+	for i := depth; i >= 0; i-- {
+		if (depositCount>>uint(i))&1 == 0 {
+			continue
+		}
+		if len(finalized[idx]) != 32 {
+			return nil, fmt.Errorf("finalized branch %d is %d bytes long, wanted 32", idx, len(finalized[idx]))
+		}
+		branch := bytesutil.ToBytes32(finalized[idx])
+		layers[i][(depositCount>>uint(i))-1] = branch[:]
+		idx++
+	}
+	// Compute the nodes which cover both finalized leaves and empty leaves, the same way the
+	// deposit contract computes its root.
+	var node [32]byte
+	size := depositCount
+	for i := 0; i < depth; i++ {
+		if size&1 == 1 {
+			node = hashutil.Hash(append(layers[i][size-1], node[:]...))
+		} else {
+			node = hashutil.Hash(append(node[:], ZeroHashes[i][:]...))
+		}
+		size /= 2
+		if depositCount%(uint64(1)<<uint(i+1)) != 0 {
+			parent := node
+			layers[i+1][size] = parent[:]
+		}
+	}
+	return &SparseMerkleTrie{
+		branches:      layers,
+		originalItems: make([][]byte, depositCount),
+		depth:         uint(depth),
+	}, nil
+}
+
 // GenerateTrieFromItems constructs a Merkle trie from a sequence of byte slices.
 func GenerateTrieFromItems(items [][]byte, depth int) (*SparseMerkleTrie, error) {
 	if len(items) == 0 {
@@ -77,6 +132,45 @@ func (m *SparseMerkleTrie) Items() [][]byte {
 	return m.originalItems
 }
 
+// FinalizedBranches returns the roots of the complete subtrees covering the first depositCount
+// leaves of the trie, ordered from the largest subtree to the smallest. Along with the deposit
+// count, they are all that is needed to keep inserting items into the trie.
+func (m *SparseMerkleTrie) FinalizedBranches(depositCount uint64) ([][]byte, error) {
+	if depositCount == 0 || depositCount > uint64(len(m.originalItems)) {
+		return nil, fmt.Errorf("deposit count out of range in trie, max range: %d, received: %d", len(m.originalItems), depositCount)
+	}
+	finalized := make([][]byte, 0, bits.OnesCount64(depositCount))
+	for i := int(m.depth); i >= 0; i-- {
+		if (depositCount>>uint(i))&1 == 0 {
+			continue
+		}
+		idx := (depositCount >> uint(i)) - 1
+		if idx >= uint64(len(m.branches[i])) || len(m.branches[i][idx]) == 0 {
+			return nil, fmt.Errorf("no branch at layer %d and index %d in trie", i, idx)
+		}
+		branch := bytesutil.ToBytes32(m.branches[i][idx])
+		finalized = append(finalized, branch[:])
+	}
+	return finalized, nil
+}
+
+// Copy returns a copy of the trie. Items inserted into the copy are not inserted
+// into the original trie.
+func (m *SparseMerkleTrie) Copy() *SparseMerkleTrie {
+	branches := make([][][]byte, len(m.branches))
+	for i, layer := range m.branches {
+		branches[i] = make([][]byte, len(layer))
+		copy(branches[i], layer)
+	}
+	items := make([][]byte, len(m.originalItems))
+	copy(items, m.originalItems)
+	return &SparseMerkleTrie{
+		depth:         m.depth,
+		branches:      branches,
+		originalItems: items,
+	}
+}
+
 // Root returns the top-most, Merkle root of the trie.
 func (m *SparseMerkleTrie) Root() [32]byte {
 	enc := [32]byte{}
@@ -137,6 +231,9 @@ func (m *SparseMerkleTrie) MerkleProof(index int) ([][]byte, error) {
 	for i := uint(0); i < m.depth; i++ {
 		subIndex := (merkleIndex / (1 << i)) ^ 1
 		if subIndex < uint(len(m.branches[i])) {
+			if len(m.branches[i][subIndex]) == 0 {
+				return nil, fmt.Errorf("no proof for merkle index %d, the trie was created from finalized branches", index)
+			}
 			item := bytesutil.ToBytes32(m.branches[i][subIndex])
 			proof[i] = item[:]
 		} else {
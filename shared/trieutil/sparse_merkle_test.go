@@ -288,3 +288,105 @@ func BenchmarkVerifyMerkleBranch(b *testing.B) {
 		}
 	}
 }
+
+func TestCreateTrieFromFinalized_MatchesFullTrie(t *testing.T) {
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	items := make([][]byte, 40)
+	for i := range items {
+		h := hashutil.Hash([]byte(strconv.Itoa(i)))
+		items[i] = h[:]
+	}
+	for count := 1; count < 33; count++ {
+		full, err := GenerateTrieFromItems(items[:count], depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finalized, err := full.FinalizedBranches(uint64(count))
+		if err != nil {
+			t.Fatal(err)
+		}
+		trie, err := CreateTrieFromFinalized(finalized, uint64(count), depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if trie.Root() != full.Root() {
+			t.Fatalf("Count %d: wanted root %#x, received %#x", count, full.Root(), trie.Root())
+		}
+		for i := count; i < len(items); i++ {
+			full.Insert(items[i], i)
+			trie.Insert(items[i], i)
+			if trie.Root() != full.Root() {
+				t.Fatalf("Count %d, insert %d: wanted root %#x, received %#x", count, i, full.Root(), trie.Root())
+			}
+			proof, err := trie.MerkleProof(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			root := trie.Root()
+			if ok := VerifyMerkleBranch(root[:], items[i], i, proof); !ok {
+				t.Fatalf("Count %d: could not verify Merkle proof of item %d", count, i)
+			}
+		}
+		if _, err := trie.MerkleProof(0); count > 1 && err == nil {
+			t.Errorf("Count %d: expected no Merkle proof of a finalized item", count)
+		}
+	}
+}
+
+func TestCreateTrieFromFinalized_ProtoRoundTrip(t *testing.T) {
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	items := [][]byte{[]byte("A"), []byte("BB"), []byte("CCC"), []byte("DDDD"), []byte("EEEEE")}
+	full, err := GenerateTrieFromItems(items, depth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finalized, err := full.FinalizedBranches(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trie, err := CreateTrieFromFinalized(finalized, 3, depth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trie = CreateTrieFromProto(trie.ToProto())
+	trie.Insert(items[3], 3)
+	trie.Insert(items[4], 4)
+	if trie.Root() != full.Root() {
+		t.Errorf("Wanted root %#x, received %#x", full.Root(), trie.Root())
+	}
+	if len(trie.Items()) != len(items) {
+		t.Errorf("Wanted %d items, received %d", len(items), len(trie.Items()))
+	}
+}
+
+func TestCreateTrieFromFinalized_InvalidBranches(t *testing.T) {
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	h := hashutil.Hash([]byte("hi"))
+	if _, err := CreateTrieFromFinalized(nil, 0, depth); err == nil {
+		t.Error("Expected failure for an empty trie")
+	}
+	// 3 deposits are covered by 2 complete subtrees.
+	if _, err := CreateTrieFromFinalized([][]byte{h[:]}, 3, depth); err == nil {
+		t.Error("Expected failure for a missing finalized branch")
+	}
+	if _, err := CreateTrieFromFinalized([][]byte{h[:], {1, 2}}, 3, depth); err == nil {
+		t.Error("Expected failure for a short finalized branch")
+	}
+}
+
+func TestMerkleTrie_Copy(t *testing.T) {
+	items := [][]byte{[]byte("A"), []byte("BB"), []byte("CCC")}
+	m, err := GenerateTrieFromItems(items, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := m.Root()
+	cpy := m.Copy()
+	cpy.Insert([]byte("DDDD"), 3)
+	if m.Root() != root {
+		t.Error("Inserting into the copy changed the original trie")
+	}
+	if cpy.Root() == root {
+		t.Error("Inserting into the copy did not change its root")
+	}
+}
@@ -0,0 +1,117 @@
+/**
+ * Deposit snapshot exporter
+ *
+ * Exports a snapshot of the deposit tree of a synced beacon node, which can be used with the
+ * --deposit-snapshot flag of another beacon node to seed its deposit trie and cache instead of
+ * processing every deposit contract log from the deposit contract deployment.
+ *
+ * The snapshot covers the deposits of the finalized state, unless a deposit count is given, and
+ * ships the genesis state which a fresh beacon node cannot build from the deposit contract logs
+ * it skips. It is written as a protobuf encoded DepositSnapshot message of
+ * proto/beacon/db/powchain.proto. The beacon node must not be running while the snapshot is exported.
+ *
+ * Usage: Run bazel run //tools/deposit-snapshot -- \
+ *  --datadir /path/to/beaconchaindata --output /path/to/deposit_snapshot.pb --web3provider http://localhost:8545
+ */
+package main
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	datadir      = flag.String("datadir", "", "Path to the beacon chain database directory")
+	output       = flag.String("output", "", "Filepath to write the protobuf encoded deposit snapshot to")
+	web3provider = flag.String("web3provider", "http://localhost:8545", "Eth1 node endpoint used to fetch the hash of the snapshot block")
+	depositCount = flag.Uint64("deposit-count", 0, "Number of deposits to include in the snapshot, defaults to the deposits of the finalized state")
+)
+
+func main() {
+	flag.Parse()
+	if *datadir == "" || *output == "" {
+		log.Fatal("Both --datadir and --output are required")
+	}
+	ctx := context.Background()
+
+	d, err := db.NewDB(*datadir, cache.NewStateSummaryCache())
+	if err != nil {
+		log.Fatalf("Could not open database: %v", err)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	eth1Data, err := d.PowchainData(ctx)
+	if err != nil {
+		log.Fatalf("Could not read eth1 data: %v", err)
+	}
+	if eth1Data == nil {
+		log.Fatal("No eth1 data in database")
+	}
+
+	count := *depositCount
+	if count == 0 {
+		cp, err := d.FinalizedCheckpoint(ctx)
+		if err != nil {
+			log.Fatalf("Could not read finalized checkpoint: %v", err)
+		}
+		st, err := d.State(ctx, bytesutil.ToBytes32(cp.Root))
+		if err != nil {
+			log.Fatalf("Could not read finalized state: %v", err)
+		}
+		if st == nil {
+			log.Fatal("No finalized state in database, use --deposit-count to choose the deposits of the snapshot")
+		}
+		count = st.Eth1DepositIndex()
+	}
+
+	snapshot, err := powchain.DepositSnapshot(eth1Data, count)
+	if err != nil {
+		log.Fatalf("Could not create deposit snapshot: %v", err)
+	}
+	genesisState, err := d.GenesisState(ctx)
+	if err != nil {
+		log.Fatalf("Could not read genesis state: %v", err)
+	}
+	if genesisState == nil {
+		log.Fatal("No genesis state in database")
+	}
+	snapshot.GenesisState = genesisState.InnerStateUnsafe()
+
+	client, err := ethclient.Dial(*web3provider)
+	if err != nil {
+		log.Fatalf("Could not connect to eth1 node: %v", err)
+	}
+	defer client.Close()
+	header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(snapshot.Eth1BlockHeight))
+	if err != nil {
+		log.Fatalf("Could not fetch eth1 block %d: %v", snapshot.Eth1BlockHeight, err)
+	}
+	snapshot.Eth1BlockHash = header.Hash().Bytes()
+
+	enc, err := snapshot.Marshal()
+	if err != nil {
+		log.Fatalf("Could not marshal deposit snapshot: %v", err)
+	}
+	if err := ioutil.WriteFile(*output, enc, 0644); err != nil {
+		log.Fatalf("Could not write deposit snapshot: %v", err)
+	}
+	log.WithFields(log.Fields{
+		"depositCount": snapshot.DepositCount,
+		"blockNumber":  snapshot.Eth1BlockHeight,
+		"blockHash":    header.Hash().Hex(),
+		"output":       *output,
+	}).Info("Exported deposit snapshot")
+}
@@ -0,0 +1,230 @@
+package accounts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// DepositData is an entry of a deposit_data.json file, in the format used by the eth2 deposit tooling.
+// Byte values are hex encoded without a 0x prefix.
+type DepositData struct {
+	PublicKey             string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+}
+
+// GenerateDepositData creates the deposit data of the validator keys, in public key order, for
+// deposits of the given amount to the withdrawal credentials. The deposits are signed for the
+// genesis fork version of the chain config.
+func GenerateDepositData(validatorKeys map[string]*keystore.Key, withdrawalCredentials []byte, amountInGwei uint64) ([]*DepositData, error) {
+	if len(withdrawalCredentials) != 32 {
+		return nil, fmt.Errorf("withdrawal credentials must be 32 bytes, received %d", len(withdrawalCredentials))
+	}
+	forkVersion := params.BeaconConfig().GenesisForkVersion
+
+	keys := make([]*keystore.Key, 0, len(validatorKeys))
+	for _, key := range validatorKeys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i].PublicKey.Marshal(), keys[j].PublicKey.Marshal()) < 0
+	})
+
+	deposits := make([]*DepositData, len(keys))
+	for i, key := range keys {
+		data, dataRoot, err := keystore.DepositInputForCredentials(key, withdrawalCredentials, amountInGwei)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create deposit data")
+		}
+		// The signing root of the deposit data is the root of its deposit message.
+		messageRoot, err := ssz.SigningRoot(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute deposit message root")
+		}
+		deposits[i] = &DepositData{
+			PublicKey:             hex.EncodeToString(data.PublicKey),
+			WithdrawalCredentials: hex.EncodeToString(data.WithdrawalCredentials),
+			Amount:                data.Amount,
+			Signature:             hex.EncodeToString(data.Signature),
+			DepositMessageRoot:    hex.EncodeToString(messageRoot[:]),
+			DepositDataRoot:       hex.EncodeToString(dataRoot[:]),
+			ForkVersion:           hex.EncodeToString(forkVersion),
+		}
+	}
+	return deposits, nil
+}
+
+// DepositDataFromKeystore creates the deposit data of the validator keys of a keystore. The deposits
+// are made to the withdrawal public key, if given, or else to the only withdrawal key of the keystore.
+func DepositDataFromKeystore(keystorePath string, passphrase string, withdrawalPubKey []byte, amountInGwei uint64) ([]*DepositData, error) {
+	validatorKeys, err := DecryptKeysFromKeystore(keystorePath, params.BeaconConfig().ValidatorPrivkeyFileName, passphrase)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decrypt validator keys from keystore in path %s", keystorePath)
+	}
+	if len(validatorKeys) == 0 {
+		return nil, fmt.Errorf("no validator keys in keystore in path %s", keystorePath)
+	}
+	if len(withdrawalPubKey) == 0 {
+		withdrawalKeys, err := DecryptKeysFromKeystore(keystorePath, params.BeaconConfig().WithdrawalPrivkeyFileName, passphrase)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decrypt withdrawal keys from keystore in path %s", keystorePath)
+		}
+		if len(withdrawalKeys) != 1 {
+			return nil, fmt.Errorf("found %d withdrawal keys in keystore, a withdrawal public key must be given", len(withdrawalKeys))
+		}
+		for _, key := range withdrawalKeys {
+			withdrawalPubKey = key.PublicKey.Marshal()
+		}
+	}
+	if _, err := bls.PublicKeyFromBytes(withdrawalPubKey); err != nil {
+		return nil, errors.Wrap(err, "invalid withdrawal public key")
+	}
+	return GenerateDepositData(validatorKeys, keystore.WithdrawalCredentials(withdrawalPubKey), amountInGwei)
+}
+
+// VerifyDepositData checks every deposit data entry against the chain config: its fork version
+// is the genesis fork version, its amount is within the deposit bounds, its roots match its
+// content and its signature is valid for its public key.
+func VerifyDepositData(deposits []*DepositData) error {
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainDeposit, params.BeaconConfig().GenesisForkVersion, nil /*genesisValidatorsRoot*/)
+	if err != nil {
+		return errors.Wrap(err, "could not compute deposit domain")
+	}
+	for i, deposit := range deposits {
+		if err := verifyDepositData(deposit, domain); err != nil {
+			return errors.Wrapf(err, "invalid deposit data at index %d with public key %s", i, deposit.PublicKey)
+		}
+	}
+	return nil
+}
+
+func verifyDepositData(deposit *DepositData, domain []byte) error {
+	forkVersion, err := decodeHex(deposit.ForkVersion, "fork version", 4)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(forkVersion, params.BeaconConfig().GenesisForkVersion) {
+		return fmt.Errorf("fork version %#x does not match genesis fork version %#x", forkVersion, params.BeaconConfig().GenesisForkVersion)
+	}
+	if deposit.Amount < params.BeaconConfig().MinDepositAmount || deposit.Amount > params.BeaconConfig().MaxEffectiveBalance {
+		return fmt.Errorf("amount %d is not between %d and %d gwei", deposit.Amount, params.BeaconConfig().MinDepositAmount, params.BeaconConfig().MaxEffectiveBalance)
+	}
+
+	pubKey, err := decodeHex(deposit.PublicKey, "public key", 48)
+	if err != nil {
+		return err
+	}
+	withdrawalCredentials, err := decodeHex(deposit.WithdrawalCredentials, "withdrawal credentials", 32)
+	if err != nil {
+		return err
+	}
+	signature, err := decodeHex(deposit.Signature, "signature", 96)
+	if err != nil {
+		return err
+	}
+	data := &ethpb.Deposit_Data{
+		PublicKey:             pubKey,
+		WithdrawalCredentials: withdrawalCredentials,
+		Amount:                deposit.Amount,
+		Signature:             signature,
+	}
+
+	messageRoot, err := ssz.SigningRoot(data)
+	if err != nil {
+		return errors.Wrap(err, "could not compute deposit message root")
+	}
+	if err := verifyRoot(deposit.DepositMessageRoot, "deposit message root", messageRoot); err != nil {
+		return err
+	}
+	dataRoot, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		return errors.Wrap(err, "could not compute deposit data root")
+	}
+	if err := verifyRoot(deposit.DepositDataRoot, "deposit data root", dataRoot); err != nil {
+		return err
+	}
+
+	publicKey, err := bls.PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return errors.Wrap(err, "could not convert bytes to public key")
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return errors.Wrap(err, "could not convert bytes to signature")
+	}
+	signingRoot, err := ssz.HashTreeRoot(&pb.SigningData{ObjectRoot: messageRoot[:], Domain: domain})
+	if err != nil {
+		return errors.Wrap(err, "could not compute signing root")
+	}
+	if !sig.Verify(publicKey, signingRoot[:]) {
+		return helpers.ErrSigFailedToVerify
+	}
+	return nil
+}
+
+// verifyRoot checks that the hex encoded root matches the computed root.
+func verifyRoot(encoded string, name string, root [32]byte) error {
+	decoded, err := decodeHex(encoded, name, 32)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(decoded, root[:]) {
+		return fmt.Errorf("%s %#x does not match computed root %#x", name, decoded, root)
+	}
+	return nil
+}
+
+// decodeHex decodes a hex encoded value of the given length, with or without a 0x prefix.
+func decodeHex(encoded string, name string, length int) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(encoded, "0x"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s", name)
+	}
+	if len(decoded) != length {
+		return nil, fmt.Errorf("%s must be %d bytes, received %d", name, length, len(decoded))
+	}
+	return decoded, nil
+}
+
+// WriteDepositDataFile writes the deposit data entries to a deposit_data.json file.
+func WriteDepositDataFile(path string, deposits []*DepositData) error {
+	enc, err := json.Marshal(deposits)
+	if err != nil {
+		return errors.Wrap(err, "could not encode deposit data")
+	}
+	if err := ioutil.WriteFile(path, enc, 0644); err != nil {
+		return errors.Wrapf(err, "could not write deposit data to %s", path)
+	}
+	return nil
+}
+
+// ReadDepositDataFile reads the deposit data entries of a deposit_data.json file.
+func ReadDepositDataFile(path string) ([]*DepositData, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read deposit data from %s", path)
+	}
+	var deposits []*DepositData
+	if err := json.Unmarshal(enc, &deposits); err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit data")
+	}
+	return deposits, nil
+}
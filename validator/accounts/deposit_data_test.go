@@ -0,0 +1,155 @@
+package accounts
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func generateTestDepositData(t *testing.T, numKeys int) []*DepositData {
+	validatorKeys := make(map[string]*keystore.Key, numKeys)
+	for i := 0; i < numKeys; i++ {
+		key, err := keystore.NewKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		validatorKeys[hex.EncodeToString(key.PublicKey.Marshal())] = key
+	}
+	withdrawalKey, err := keystore.NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposits, err := GenerateDepositData(validatorKeys, keystore.WithdrawalCredentials(withdrawalKey.PublicKey.Marshal()), params.BeaconConfig().MaxEffectiveBalance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != numKeys {
+		t.Fatalf("Wanted %d deposits, received %d", numKeys, len(deposits))
+	}
+	return deposits
+}
+
+func TestDepositData_GenerateAndVerify(t *testing.T) {
+	deposits := generateTestDepositData(t, 4)
+	for i := 1; i < len(deposits); i++ {
+		if deposits[i-1].PublicKey >= deposits[i].PublicKey {
+			t.Error("Expected deposit data in public key order")
+		}
+	}
+	if deposits[0].ForkVersion != hex.EncodeToString(params.BeaconConfig().GenesisForkVersion) {
+		t.Errorf("Unexpected fork version %s", deposits[0].ForkVersion)
+	}
+
+	path := filepath.Join(testutil.TempDir(), "deposit_data.json")
+	defer func() {
+		if err := os.Remove(path); err != nil {
+			t.Error(err)
+		}
+	}()
+	if err := WriteDepositDataFile(path, deposits); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadDepositDataFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyDepositData(read); err != nil {
+		t.Errorf("Could not verify deposit data: %v", err)
+	}
+}
+
+func TestVerifyDepositData_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(deposit *DepositData)
+		wantErr string
+	}{
+		{
+			name:    "amount",
+			modify:  func(deposit *DepositData) { deposit.Amount-- },
+			wantErr: "deposit message root",
+		},
+		{
+			name:    "amount out of bounds",
+			modify:  func(deposit *DepositData) { deposit.Amount = params.BeaconConfig().MinDepositAmount - 1 },
+			wantErr: "is not between",
+		},
+		{
+			name: "withdrawal credentials",
+			modify: func(deposit *DepositData) {
+				deposit.WithdrawalCredentials = strings.Repeat("00", 32)
+			},
+			wantErr: "deposit message root",
+		},
+		{
+			name: "deposit data root",
+			modify: func(deposit *DepositData) {
+				deposit.DepositDataRoot = strings.Repeat("00", 32)
+			},
+			wantErr: "deposit data root",
+		},
+		{
+			name:    "fork version",
+			modify:  func(deposit *DepositData) { deposit.ForkVersion = "ffffffff" },
+			wantErr: "fork version",
+		},
+		{
+			name:    "public key length",
+			modify:  func(deposit *DepositData) { deposit.PublicKey = deposit.PublicKey[2:] },
+			wantErr: "public key must be 48 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deposits := generateTestDepositData(t, 2)
+			tt.modify(deposits[1])
+			err := VerifyDepositData(deposits)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, received %v", tt.wantErr, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "index 1") {
+				t.Errorf("Expected error to name the invalid entry, received %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyDepositData_SignatureOfOtherKey(t *testing.T) {
+	deposits := generateTestDepositData(t, 2)
+	deposit := deposits[0]
+	deposit.Signature = deposits[1].Signature
+	// Fix up the deposit data root, so that only the signature is invalid.
+	data := &ethpb.Deposit_Data{
+		PublicKey:             decodeTestHex(t, deposit.PublicKey),
+		WithdrawalCredentials: decodeTestHex(t, deposit.WithdrawalCredentials),
+		Amount:                deposit.Amount,
+		Signature:             decodeTestHex(t, deposit.Signature),
+	}
+	dataRoot, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposit.DepositDataRoot = hex.EncodeToString(dataRoot[:])
+
+	err = VerifyDepositData(deposits)
+	if err == nil || !strings.Contains(err.Error(), helpers.ErrSigFailedToVerify.Error()) {
+		t.Errorf("Expected signature verification failure, received %v", err)
+	}
+}
+
+func decodeTestHex(t *testing.T, encoded string) []byte {
+	decoded, err := hex.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
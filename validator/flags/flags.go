@@ -25,6 +25,17 @@ var (
 		Name:  "tls-cert",
 		Usage: "Certificate for secure gRPC. Pass this and the tls-key flag in order to use gRPC securely.",
 	}
+	// DepositAmountFlag defines the amount of the deposits of the deposit data, in Gwei.
+	DepositAmountFlag = &cli.Uint64Flag{
+		Name:  "deposit-amount",
+		Usage: "Amount in Gwei of each deposit of the deposit data, defaults to MAX_EFFECTIVE_BALANCE of the chain config",
+	}
+	// DepositDataFileFlag defines the path of the deposit_data.json file of the deposit data of validator keys.
+	DepositDataFileFlag = &cli.StringFlag{
+		Name:  "deposit-data-file",
+		Usage: "Path to the deposit_data.json file with the deposit data of the validator keys",
+		Value: "deposit_data.json",
+	}
 	// SlasherRPCProviderFlag defines a slasher node RPC endpoint.
 	SlasherRPCProviderFlag = &cli.StringFlag{
 		Name:  "slasher-rpc-provider",
@@ -108,4 +119,9 @@ var (
 		Usage: "Filepath to a JSON file of unencrypted validator keys for easier launching of the validator client",
 		Value: "",
 	}
+	// WithdrawalPublicKeyFlag defines the BLS public key the withdrawal credentials of deposits are derived from.
+	WithdrawalPublicKeyFlag = &cli.StringFlag{
+		Name:  "withdrawal-public-key",
+		Usage: "Hex encoded BLS withdrawal public key of the deposits, defaults to the withdrawal key of the keystore",
+	}
 )
@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"runtime"
@@ -13,6 +14,7 @@ import (
 	"time"
 
 	joonix "github.com/joonix/log"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -52,6 +54,9 @@ var appFlags = []cli.Flag{
 	flags.SourceDirectories,
 	flags.SourceDirectory,
 	flags.TargetDirectory,
+	flags.DepositAmountFlag,
+	flags.DepositDataFileFlag,
+	flags.WithdrawalPublicKeyFlag,
 	flags.PasswordFlag,
 	flags.DisablePenaltyRewardLogFlag,
 	flags.UnencryptedKeysFlag,
@@ -229,6 +234,78 @@ contract in order to activate the validator client`,
 						return nil
 					},
 				},
+				{
+					Name: "deposit-data",
+					Description: `creates a deposit_data.json file with the deposit data of all the validator keys of a keystore,
+which can be used to deposit Ether into the ETH1.0 deposit contract for each validator`,
+					Flags: []cli.Flag{
+						flags.KeystorePathFlag,
+						flags.PasswordFlag,
+						flags.DepositAmountFlag,
+						flags.DepositDataFileFlag,
+						flags.WithdrawalPublicKeyFlag,
+						cmd.ChainConfigFileFlag,
+					},
+					Action: func(cliCtx *cli.Context) error {
+						if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+							params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name))
+						}
+						keystorePath, passphrase, err := accounts.HandleEmptyKeystoreFlags(cliCtx, false /*confirmPassword*/)
+						if err != nil {
+							return err
+						}
+						var withdrawalPubKey []byte
+						if cliCtx.IsSet(flags.WithdrawalPublicKeyFlag.Name) {
+							withdrawalPubKey, err = hex.DecodeString(strings.TrimPrefix(cliCtx.String(flags.WithdrawalPublicKeyFlag.Name), "0x"))
+							if err != nil {
+								return errors.Wrap(err, "could not decode withdrawal public key")
+							}
+						}
+						amount := cliCtx.Uint64(flags.DepositAmountFlag.Name)
+						if amount == 0 {
+							amount = params.BeaconConfig().MaxEffectiveBalance
+						}
+						deposits, err := accounts.DepositDataFromKeystore(keystorePath, passphrase, withdrawalPubKey, amount)
+						if err != nil {
+							return err
+						}
+						output := cliCtx.String(flags.DepositDataFileFlag.Name)
+						if err := accounts.WriteDepositDataFile(output, deposits); err != nil {
+							return err
+						}
+						log.WithFields(logrus.Fields{
+							"deposits": len(deposits),
+							"path":     output,
+						}).Info("Wrote deposit data")
+						return nil
+					},
+				},
+				{
+					Name:        "verify-deposit-data",
+					Description: "verifies the signatures and roots of a deposit_data.json file against the chain config",
+					Flags: []cli.Flag{
+						flags.DepositDataFileFlag,
+						cmd.ChainConfigFileFlag,
+					},
+					Action: func(cliCtx *cli.Context) error {
+						if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+							params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name))
+						}
+						path := cliCtx.String(flags.DepositDataFileFlag.Name)
+						deposits, err := accounts.ReadDepositDataFile(path)
+						if err != nil {
+							return err
+						}
+						if err := accounts.VerifyDepositData(deposits); err != nil {
+							return err
+						}
+						log.WithFields(logrus.Fields{
+							"deposits": len(deposits),
+							"path":     path,
+						}).Info("Verified deposit data")
+						return nil
+					},
+				},
 				{
 					Name:        "merge",
 					Description: "merges data from several validator databases into a new validator database",
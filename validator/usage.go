@@ -91,6 +91,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.SourceDirectories,
 			flags.SourceDirectory,
 			flags.TargetDirectory,
+			flags.DepositAmountFlag,
+			flags.DepositDataFileFlag,
+			flags.WithdrawalPublicKeyFlag,
 			flags.DisableAccountMetricsFlag,
 		},
 	},